@@ -0,0 +1,41 @@
+package entrypoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/server"
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/spf13/cobra"
+)
+
+var restoreExecutions []string
+
+var parentArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "This command manages the archives of purged executions. Please choose a subcommand.",
+}
+
+// This restores archived executions into the database
+var restoreCmd = &cobra.Command{
+	Use:   "restore <archive>",
+	Short: "Restore the executions of an archive file written when purging executions, for inspection.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		restored, err := server.RestoreArchive(ctx, storage.DataReference(args[0]), restoreExecutions)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Restored %d executions.\n", restored)
+		return nil
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentArchiveCmd)
+	parentArchiveCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().StringSliceVar(&restoreExecutions, "execution", nil,
+		"Names of the executions to restore, all executions of the archive are restored when unset")
+}
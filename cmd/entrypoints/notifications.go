@@ -0,0 +1,73 @@
+package entrypoints
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/flyteorg/flyteadmin/pkg/server"
+
+	"github.com/spf13/cobra"
+)
+
+var deadLettersLimit int
+
+var parentNotificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "This command controls notifications. Please choose a subcommand.",
+}
+
+var deadLettersCmd = &cobra.Command{
+	Use:   "dead-letters",
+	Short: "This command manages the notifications dead-lettered to the database. Please choose a subcommand.",
+}
+
+// This lists the dead-lettered notifications which weren't replayed yet
+var listDeadLettersCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the dead-lettered notifications which weren't replayed yet.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		deadLetters, err := server.ListNotificationDeadLetters(ctx, deadLettersLimit)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "ID\tCREATED AT\tPROCESSOR\tTYPE\tATTEMPTS\tREPLAYABLE\tREASON")
+		for _, deadLetter := range deadLetters {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%t\t%s\n", deadLetter.ID, deadLetter.CreatedAt.Format(
+				"2006-01-02T15:04:05Z07:00"), deadLetter.Processor, deadLetter.NotificationType, deadLetter.Attempts,
+				len(deadLetter.Message) > 0, deadLetter.Reason)
+		}
+		return w.Flush()
+	},
+}
+
+// This republishes dead-lettered notifications
+var replayDeadLettersCmd = &cobra.Command{
+	Use:   "replay [id...]",
+	Short: "Republish the given dead-lettered notifications, or all replayable ones when no ids are given.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		ids := make([]uint, 0, len(args))
+		for _, arg := range args {
+			id, err := strconv.ParseUint(arg, 10, 0)
+			if err != nil {
+				return fmt.Errorf("invalid dead-lettered notification id [%s]: %w", arg, err)
+			}
+			ids = append(ids, uint(id))
+		}
+		return server.ReplayNotificationDeadLetters(ctx, ids, deadLettersLimit)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentNotificationsCmd)
+	parentNotificationsCmd.AddCommand(deadLettersCmd)
+	deadLettersCmd.PersistentFlags().IntVar(&deadLettersLimit, "limit", 100,
+		"The maximum number of dead-lettered notifications to list or replay")
+	deadLettersCmd.AddCommand(listDeadLettersCmd)
+	deadLettersCmd.AddCommand(replayDeadLettersCmd)
+}
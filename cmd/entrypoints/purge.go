@@ -0,0 +1,58 @@
+package entrypoints
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/flyteorg/flyteadmin/pkg/server"
+
+	"github.com/spf13/cobra"
+)
+
+var purgeDryRun bool
+
+// This purges the executions past their retention period
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Delete the executions past the retention period of the configured retention policies.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		report, partitions, err := server.Purge(ctx, purgeDryRun)
+		// Whatever was purged before a failure is reported regardless.
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "PROJECT\tDOMAIN\tMAX AGE\tEXECUTIONS\tOBJECTS\tARCHIVES\tROWS")
+		for _, policy := range report.Policies {
+			rows := make([]string, 0, len(policy.RowsDeleted))
+			for table, count := range policy.RowsDeleted {
+				rows = append(rows, fmt.Sprintf("%s=%d", table, count))
+			}
+			sort.Strings(rows)
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\n", policy.Policy.Project, policy.Policy.Domain,
+				policy.Policy.MaxAge.Duration, policy.Executions, policy.ObjectsDeleted, policy.ArchivesWritten,
+				strings.Join(rows, " "))
+		}
+		for _, partition := range partitions.Created {
+			_, _ = fmt.Fprintf(w, "Created partition %s.\n", partition)
+		}
+		for _, partition := range partitions.Detached {
+			_, _ = fmt.Fprintf(w, "Detached partition %s.\n", partition)
+		}
+		if report.DryRun {
+			_, _ = fmt.Fprintln(w, "Dry run, nothing was deleted.")
+		}
+		if flushErr := w.Flush(); err == nil {
+			err = flushErr
+		}
+		return err
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(purgeCmd)
+	purgeCmd.Flags().BoolVar(&purgeDryRun, "dry-run", false,
+		"Report what would be purged without deleting anything")
+}
@@ -2,6 +2,9 @@ package entrypoints
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/flyteorg/flyteadmin/plugins"
 
@@ -24,7 +27,9 @@ var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Launches the Flyte admin server",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Shut down gracefully on termination, so that queued events are persisted.
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
 		// Serve profiling endpoints.
 		cfg := runtimeConfig.NewConfigurationProvider()
 		go func() {
@@ -2,6 +2,7 @@ package entrypoints
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/flyteorg/flyteadmin/pkg/server"
 
@@ -19,10 +20,13 @@ var schedulerRunCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 		schedulerConfiguration := runtime.NewConfigurationProvider().ApplicationConfiguration().GetSchedulerConfig()
-		// Serve profiling endpoints.
+		statusHandler := scheduler.NewStatusHandler()
+		// Serve profiling endpoints, along with the status of the scheduler.
 		go func() {
 			err := profutils.StartProfilingServerWithDefaultHandlers(
-				ctx, schedulerConfiguration.ProfilerPort.Port, nil)
+				ctx, schedulerConfiguration.ProfilerPort.Port, map[string]http.Handler{
+					scheduler.StatusPath: statusHandler,
+				})
 			if err != nil {
 				logger.Panicf(ctx, "Failed to Start profiling and Metrics server. Error, %v", err)
 			}
@@ -32,7 +36,7 @@ var schedulerRunCmd = &cobra.Command{
 		applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
 		server.SetMetricKeys(applicationConfiguration)
 
-		return scheduler.StartScheduler(ctx)
+		return scheduler.StartScheduler(ctx, statusHandler)
 	},
 }
 
@@ -0,0 +1,92 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/backfill/interfaces"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// The lock held by the admin instance launching the executions of backfills, so that the replicas don't launch the
+// same ones at once.
+const backfillerLockName = "backfiller"
+
+type backfillerMetrics struct {
+	Scope        promutils.Scope
+	LaunchErrors prometheus.Counter
+}
+
+// backfiller launches the next executions of running backfills every interval, as many as their maximum concurrency
+// allows. Every admin instance runs one, but only one of them launches executions at a time.
+type backfiller struct {
+	manager  managerInterfaces.BackfillInterface
+	lockRepo repositoryInterfaces.LockRepoInterface
+	config   runtimeInterfaces.BackfillConfig
+	metrics  backfillerMetrics
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (b *backfiller) Run() {
+	defer close(b.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-b.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	ticker := time.NewTicker(b.config.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+			acquired, err := b.lockRepo.RunExclusively(ctx, backfillerLockName, b.manager.LaunchBackfills)
+			if !acquired && err == nil {
+				logger.Debugf(ctx, "Skipped launching the executions of backfills, which another instance is launching")
+			}
+			if err != nil && ctx.Err() == nil {
+				b.metrics.LaunchErrors.Inc()
+				logger.Warnf(ctx, "Failed to launch the executions of backfills with err [%+v]", err)
+			}
+		}
+	}
+}
+
+func (b *backfiller) Close(ctx context.Context) error {
+	close(b.stop)
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewBackfiller returns a backfiller periodically launching the executions of running backfills through the manager.
+func NewBackfiller(manager managerInterfaces.BackfillInterface, lockRepo repositoryInterfaces.LockRepoInterface,
+	config runtimeInterfaces.BackfillConfig, scope promutils.Scope) interfaces.Backfiller {
+	return &backfiller{
+		manager:  manager,
+		lockRepo: lockRepo,
+		config:   config,
+		metrics: backfillerMetrics{
+			Scope: scope,
+			LaunchErrors: scope.MustNewCounter("launch_errors",
+				"number of failures listing the backfills to launch the executions of"),
+		},
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
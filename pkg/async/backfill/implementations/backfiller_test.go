@@ -0,0 +1,58 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+func TestBackfiller_Run(t *testing.T) {
+	manager := &managerMocks.BackfillInterface{}
+	launched := make(chan struct{}, 2)
+	manager.OnLaunchBackfillsMatch(mock.Anything).Return(errors.New("foo")).Once()
+	manager.OnLaunchBackfillsMatch(mock.Anything).Run(func(args mock.Arguments) {
+		launched <- struct{}{}
+	}).Return(nil)
+	lockRepo := &repositoryMocks.LockRepoInterface{}
+	lockRepo.OnRunExclusivelyMatch(mock.Anything, backfillerLockName, mock.Anything).Return(false, nil).Once()
+	lockRepo.OnRunExclusivelyMatch(mock.Anything, backfillerLockName, mock.Anything).Call.Return(
+		func(ctx context.Context, name string, fn func(context.Context) error) bool {
+			return true
+		}, func(ctx context.Context, name string, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	backfiller := NewBackfiller(manager, lockRepo, runtimeInterfaces.BackfillConfig{
+		Interval: config.Duration{Duration: time.Millisecond},
+	}, promutils.NewTestScope())
+	go backfiller.Run()
+
+	select {
+	case <-launched:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the executions of backfills weren't launched")
+	}
+	assert.NoError(t, backfiller.Close(context.Background()))
+	// Launching continued after the lock was held elsewhere and after the error.
+	manager.AssertExpectations(t)
+	lockRepo.AssertExpectations(t)
+}
+
+func TestBackfiller_Close(t *testing.T) {
+	backfiller := NewBackfiller(&managerMocks.BackfillInterface{}, &repositoryMocks.LockRepoInterface{},
+		runtimeInterfaces.BackfillConfig{
+			Interval: config.Duration{Duration: time.Hour},
+		}, promutils.NewTestScope())
+	go backfiller.Run()
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(t, backfiller.Close(context.Background()))
+}
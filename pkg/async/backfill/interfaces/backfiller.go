@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+)
+
+//go:generate mockery -name=Backfiller -output=../mocks -case=underscore
+
+type Backfiller interface {
+	// Periodically launches the next executions of running backfills until Close is called, unless another admin
+	// instance is launching them.
+	Run()
+	// Stops launching executions and waits, at most until ctx is done, for those being launched.
+	Close(ctx context.Context) error
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/controller/nodes/task/secretmanager"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
 )
@@ -79,6 +80,13 @@ func NewCloudEventsPublisher(ctx context.Context, config runtimeInterfaces.Cloud
 			panic(err)
 		}
 		return cloudEventImplementations.NewCloudEventsPublisher(&cloudEventImplementations.KafkaSender{Client: client}, scope, config.EventsPublisherConfig.EventTypes)
+	case cloudEventImplementations.HTTP:
+		sm := secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig())
+		sender, err := cloudEventImplementations.NewHTTPSender(ctx, config.HTTPConfig, sm)
+		if err != nil {
+			panic(err)
+		}
+		return cloudEventImplementations.NewCloudEventsPublisher(sender, scope, config.EventsPublisherConfig.EventTypes)
 	case common.Local:
 		fallthrough
 	default:
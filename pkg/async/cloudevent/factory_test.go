@@ -63,3 +63,17 @@ func TestInvalidKafkaConfig(t *testing.T) {
 	NewCloudEventsPublisher(context.Background(), cfg, promutils.NewTestScope())
 	t.Errorf("did not panic")
 }
+
+func TestHTTPConfig(t *testing.T) {
+	cfg := runtimeInterfaces.CloudEventsConfig{
+		Enable:     true,
+		Type:       implementations.HTTP,
+		HTTPConfig: runtimeInterfaces.CloudEventsHTTPConfig{URL: "http://broker-ingress.knative-eventing.svc"},
+	}
+	assert.NotNil(t, NewCloudEventsPublisher(context.Background(), cfg, promutils.NewTestScope()))
+
+	defer func() { r := recover(); assert.NotNil(t, r) }()
+	cfg.HTTPConfig.Mode = "batched"
+	NewCloudEventsPublisher(context.Background(), cfg, promutils.NewTestScope())
+	t.Errorf("did not panic")
+}
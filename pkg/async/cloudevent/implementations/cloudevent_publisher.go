@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
 
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
@@ -73,7 +74,12 @@ func (p *Publisher) Publish(ctx context.Context, notificationType string, msg pr
 	// CloudEvent specification: https://github.com/cloudevents/spec/blob/v1.0/spec.md#required-attributes
 	event.SetType(fmt.Sprintf("%v.%v", cloudEventTypePrefix, notificationType))
 	event.SetSource(cloudEventSource)
-	event.SetID(fmt.Sprintf("%v.%v", executionID, phase))
+	// Events published by the outbox relay keep the same id across attempts, so that consumers can deduplicate them.
+	if deduplicationKey, ok := ctx.Value(common.DeduplicationKey).(string); ok && len(deduplicationKey) > 0 {
+		event.SetID(deduplicationKey)
+	} else {
+		event.SetID(fmt.Sprintf("%v.%v", executionID, phase))
+	}
 	event.SetTime(eventTime)
 	event.SetExtension(jsonSchemaURLKey, jsonSchemaURL)
 
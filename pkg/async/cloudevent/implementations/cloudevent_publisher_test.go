@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/protobuf/jsonpb"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
@@ -200,3 +201,20 @@ func TestCloudEventPublisher_PublishError(t *testing.T) {
 	assert.Equal(t, errorPublish, currentEventPublisher.Publish(context.Background(),
 		proto.MessageName(taskRequest), taskRequest))
 }
+
+func TestCloudEventPublisher_DeduplicationKey(t *testing.T) {
+	initializeCloudEventPublisher()
+	currentEventPublisher := NewCloudEventsPublisher(mockPubSubSender, promutils.NewTestScope(), []string{"*"})
+	ctx := context.WithValue(context.Background(), common.DeduplicationKey, "project/domain/name.SUCCEEDED.0")
+	assert.Nil(t, currentEventPublisher.Publish(ctx, proto.MessageName(workflowRequest), workflowRequest))
+	assert.Nil(t, currentEventPublisher.Publish(context.Background(), proto.MessageName(workflowRequest),
+		workflowRequest))
+
+	ids := make([]string, 0, len(testCloudEventPublisher.Published))
+	for _, published := range testCloudEventPublisher.Published {
+		cloudEvent := cloudevents.NewEvent()
+		assert.Nil(t, pbcloudevents.Protobuf.Unmarshal(published.Body, &cloudEvent))
+		ids = append(ids, cloudEvent.ID())
+	}
+	assert.Equal(t, []string{"project/domain/name.SUCCEEDED.0", executionID.String() + ".SUCCEEDED"}, ids)
+}
@@ -0,0 +1,130 @@
+package implementations
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+)
+
+const (
+	defaultHTTPTimeout    = 10 * time.Second
+	defaultHTTPRetryDelay = time.Second
+)
+
+// HTTPSender Implementation of Sender posting events with the CloudEvents HTTP protocol binding.
+type HTTPSender struct {
+	Client cloudevents.Client
+	// Sends events in structured rather than binary mode.
+	Structured    bool
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+func (s *HTTPSender) Send(ctx context.Context, notificationType string, event cloudevents.Event) error {
+	if s.Structured {
+		ctx = cloudevents.WithEncodingStructured(ctx)
+	}
+	if s.RetryAttempts > 0 {
+		ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, s.RetryDelay, s.RetryAttempts)
+	}
+	if result := s.Client.Send(ctx, event); !cloudevents.IsACK(result) {
+		return fmt.Errorf("failed to send cloud event: %v", result)
+	}
+	return nil
+}
+
+// Returns the TLS configuration of clients connecting to servers with the given configuration.
+func newTLSClientConfig(config runtimeInterfaces.TLSClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if len(config.CACertFile) > 0 {
+		caCert, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificates from [%s]: %w", config.CACertFile, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no CA certificates found in [%s]", config.CACertFile)
+		}
+	}
+	if len(config.CertFile) > 0 || len(config.KeyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func NewHTTPSender(ctx context.Context, config runtimeInterfaces.CloudEventsHTTPConfig, sm core.SecretManager) (
+	*HTTPSender, error) {
+	if len(config.URL) == 0 {
+		return nil, fmt.Errorf("a url is required to send cloud events over http")
+	}
+	var structured bool
+	switch config.Mode {
+	case "", runtimeInterfaces.CloudEventsHTTPModeBinary:
+	case runtimeInterfaces.CloudEventsHTTPModeStructured:
+		structured = true
+	default:
+		return nil, fmt.Errorf("unsupported cloud events http mode [%s]", config.Mode)
+	}
+
+	tlsConfig, err := newTLSClientConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	timeout := config.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultHTTPTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	options := []cehttp.Option{
+		cehttp.WithTarget(config.URL),
+		cehttp.WithClient(http.Client{Transport: transport, Timeout: timeout}),
+	}
+	for key, value := range config.Headers {
+		options = append(options, cehttp.WithHeader(key, value))
+	}
+	if len(config.AuthHeaderSecretName) > 0 {
+		authHeader, err := sm.Get(ctx, config.AuthHeaderSecretName)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, cehttp.WithHeader("Authorization", strings.TrimSpace(authHeader)))
+	}
+
+	protocol, err := cehttp.New(options...)
+	if err != nil {
+		return nil, err
+	}
+	client, err := cloudevents.NewClient(protocol, cloudevents.WithTimeNow(), cloudevents.WithUUIDs())
+	if err != nil {
+		return nil, err
+	}
+	retryDelay := time.Duration(config.RetryDelaySeconds) * time.Second
+	if retryDelay == 0 {
+		retryDelay = defaultHTTPRetryDelay
+	}
+	return &HTTPSender{
+		Client:        client,
+		Structured:    structured,
+		RetryAttempts: config.RetryAttempts,
+		RetryDelay:    retryDelay,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package implementations
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestCloudEvent() cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetSource("flyteadmin")
+	event.SetType("com.flyte.resource.workflow")
+	_ = event.SetData(cloudevents.ApplicationJSON, map[string]string{"phase": "SUCCEEDED"})
+	return event
+}
+
+func TestHTTPSender_Binary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"phase":"SUCCEEDED"}`, string(body))
+		assert.Equal(t, "1234", r.Header.Get("ce-id"))
+		assert.Equal(t, "com.flyte.resource.workflow", r.Header.Get("ce-type"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "flyte", r.Header.Get("X-Tenant"))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "broker_token").Return("Bearer token\n", nil)
+	sender, err := NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL:                  server.URL,
+		Headers:              map[string]string{"X-Tenant": "flyte"},
+		AuthHeaderSecretName: "broker_token",
+	}, sm)
+	assert.NoError(t, err)
+	assert.NoError(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+}
+
+func TestHTTPSender_Structured(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.Header.Get("ce-id"))
+		assert.Contains(t, string(body), `"id":"1234"`)
+		assert.Contains(t, string(body), `"data":{"phase":"SUCCEEDED"}`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender, err := NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL:  server.URL,
+		Mode: runtimeInterfaces.CloudEventsHTTPModeStructured,
+	}, &mocks.SecretManager{})
+	assert.NoError(t, err)
+	assert.NoError(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+}
+
+func TestHTTPSender_Retries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/unavailable":
+			if requests < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sender, err := NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL:           server.URL + "/unavailable",
+		RetryAttempts: 3,
+	}, &mocks.SecretManager{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, sender.RetryDelay)
+	sender.RetryDelay = time.Millisecond
+	assert.NoError(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+	assert.Equal(t, 3, requests)
+
+	// Receivers rejecting events aren't retried.
+	requests = 0
+	sender, err = NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL:           server.URL + "/invalid",
+		RetryAttempts: 3,
+	}, &mocks.SecretManager{})
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+	assert.Equal(t, 1, requests)
+}
+
+func TestHTTPSender_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The receiver's certificate isn't trusted by default.
+	sender, err := NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{URL: server.URL},
+		&mocks.SecretManager{})
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caCertFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0600))
+	sender, err = NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL: server.URL,
+		TLS: runtimeInterfaces.TLSClientConfig{CACertFile: caCertFile},
+	}, &mocks.SecretManager{})
+	assert.NoError(t, err)
+	assert.NoError(t, sender.Send(context.Background(), "", newTestCloudEvent()))
+}
+
+func TestNewHTTPSender_InvalidConfig(t *testing.T) {
+	_, err := NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{}, &mocks.SecretManager{})
+	assert.EqualError(t, err, "a url is required to send cloud events over http")
+
+	_, err = NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL:  "http://localhost",
+		Mode: "batched",
+	}, &mocks.SecretManager{})
+	assert.EqualError(t, err, "unsupported cloud events http mode [batched]")
+
+	_, err = NewHTTPSender(context.Background(), runtimeInterfaces.CloudEventsHTTPConfig{
+		URL: "http://localhost",
+		TLS: runtimeInterfaces.TLSClientConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+	}, &mocks.SecretManager{})
+	assert.Error(t, err)
+}
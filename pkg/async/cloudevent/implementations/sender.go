@@ -16,6 +16,7 @@ type Receiver = string
 
 const (
 	Kafka Receiver = "Kafka"
+	HTTP  Receiver = "http"
 )
 
 // PubSubSender Implementation of Sender
@@ -0,0 +1,349 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// The maximum number of spilled batches replayed after each flush, so that replaying a large backlog doesn't hold up
+// newly written events for too long.
+const replayBatchesPerFlush = 10
+
+// Database errors worth retrying, such as a lost connection, are surfaced with these codes. Others, such as
+// constraint violations, will fail every time.
+var transientErrorCodes = map[codes.Code]bool{
+	codes.Internal:          true,
+	codes.Unavailable:       true,
+	codes.DeadlineExceeded:  true,
+	codes.Canceled:          true,
+	codes.Aborted:           true,
+	codes.ResourceExhausted: true,
+}
+
+func isTransientError(err error) bool {
+	if flyteAdminError, ok := err.(errors.FlyteAdminError); ok {
+		return transientErrorCodes[flyteAdminError.Code()]
+	}
+	return true
+}
+
+type eventWriterMetrics struct {
+	Scope         promutils.Scope
+	QueueDepth    prometheus.Gauge
+	EventsWritten prometheus.Counter
+	WriteRetries  prometheus.Counter
+	EventsSpilled prometheus.Counter
+	EventsDropped prometheus.Counter
+	// Spilled events persisted once the database recovered.
+	EventsReplayed prometheus.Counter
+	SpilledBatches prometheus.Gauge
+	SpilledBytes   prometheus.Gauge
+}
+
+func newEventWriterMetrics(scope promutils.Scope) eventWriterMetrics {
+	return eventWriterMetrics{
+		Scope:          scope,
+		QueueDepth:     scope.MustNewGauge("queue_depth", "number of events waiting to be written"),
+		EventsWritten:  scope.MustNewCounter("events_written", "number of events written to the database"),
+		WriteRetries:   scope.MustNewCounter("write_retries", "number of retried event batch inserts"),
+		EventsSpilled:  scope.MustNewCounter("events_spilled", "number of events spilled to disk"),
+		EventsDropped:  scope.MustNewCounter("events_dropped", "number of events which were never written"),
+		EventsReplayed: scope.MustNewCounter("events_replayed", "number of spilled events written to the database"),
+		SpilledBatches: scope.MustNewGauge("spilled_batches", "number of event batches spilled to disk"),
+		SpilledBytes:   scope.MustNewGauge("spilled_bytes", "size of the event batches spilled to disk"),
+	}
+}
+
+// eventStore describes how events of type E are persisted as database models of type M, and serialized when spilled.
+type eventStore[E any, M any] struct {
+	transform   func(event E) (*M, error)
+	batchCreate func(ctx context.Context, inputs []M) error
+	create      func(ctx context.Context, input M) error
+	marshal     func(event E) ([]byte, error)
+	unmarshal   func(data []byte) (E, error)
+}
+
+// durableEventWriter asynchronously persists events in batches. Inserts failing with transient errors are retried,
+// and once retries are exhausted, or the buffer is full, events are spilled to a local on-disk queue which is replayed
+// when the database recovers.
+type durableEventWriter[E any, M any] struct {
+	store   eventStore[E, M]
+	config  runtimeInterfaces.AsyncEventsWriterConfig
+	metrics eventWriterMetrics
+	// Unset when spilling is disabled.
+	spill  *spillQueue
+	events chan E
+	// Guards closed, so that nothing is sent on events once it's closed.
+	mutex  sync.RWMutex
+	closed bool
+	// Cancelled when draining times out, which aborts retries so that the remaining events are spilled.
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (w *durableEventWriter[E, M]) Write(event E) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.closed {
+		logger.Warnf(context.TODO(), "Event writer is closed, spilling event [%+v]", event)
+		w.spillEvents([]E{event})
+		return
+	}
+	select {
+	case w.events <- event:
+		w.metrics.QueueDepth.Set(float64(len(w.events)))
+	default:
+		// Rather than holding up the caller until the database catches up.
+		w.spillEvents([]E{event})
+	}
+}
+
+func (w *durableEventWriter[E, M]) Run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.config.FlushInterval.Duration)
+	defer ticker.Stop()
+	batch := make([]E, 0, w.config.BatchSize)
+	for {
+		select {
+		case event, ok := <-w.events:
+			if !ok {
+				w.flush(batch)
+				w.replay()
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) >= w.config.BatchSize {
+				w.flush(batch)
+				batch = make([]E, 0, w.config.BatchSize)
+			}
+		case <-ticker.C:
+			w.flush(batch)
+			batch = make([]E, 0, w.config.BatchSize)
+			w.replay()
+		}
+		w.metrics.QueueDepth.Set(float64(len(w.events)))
+	}
+}
+
+func (w *durableEventWriter[E, M]) Close(ctx context.Context) error {
+	w.mutex.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.events)
+	}
+	w.mutex.Unlock()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		logger.Warnf(ctx, "Timed out draining event writer, spilling the remaining events")
+		w.cancel()
+		<-w.done
+		return ctx.Err()
+	}
+}
+
+// Sleeps before a retry, returning false if retries were aborted in the meantime.
+func (w *durableEventWriter[E, M]) backoff(attempt int) bool {
+	select {
+	case <-time.After(w.config.RetryDelay.Duration * time.Duration(1<<attempt)):
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+// Inserts the events, retrying transient failures up to retries times. Returns the events which couldn't be inserted
+// because of transient failures, those which can never be inserted are dropped.
+func (w *durableEventWriter[E, M]) persist(events []E, retries int) []E {
+	ctx := w.ctx
+	inputs := make([]M, 0, len(events))
+	transformed := make([]E, 0, len(events))
+	for _, event := range events {
+		input, err := w.store.transform(event)
+		if err != nil {
+			w.metrics.EventsDropped.Inc()
+			logger.Warnf(ctx, "Failed to transform event [%+v] to database model with err [%+v]", event, err)
+			continue
+		}
+		inputs = append(inputs, *input)
+		transformed = append(transformed, event)
+	}
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	for attempt := 0; ; attempt++ {
+		if ctx.Err() != nil {
+			return transformed
+		}
+		err := w.store.batchCreate(ctx, inputs)
+		if err == nil {
+			w.metrics.EventsWritten.Add(float64(len(inputs)))
+			return nil
+		}
+		if !isTransientError(err) {
+			// Find out which of the events is at fault, and write the others.
+			logger.Warnf(ctx, "Failed to write batch of %d events with err [%+v], writing them one at a time",
+				len(inputs), err)
+			return w.persistIndividually(transformed, inputs)
+		}
+		if attempt >= retries || !w.backoff(attempt) {
+			logger.Warnf(ctx, "Failed to write batch of %d events after %d attempts with err [%+v]",
+				len(inputs), attempt+1, err)
+			return transformed
+		}
+		w.metrics.WriteRetries.Inc()
+	}
+}
+
+func (w *durableEventWriter[E, M]) persistIndividually(events []E, inputs []M) []E {
+	var failed []E
+	for i, input := range inputs {
+		err := w.store.create(w.ctx, input)
+		if err == nil {
+			w.metrics.EventsWritten.Inc()
+			continue
+		}
+		if flyteAdminError, ok := err.(errors.FlyteAdminError); ok && flyteAdminError.Code() == codes.AlreadyExists {
+			// The event was already recorded.
+			continue
+		}
+		if isTransientError(err) {
+			failed = append(failed, events[i])
+			continue
+		}
+		w.metrics.EventsDropped.Inc()
+		logger.Warnf(w.ctx, "Failed to write event [%+v] to database with err [%+v]", events[i], err)
+	}
+	return failed
+}
+
+func (w *durableEventWriter[E, M]) flush(batch []E) {
+	if len(batch) == 0 {
+		return
+	}
+	if failed := w.persist(batch, w.config.MaxRetries); len(failed) > 0 {
+		w.spillEvents(failed)
+	}
+}
+
+func (w *durableEventWriter[E, M]) updateSpillMetrics() {
+	batches, size := w.spill.Stats()
+	w.metrics.SpilledBatches.Set(float64(batches))
+	w.metrics.SpilledBytes.Set(float64(size))
+}
+
+func (w *durableEventWriter[E, M]) spillEvents(events []E) {
+	ctx := context.TODO()
+	if w.spill == nil {
+		w.metrics.EventsDropped.Add(float64(len(events)))
+		logger.Warnf(ctx, "Dropping %d events, spilling to disk isn't configured", len(events))
+		return
+	}
+	records := make([][]byte, 0, len(events))
+	for _, event := range events {
+		record, err := w.store.marshal(event)
+		if err != nil {
+			w.metrics.EventsDropped.Inc()
+			logger.Warnf(ctx, "Failed to serialize event [%+v] with err [%+v]", event, err)
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := w.spill.Push(records); err != nil {
+		w.metrics.EventsDropped.Add(float64(len(records)))
+		logger.Errorf(ctx, "Failed to spill %d events to disk with err [%+v]", len(records), err)
+		return
+	}
+	w.metrics.EventsSpilled.Add(float64(len(records)))
+	w.updateSpillMetrics()
+}
+
+// Writes the oldest spilled batches to the database, stopping as soon as one can't be written.
+func (w *durableEventWriter[E, M]) replay() {
+	if w.spill == nil {
+		return
+	}
+	defer w.updateSpillMetrics()
+	for i := 0; i < replayBatchesPerFlush; i++ {
+		name, records, err := w.spill.Peek()
+		if len(name) == 0 {
+			return
+		}
+		if err != nil {
+			logger.Errorf(w.ctx, "Failed to read spilled events [%s], setting them aside with err [%+v]", name, err)
+			if err := w.spill.Quarantine(name); err != nil {
+				logger.Errorf(w.ctx, "Failed to set aside spilled events [%s] with err [%+v]", name, err)
+				return
+			}
+			continue
+		}
+		events := make([]E, 0, len(records))
+		for _, record := range records {
+			event, err := w.store.unmarshal(record)
+			if err != nil {
+				w.metrics.EventsDropped.Inc()
+				logger.Warnf(w.ctx, "Failed to deserialize spilled event in [%s] with err [%+v]", name, err)
+				continue
+			}
+			events = append(events, event)
+		}
+		failed := w.persist(events, 0)
+		if len(events) > 0 && len(failed) == len(events) {
+			// The database is still unavailable, try again on the next flush.
+			return
+		}
+		if len(failed) > 0 {
+			w.spillEvents(failed)
+		}
+		w.metrics.EventsReplayed.Add(float64(len(events) - len(failed)))
+		if err := w.spill.Remove(name); err != nil {
+			logger.Errorf(w.ctx, "Failed to remove replayed events [%s] with err [%+v]", name, err)
+			return
+		}
+	}
+}
+
+func newDurableEventWriter[E any, M any](store eventStore[E, M], bufferSize int,
+	config runtimeInterfaces.AsyncEventsWriterConfig, spillPrefix string, scope promutils.Scope) *durableEventWriter[E, M] {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1
+	}
+	if config.FlushInterval.Duration <= 0 {
+		config.FlushInterval.Duration = time.Second
+	}
+	var spill *spillQueue
+	if len(config.SpillDirectory) > 0 {
+		var err error
+		if spill, err = newSpillQueue(config.SpillDirectory, spillPrefix, config.MaxSpillBytes); err != nil {
+			panic(fmt.Sprintf("failed to open event spill directory [%s] with err: %v", config.SpillDirectory, err))
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &durableEventWriter[E, M]{
+		store:   store,
+		config:  config,
+		metrics: newEventWriterMetrics(scope),
+		spill:   spill,
+		events:  make(chan E, bufferSize),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	if spill != nil {
+		w.updateSpillMetrics()
+	}
+	return w
+}
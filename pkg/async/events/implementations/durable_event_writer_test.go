@@ -0,0 +1,172 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var errUnavailable = errors.NewFlyteAdminError(codes.Internal, "connection refused")
+
+// fakeEventStore persists string events, failing batches while unavailable and events named "invalid".
+type fakeEventStore struct {
+	mutex       sync.Mutex
+	unavailable bool
+	batches     int
+	written     []string
+}
+
+func (s *fakeEventStore) setUnavailable(unavailable bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.unavailable = unavailable
+}
+
+func (s *fakeEventStore) getWritten() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.written...)
+}
+
+func (s *fakeEventStore) create(_ context.Context, inputs ...string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.batches++
+	if s.unavailable {
+		return errUnavailable
+	}
+	for _, input := range inputs {
+		if input == "invalid" {
+			return errors.NewFlyteAdminError(codes.InvalidArgument, "invalid event")
+		}
+	}
+	s.written = append(s.written, inputs...)
+	return nil
+}
+
+func (s *fakeEventStore) eventStore() eventStore[string, string] {
+	return eventStore[string, string]{
+		transform: func(event string) (*string, error) {
+			if len(event) == 0 {
+				return nil, fmt.Errorf("empty event")
+			}
+			return &event, nil
+		},
+		batchCreate: func(ctx context.Context, inputs []string) error {
+			return s.create(ctx, inputs...)
+		},
+		create: func(ctx context.Context, input string) error {
+			return s.create(ctx, input)
+		},
+		marshal: func(event string) ([]byte, error) {
+			return []byte(event), nil
+		},
+		unmarshal: func(data []byte) (string, error) {
+			return string(data), nil
+		},
+	}
+}
+
+func newTestWriterConfig(t *testing.T) runtimeInterfaces.AsyncEventsWriterConfig {
+	return runtimeInterfaces.AsyncEventsWriterConfig{
+		BatchSize:      2,
+		FlushInterval:  config.Duration{Duration: time.Hour},
+		MaxRetries:     2,
+		RetryDelay:     config.Duration{Duration: time.Millisecond},
+		SpillDirectory: t.TempDir(),
+	}
+}
+
+func TestDurableEventWriter_Batches(t *testing.T) {
+	store := &fakeEventStore{}
+	writer := newDurableEventWriter(store.eventStore(), 10, newTestWriterConfig(t), "test", mockScope.NewTestScope())
+	for _, event := range []string{"a", "b", "c", "", "invalid"} {
+		writer.Write(event)
+	}
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+
+	// a and b are written in a batch, and after the batch of c and invalid fails, c on its own.
+	assert.Equal(t, []string{"a", "b", "c"}, store.getWritten())
+	assert.Equal(t, float64(3), testutil.ToFloat64(writer.metrics.EventsWritten))
+	assert.Equal(t, float64(2), testutil.ToFloat64(writer.metrics.EventsDropped))
+	assert.Equal(t, float64(0), testutil.ToFloat64(writer.metrics.EventsSpilled))
+
+	// Events written once closed are spilled rather than sent on the closed channel.
+	writer.Write("d")
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.EventsSpilled))
+}
+
+func TestDurableEventWriter_RetriesThenSpills(t *testing.T) {
+	store := &fakeEventStore{unavailable: true}
+	writerConfig := newTestWriterConfig(t)
+	writer := newDurableEventWriter(store.eventStore(), 10, writerConfig, "test", mockScope.NewTestScope())
+	writer.Write("a")
+	writer.Write("b")
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+
+	// The first attempt, two retries and the replay after draining.
+	assert.Equal(t, 4, store.batches)
+	assert.Equal(t, float64(2), testutil.ToFloat64(writer.metrics.WriteRetries))
+	assert.Equal(t, float64(2), testutil.ToFloat64(writer.metrics.EventsSpilled))
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.SpilledBatches))
+	assert.Empty(t, store.getWritten())
+
+	store.setUnavailable(false)
+	writer = newDurableEventWriter(store.eventStore(), 10, writerConfig, "test", mockScope.NewTestScope())
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Equal(t, []string{"a", "b"}, store.getWritten())
+	assert.Equal(t, float64(2), testutil.ToFloat64(writer.metrics.EventsReplayed))
+	assert.Equal(t, float64(0), testutil.ToFloat64(writer.metrics.SpilledBatches))
+}
+
+func TestDurableEventWriter_FullBuffer(t *testing.T) {
+	store := &fakeEventStore{}
+	writer := newDurableEventWriter(store.eventStore(), 1, newTestWriterConfig(t), "test", mockScope.NewTestScope())
+	// The writer isn't running, so the second event doesn't fit in the buffer and is spilled without blocking.
+	writer.Write("a")
+	writer.Write("b")
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.QueueDepth))
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.EventsSpilled))
+
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Equal(t, []string{"a", "b"}, store.getWritten())
+}
+
+func TestDurableEventWriter_SpillingDisabled(t *testing.T) {
+	store := &fakeEventStore{unavailable: true}
+	writerConfig := newTestWriterConfig(t)
+	writerConfig.SpillDirectory = ""
+	writer := newDurableEventWriter(store.eventStore(), 10, writerConfig, "test", mockScope.NewTestScope())
+	writer.Write("a")
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.EventsDropped))
+}
+
+func TestDurableEventWriter_DrainTimeout(t *testing.T) {
+	store := &fakeEventStore{unavailable: true}
+	writerConfig := newTestWriterConfig(t)
+	writerConfig.RetryDelay = config.Duration{Duration: time.Hour}
+	writer := newDurableEventWriter(store.eventStore(), 10, writerConfig, "test", mockScope.NewTestScope())
+	writer.Write("a")
+	go writer.Run()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	// Retries are abandoned and the event spilled, rather than waiting on the retry delay.
+	assert.Equal(t, context.DeadlineExceeded, writer.Close(ctx))
+	assert.Equal(t, float64(1), testutil.ToFloat64(writer.metrics.EventsSpilled))
+}
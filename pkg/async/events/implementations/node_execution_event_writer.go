@@ -3,44 +3,38 @@ package implementations
 import (
 	"context"
 
-	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
-
 	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
-	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
 )
 
+// Prefix of the names of the files node execution events are spilled to.
+const nodeExecutionSpillPrefix = "node_execution_events"
+
 // This event writer acts to asynchronously persist node execution events. As flytepropeller sends node
 // events, node execution processing doesn't have to wait on these to be committed.
-type nodeExecutionEventWriter struct {
-	db     repositoryInterfaces.Repository
-	events chan admin.NodeExecutionEventRequest
-}
-
-func (w *nodeExecutionEventWriter) Write(event admin.NodeExecutionEventRequest) {
-	w.events <- event
-}
-
-func (w *nodeExecutionEventWriter) Run() {
-	for event := range w.events {
-		eventModel, err := transformers.CreateNodeExecutionEventModel(event)
-		if err != nil {
-			logger.Warnf(context.TODO(), "Failed to transform event [%+v] to database model with err [%+v]", event, err)
-			continue
-		}
-		err = w.db.NodeExecutionEventRepo().Create(context.TODO(), *eventModel)
-		if err != nil {
-			// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
-			// to replay and understand the event execution timeline.
-			logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", event, err)
-		}
-	}
-}
-
-func NewNodeExecutionEventWriter(db repositoryInterfaces.Repository, bufferSize int) interfaces.NodeExecutionEventWriter {
-	return &nodeExecutionEventWriter{
-		db:     db,
-		events: make(chan admin.NodeExecutionEventRequest, bufferSize),
-	}
+func NewNodeExecutionEventWriter(db repositoryInterfaces.Repository, bufferSize int,
+	config runtimeInterfaces.AsyncEventsWriterConfig, scope promutils.Scope) interfaces.NodeExecutionEventWriter {
+	return newDurableEventWriter(eventStore[admin.NodeExecutionEventRequest, models.NodeExecutionEvent]{
+		transform: transformers.CreateNodeExecutionEventModel,
+		batchCreate: func(ctx context.Context, inputs []models.NodeExecutionEvent) error {
+			return db.NodeExecutionEventRepo().BatchCreate(ctx, inputs)
+		},
+		create: func(ctx context.Context, input models.NodeExecutionEvent) error {
+			return db.NodeExecutionEventRepo().Create(ctx, input)
+		},
+		marshal: func(event admin.NodeExecutionEventRequest) ([]byte, error) {
+			return proto.Marshal(&event)
+		},
+		unmarshal: func(data []byte) (admin.NodeExecutionEventRequest, error) {
+			var event admin.NodeExecutionEventRequest
+			err := proto.Unmarshal(data, &event)
+			return event, err
+		},
+	}, bufferSize, config, nodeExecutionSpillPrefix, scope)
 }
@@ -1,12 +1,19 @@
 package implementations
 
 import (
+	"context"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNodeExecutionEventWriter(t *testing.T) {
@@ -23,15 +30,60 @@ func TestNodeExecutionEventWriter(t *testing.T) {
 					Name:    "exec_name",
 				},
 			},
+			Phase:      core.NodeExecution_RUNNING,
+			OccurredAt: ptypes.TimestampNow(),
 		},
 	}
 
 	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
-	nodeExecEventRepo.On("Create", event).Return(nil)
+	var written []models.NodeExecutionEvent
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		written = append(written, args.Get(1).([]models.NodeExecutionEvent)...)
+	}).Return(nil)
 	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
-	writer := NewNodeExecutionEventWriter(db, 100)
+	writer := NewNodeExecutionEventWriter(db, 100, runtimeInterfaces.AsyncEventsWriterConfig{BatchSize: 10},
+		mockScope.NewTestScope())
 	// Assert we can write an event using the buffered channel without holding up this process.
 	writer.Write(event)
 	go func() { writer.Run() }()
-	close(writer.(*nodeExecutionEventWriter).events)
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Len(t, written, 1)
+	assert.Equal(t, "node_id", written[0].NodeExecutionKey.NodeID)
+}
+
+func TestNodeExecutionEventWriter_SpillAndReplay(t *testing.T) {
+	db := mocks.NewMockRepository()
+	event := admin.NodeExecutionEventRequest{
+		RequestId: "request_id",
+		Event: &event2.NodeExecutionEvent{
+			Id: &core.NodeExecutionIdentifier{
+				NodeId:      "node_id",
+				ExecutionId: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "exec_name"},
+			},
+			Phase:      core.NodeExecution_SUCCEEDED,
+			OccurredAt: ptypes.TimestampNow(),
+		},
+	}
+	config := runtimeInterfaces.AsyncEventsWriterConfig{BatchSize: 10, SpillDirectory: t.TempDir()}
+
+	unavailableRepo := mocks.NodeExecutionEventRepoInterface{}
+	unavailableRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(errUnavailable)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &unavailableRepo
+	writer := NewNodeExecutionEventWriter(db, 100, config, mockScope.NewTestScope())
+	writer.Write(event)
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+
+	// The spilled event is read back from disk by the next writer once the database is available.
+	availableRepo := mocks.NodeExecutionEventRepoInterface{}
+	var written []models.NodeExecutionEvent
+	availableRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		written = append(written, args.Get(1).([]models.NodeExecutionEvent)...)
+	}).Return(nil)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &availableRepo
+	writer = NewNodeExecutionEventWriter(db, 100, config, mockScope.NewTestScope())
+	go writer.Run()
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Len(t, written, 1)
+	assert.Equal(t, "request_id", written[0].RequestID)
 }
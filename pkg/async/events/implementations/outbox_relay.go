@@ -0,0 +1,161 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudeventInterfaces "github.com/flyteorg/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type outboxRelayMetrics struct {
+	Scope           promutils.Scope
+	EventsPublished prometheus.Counter
+	PublishErrors   prometheus.Counter
+	// Events which are no longer attempted, having failed to publish too many times.
+	EventsAbandoned prometheus.Counter
+	RelayErrors     prometheus.Counter
+}
+
+// outboxRelay publishes the events recorded in the outbox, alongside the execution state changes they describe, to the
+// external events and cloud events publishers. Events of an execution are published in the order they were recorded,
+// so once one fails to publish the following ones are held back until it's retried.
+type outboxRelay struct {
+	repo       repositoryInterfaces.OutboxEventRepoInterface
+	publishers map[string]notificationInterfaces.Publisher
+	config     runtimeInterfaces.EventsOutboxConfig
+	metrics    outboxRelayMetrics
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func (r *outboxRelay) Run() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.config.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			// Full batches are followed by another straight away, until the backlog is cleared.
+			for {
+				caughtUp, err := r.relay(context.Background())
+				if err != nil {
+					r.metrics.RelayErrors.Inc()
+					logger.Warnf(context.TODO(), "Failed to relay outbox events with err [%+v]", err)
+				}
+				if err != nil || caughtUp {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (r *outboxRelay) Close(ctx context.Context) error {
+	close(r.stop)
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *outboxRelay) publish(ctx context.Context, event models.OutboxEvent) error {
+	publisher, ok := r.publishers[event.Sink]
+	if !ok {
+		return fmt.Errorf("unknown outbox event sink [%s]", event.Sink)
+	}
+	request, err := transformers.FromOutboxEventModel(event)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(context.WithValue(ctx, common.DeduplicationKey, event.DeduplicationKey), event.EventType,
+		request)
+}
+
+// Publishes a batch of pending events and deletes the ones published past the retention period. Returns whether all
+// pending events were published.
+func (r *outboxRelay) relay(ctx context.Context) (bool, error) {
+	caughtUp := true
+	// Events listed from a lagging read replica would be published again.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
+	_, err := r.repo.RunExclusively(ctx, func(ctx context.Context) error {
+		events, err := r.repo.ListPending(ctx, r.config.BatchSize, r.config.MaxAttempts)
+		if err != nil {
+			return err
+		}
+		published := make([]uint, 0, len(events))
+		// Executions, per sink, with events held back behind one which failed to publish.
+		heldBack := sets.NewString()
+		for _, event := range events {
+			key := fmt.Sprintf("%s/%s/%s/%s", event.Sink, event.ExecutionProject, event.ExecutionDomain,
+				event.ExecutionName)
+			if heldBack.Has(key) {
+				continue
+			}
+			if err := r.publish(ctx, event); err != nil {
+				heldBack.Insert(key)
+				r.metrics.PublishErrors.Inc()
+				if event.Attempts+1 >= r.config.MaxAttempts {
+					r.metrics.EventsAbandoned.Inc()
+					logger.Errorf(ctx, "Giving up on publishing outbox event [%s] to [%s] after %d attempts with err [%+v]",
+						event.DeduplicationKey, event.Sink, event.Attempts+1, err)
+				} else {
+					logger.Warnf(ctx, "Failed to publish outbox event [%s] to [%s] with err [%+v]",
+						event.DeduplicationKey, event.Sink, err)
+				}
+				if err := r.repo.MarkFailed(ctx, event.ID, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			published = append(published, event.ID)
+		}
+		if err := r.repo.MarkPublished(ctx, published); err != nil {
+			return err
+		}
+		r.metrics.EventsPublished.Add(float64(len(published)))
+		caughtUp = len(events) < r.config.BatchSize || len(published) < len(events)
+		return r.repo.DeletePublished(ctx, time.Now().Add(-r.config.RetentionPeriod.Duration))
+	})
+	return caughtUp, err
+}
+
+// NewOutboxRelay returns a relay publishing the events recorded in the outbox to the external events publisher and
+// the cloud events publisher.
+func NewOutboxRelay(repo repositoryInterfaces.OutboxEventRepoInterface, config runtimeInterfaces.EventsOutboxConfig,
+	eventPublisher notificationInterfaces.Publisher, cloudEventPublisher cloudeventInterfaces.Publisher,
+	scope promutils.Scope) interfaces.OutboxRelay {
+	return &outboxRelay{
+		repo: repo,
+		publishers: map[string]notificationInterfaces.Publisher{
+			models.OutboxSinkExternalEvents: eventPublisher,
+			models.OutboxSinkCloudEvents:    cloudEventPublisher,
+		},
+		config: config,
+		metrics: outboxRelayMetrics{
+			Scope:           scope,
+			EventsPublished: scope.MustNewCounter("events_published", "number of outbox events published"),
+			PublishErrors:   scope.MustNewCounter("publish_errors", "number of failed attempts to publish outbox events"),
+			EventsAbandoned: scope.MustNewCounter("events_abandoned",
+				"number of outbox events which are no longer attempted after failing to publish too many times"),
+			RelayErrors: scope.MustNewCounter("relay_errors", "number of failures reading or updating the outbox"),
+		},
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
@@ -0,0 +1,138 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testOutboxConfig = runtimeInterfaces.EventsOutboxConfig{
+	Enabled:         true,
+	PollInterval:    config.Duration{Duration: time.Millisecond},
+	BatchSize:       10,
+	MaxAttempts:     3,
+	RetentionPeriod: config.Duration{Duration: time.Hour},
+}
+
+func getTestOutboxEvents(t *testing.T, id uint, name string, phase core.WorkflowExecution_Phase) []models.OutboxEvent {
+	events, err := transformers.CreateOutboxEventModels(
+		[]string{models.OutboxSinkExternalEvents, models.OutboxSinkCloudEvents}, &admin.WorkflowExecutionEventRequest{
+			Event: &event.WorkflowExecutionEvent{
+				ExecutionId: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: name},
+				Phase:       phase,
+			},
+		})
+	assert.NoError(t, err)
+	for i := range events {
+		events[i].ID = id + uint(i)
+	}
+	return events
+}
+
+func newTestOutboxRepo() *repositoryMocks.OutboxEventRepoInterface {
+	repo := &repositoryMocks.OutboxEventRepoInterface{}
+	repo.OnRunExclusivelyMatch(mock.Anything, mock.Anything).Call.Return(true,
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	repo.OnDeletePublishedMatch(mock.Anything, mock.Anything).Return(nil)
+	return repo
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	// Two events per execution, one per sink.
+	pending := append(getTestOutboxEvents(t, 1, "a", core.WorkflowExecution_RUNNING),
+		getTestOutboxEvents(t, 3, "b", core.WorkflowExecution_RUNNING)...)
+	pending = append(pending, getTestOutboxEvents(t, 5, "a", core.WorkflowExecution_SUCCEEDED)...)
+	repo := newTestOutboxRepo()
+	repo.OnListPendingMatch(mock.Anything, 10, 3).Return(pending, nil)
+	var published []uint
+	repo.OnMarkPublishedMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(1).([]uint)
+	}).Return(nil)
+	repo.OnMarkFailedMatch(mock.Anything, uint(2), "unavailable").Return(nil)
+
+	var eventPublisher notificationMocks.MockPublisher
+	var externalEvents []string
+	eventPublisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "flyteidl.admin.WorkflowExecutionEventRequest", key)
+		externalEvents = append(externalEvents, ctx.Value(common.DeduplicationKey).(string))
+		return nil
+	})
+	var cloudEventPublisher notificationMocks.MockPublisher
+	cloudEventPublisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		if msg.(*admin.WorkflowExecutionEventRequest).Event.ExecutionId.Name == "a" {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	relay := NewOutboxRelay(repo, testOutboxConfig, &eventPublisher, &cloudEventPublisher, promutils.NewTestScope())
+
+	caughtUp, err := relay.(*outboxRelay).relay(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, caughtUp)
+	assert.Equal(t, []string{pending[0].DeduplicationKey, pending[2].DeduplicationKey, pending[4].DeduplicationKey},
+		externalEvents)
+	// The cloud event of a's later phase is held back behind the one which failed to publish.
+	assert.Equal(t, []uint{1, 3, 4, 5}, published)
+	repo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_RelayNotCaughtUp(t *testing.T) {
+	config := testOutboxConfig
+	config.BatchSize = 2
+	repo := newTestOutboxRepo()
+	repo.OnListPendingMatch(mock.Anything, 2, 3).Return(getTestOutboxEvents(t, 1, "a", core.WorkflowExecution_RUNNING), nil)
+	repo.OnMarkPublishedMatch(mock.Anything, []uint{1, 2}).Return(nil)
+	relay := NewOutboxRelay(repo, config, &notificationMocks.MockPublisher{}, &notificationMocks.MockPublisher{},
+		promutils.NewTestScope())
+
+	caughtUp, err := relay.(*outboxRelay).relay(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, caughtUp)
+}
+
+func TestOutboxRelay_RelayListError(t *testing.T) {
+	repo := newTestOutboxRepo()
+	expected := errors.New("connection refused")
+	repo.OnListPendingMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil, expected)
+	relay := NewOutboxRelay(repo, testOutboxConfig, &notificationMocks.MockPublisher{},
+		&notificationMocks.MockPublisher{}, promutils.NewTestScope())
+
+	_, err := relay.(*outboxRelay).relay(context.Background())
+	assert.Equal(t, expected, err)
+}
+
+func TestOutboxRelay_RunAndClose(t *testing.T) {
+	repo := newTestOutboxRepo()
+	listed := make(chan struct{}, 1)
+	repo.OnListPendingMatch(mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		select {
+		case listed <- struct{}{}:
+		default:
+		}
+	}).Return(nil, nil)
+	repo.OnMarkPublishedMatch(mock.Anything, mock.Anything).Return(nil)
+	relay := NewOutboxRelay(repo, testOutboxConfig, &notificationMocks.MockPublisher{},
+		&notificationMocks.MockPublisher{}, promutils.NewTestScope())
+
+	go relay.Run()
+	<-listed
+	assert.NoError(t, relay.Close(context.Background()))
+}
@@ -0,0 +1,191 @@
+package implementations
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	spillFileExtension = ".events"
+	// Spilled batches which can't be decoded are renamed with this extension and left for operators to inspect.
+	corruptSpillFileExtension = ".corrupt"
+)
+
+var errSpillQueueFull = fmt.Errorf("spill queue is full")
+
+// spillQueue is a local on-disk FIFO of batches of serialized events. Each batch is stored in its own file as a
+// sequence of uvarint length-prefixed records, named so that lexical order is the order batches were pushed in.
+type spillQueue struct {
+	mutex     sync.Mutex
+	directory string
+	prefix    string
+	maxBytes  int64
+	// Names of the spilled files, oldest first.
+	files    []string
+	sizes    map[string]int64
+	size     int64
+	sequence uint64
+}
+
+func (q *spillQueue) isSpillFile(name string) bool {
+	return strings.HasPrefix(name, q.prefix+"-") && strings.HasSuffix(name, spillFileExtension)
+}
+
+// Push writes a batch of records to disk. It fails with errSpillQueueFull once maxBytes would be exceeded.
+func (q *spillQueue) Push(records [][]byte) error {
+	var buf bytes.Buffer
+	length := make([]byte, binary.MaxVarintLen64)
+	for _, record := range records {
+		n := binary.PutUvarint(length, uint64(len(record)))
+		buf.Write(length[:n])
+		buf.Write(record)
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.maxBytes > 0 && q.size+int64(buf.Len()) > q.maxBytes {
+		return errSpillQueueFull
+	}
+	q.sequence++
+	name := fmt.Sprintf("%s-%020d-%010d%s", q.prefix, time.Now().UnixNano(), q.sequence, spillFileExtension)
+	// The batch is written to a temporary file first so that a crash never leaves a partial batch in the queue.
+	tmpPath := filepath.Join(q.directory, "."+name)
+	if err := os.WriteFile(tmpPath, buf.Bytes(), 0600); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, filepath.Join(q.directory, name)); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	q.files = append(q.files, name)
+	q.sizes[name] = int64(buf.Len())
+	q.size += int64(buf.Len())
+	return nil
+}
+
+func decodeSpilledRecords(data []byte) ([][]byte, error) {
+	var records [][]byte
+	reader := bytes.NewReader(data)
+	for reader.Len() > 0 {
+		length, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(reader.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		record := make([]byte, length)
+		if _, err := io.ReadFull(reader, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Peek returns the name and records of the oldest batch, or an empty name when the queue is empty. The batch stays
+// queued until it's removed.
+func (q *spillQueue) Peek() (string, [][]byte, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.files) == 0 {
+		return "", nil, nil
+	}
+	name := q.files[0]
+	data, err := os.ReadFile(filepath.Join(q.directory, name))
+	if err != nil {
+		return name, nil, err
+	}
+	records, err := decodeSpilledRecords(data)
+	return name, records, err
+}
+
+func (q *spillQueue) forget(name string) {
+	for i, file := range q.files {
+		if file == name {
+			q.files = append(q.files[:i], q.files[i+1:]...)
+			break
+		}
+	}
+	q.size -= q.sizes[name]
+	delete(q.sizes, name)
+}
+
+// Remove deletes a batch once its events have been persisted.
+func (q *spillQueue) Remove(name string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err := os.Remove(filepath.Join(q.directory, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	q.forget(name)
+	return nil
+}
+
+// Quarantine takes a batch which can't be read out of the queue, keeping the file around for inspection.
+func (q *spillQueue) Quarantine(name string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	path := filepath.Join(q.directory, name)
+	if err := os.Rename(path, path+corruptSpillFileExtension); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	q.forget(name)
+	return nil
+}
+
+// Stats returns the number of queued batches and their total size in bytes.
+func (q *spillQueue) Stats() (int, int64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.files), q.size
+}
+
+// newSpillQueue opens the queue of batches spilled to directory with the given file name prefix, picking up those
+// left behind by a previous run.
+func newSpillQueue(directory, prefix string, maxBytes int64) (*spillQueue, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, err
+	}
+	q := &spillQueue{
+		directory: directory,
+		prefix:    prefix,
+		maxBytes:  maxBytes,
+		sizes:     make(map[string]int64),
+	}
+	// os.ReadDir returns entries sorted by name, which is the order batches were pushed in.
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(name, ".") && q.isSpillFile(strings.TrimPrefix(name, ".")) {
+			// A batch whose write was interrupted, it was never acknowledged as spilled.
+			_ = os.Remove(filepath.Join(directory, name))
+			continue
+		}
+		if !q.isSpillFile(name) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		q.files = append(q.files, name)
+		q.sizes[name] = info.Size()
+		q.size += info.Size()
+	}
+	return q, nil
+}
@@ -0,0 +1,72 @@
+package implementations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpillQueue(t *testing.T) {
+	directory := t.TempDir()
+	queue, err := newSpillQueue(directory, "test", 0)
+	assert.NoError(t, err)
+
+	name, records, err := queue.Peek()
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+	assert.Empty(t, records)
+
+	assert.NoError(t, queue.Push([][]byte{[]byte("a"), {}, []byte("b")}))
+	assert.NoError(t, queue.Push([][]byte{[]byte("c")}))
+	batches, size := queue.Stats()
+	assert.Equal(t, 2, batches)
+	assert.Equal(t, int64(7), size)
+
+	// Queued batches are picked up again after a restart, along with batches of other queues sharing the directory
+	// being left alone.
+	other, err := newSpillQueue(directory, "other", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, other.Push([][]byte{[]byte("d")}))
+	queue, err = newSpillQueue(directory, "test", 0)
+	assert.NoError(t, err)
+
+	name, records, err = queue.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), {}, []byte("b")}, records)
+	assert.NoError(t, queue.Remove(name))
+
+	name, records, err = queue.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("c")}, records)
+	assert.NoError(t, queue.Remove(name))
+
+	batches, size = queue.Stats()
+	assert.Equal(t, 0, batches)
+	assert.Equal(t, int64(0), size)
+}
+
+func TestSpillQueue_Full(t *testing.T) {
+	queue, err := newSpillQueue(t.TempDir(), "test", 4)
+	assert.NoError(t, err)
+	assert.NoError(t, queue.Push([][]byte{[]byte("ab")}))
+	assert.Equal(t, errSpillQueueFull, queue.Push([][]byte{[]byte("cd")}))
+}
+
+func TestSpillQueue_Corrupt(t *testing.T) {
+	directory := t.TempDir()
+	queue, err := newSpillQueue(directory, "test", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, queue.Push([][]byte{[]byte("abc")}))
+	name, _, err := queue.Peek()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(directory, name), []byte{5, 'a'}, 0600))
+
+	_, _, err = queue.Peek()
+	assert.Error(t, err)
+	assert.NoError(t, queue.Quarantine(name))
+	batches, _ := queue.Stats()
+	assert.Equal(t, 0, batches)
+	assert.FileExists(t, filepath.Join(directory, name+corruptSpillFileExtension))
+}
@@ -3,44 +3,38 @@ package implementations
 import (
 	"context"
 
-	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
-
 	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
-	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
 )
 
+// Prefix of the names of the files workflow execution events are spilled to.
+const workflowExecutionSpillPrefix = "workflow_execution_events"
+
 // This event writer acts to asynchronously persist workflow execution events. As flytepropeller sends workflow
 // events, workflow execution processing doesn't have to wait on these to be committed.
-type workflowExecutionEventWriter struct {
-	db     repositoryInterfaces.Repository
-	events chan admin.WorkflowExecutionEventRequest
-}
-
-func (w *workflowExecutionEventWriter) Write(event admin.WorkflowExecutionEventRequest) {
-	w.events <- event
-}
-
-func (w *workflowExecutionEventWriter) Run() {
-	for event := range w.events {
-		eventModel, err := transformers.CreateExecutionEventModel(event)
-		if err != nil {
-			logger.Warnf(context.TODO(), "Failed to transform event [%+v] to database model with err [%+v]", event, err)
-			continue
-		}
-		err = w.db.ExecutionEventRepo().Create(context.TODO(), *eventModel)
-		if err != nil {
-			// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
-			// to replay and understand the event execution timeline.
-			logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", event, err)
-		}
-	}
-}
-
-func NewWorkflowExecutionEventWriter(db repositoryInterfaces.Repository, bufferSize int) interfaces.WorkflowExecutionEventWriter {
-	return &workflowExecutionEventWriter{
-		db:     db,
-		events: make(chan admin.WorkflowExecutionEventRequest, bufferSize),
-	}
+func NewWorkflowExecutionEventWriter(db repositoryInterfaces.Repository, bufferSize int,
+	config runtimeInterfaces.AsyncEventsWriterConfig, scope promutils.Scope) interfaces.WorkflowExecutionEventWriter {
+	return newDurableEventWriter(eventStore[admin.WorkflowExecutionEventRequest, models.ExecutionEvent]{
+		transform: transformers.CreateExecutionEventModel,
+		batchCreate: func(ctx context.Context, inputs []models.ExecutionEvent) error {
+			return db.ExecutionEventRepo().BatchCreate(ctx, inputs)
+		},
+		create: func(ctx context.Context, input models.ExecutionEvent) error {
+			return db.ExecutionEventRepo().Create(ctx, input)
+		},
+		marshal: func(event admin.WorkflowExecutionEventRequest) ([]byte, error) {
+			return proto.Marshal(&event)
+		},
+		unmarshal: func(data []byte) (admin.WorkflowExecutionEventRequest, error) {
+			var event admin.WorkflowExecutionEventRequest
+			err := proto.Unmarshal(data, &event)
+			return event, err
+		},
+	}, bufferSize, config, workflowExecutionSpillPrefix, scope)
 }
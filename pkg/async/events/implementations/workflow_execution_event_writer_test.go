@@ -1,12 +1,19 @@
 package implementations
 
 import (
+	"context"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestWorkflowExecutionEventWriter(t *testing.T) {
@@ -20,15 +27,24 @@ func TestWorkflowExecutionEventWriter(t *testing.T) {
 				Domain:  "domain",
 				Name:    "exec_name",
 			},
+			Phase:      core.WorkflowExecution_RUNNING,
+			OccurredAt: ptypes.TimestampNow(),
 		},
 	}
 
 	workflowExecEventRepo := mocks.ExecutionEventRepoInterface{}
-	workflowExecEventRepo.On("Create", event).Return(nil)
+	var written []models.ExecutionEvent
+	workflowExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		written = append(written, args.Get(1).([]models.ExecutionEvent)...)
+	}).Return(nil)
 	db.(*mocks.MockRepository).ExecutionEventRepoIface = &workflowExecEventRepo
-	writer := NewWorkflowExecutionEventWriter(db, 100)
+	writer := NewWorkflowExecutionEventWriter(db, 100, runtimeInterfaces.AsyncEventsWriterConfig{BatchSize: 10},
+		mockScope.NewTestScope())
 	// Assert we can write an event using the buffered channel without holding up this process.
 	writer.Write(event)
 	go func() { writer.Run() }()
-	close(writer.(*workflowExecutionEventWriter).events)
+	assert.NoError(t, writer.Close(context.Background()))
+	assert.Len(t, written, 1)
+	assert.Equal(t, "request_id", written[0].RequestID)
+	assert.Equal(t, "exec_name", written[0].ExecutionKey.Name)
 }
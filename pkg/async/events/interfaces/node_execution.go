@@ -1,12 +1,18 @@
 package interfaces
 
 import (
+	"context"
+
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 //go:generate mockery -name=NodeExecutionEventWriter -output=../mocks -case=underscore
 
 type NodeExecutionEventWriter interface {
+	// Persists written events until Close is called.
 	Run()
+	// Queues an event to be persisted without blocking the caller.
 	Write(nodeExecutionEvent admin.NodeExecutionEventRequest)
+	// Stops accepting events and waits, at most until ctx is done, for the queued ones to be persisted.
+	Close(ctx context.Context) error
 }
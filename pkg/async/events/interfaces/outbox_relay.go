@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+)
+
+//go:generate mockery -name=OutboxRelay -output=../mocks -case=underscore
+
+type OutboxRelay interface {
+	// Publishes the events recorded in the outbox until Close is called.
+	Run()
+	// Stops publishing and waits, at most until ctx is done, for the events being published.
+	Close(ctx context.Context) error
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	admin "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +15,20 @@ type NodeExecutionEventWriter struct {
 	mock.Mock
 }
 
+// Close provides a mock function with given fields: ctx
+func (_m *NodeExecutionEventWriter) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields:
 func (_m *NodeExecutionEventWriter) Run() {
 	_m.Called()
@@ -109,18 +109,97 @@ func substituteEmailParameters(message string, request admin.WorkflowExecutionEv
 	return message
 }
 
+// Returns the template configured for a notification type, or the emailer template when it isn't set.
+func getTemplate(template, emailerTemplate string) string {
+	if len(template) > 0 {
+		return template
+	}
+	return emailerTemplate
+}
+
+// renderMessage renders the subject and body templates with the configured template engine.
+func renderMessage(config runtimeInterfaces.NotificationsConfig, templates NotificationTemplates, html bool,
+	request admin.WorkflowExecutionEventRequest, execution *admin.Execution) (*admin.EmailMessage, error) {
+	engine, err := GetTemplateEngine(config.TemplateEngine)
+	if err != nil {
+		return nil, err
+	}
+	data := NewTemplateData(config, request, execution)
+	subject, err := engine.Render(templates.Subject, data, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render subject template with err: %w", err)
+	}
+	body, err := engine.Render(templates.Body, data, html)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render body template with err: %w", err)
+	}
+	return &admin.EmailMessage{
+		SubjectLine: subject,
+		Body:        body,
+	}, nil
+}
+
 // Converts a terminal execution event and existing execution model to an admin.EmailMessage proto, substituting parameters
-// in customizable email fields set in the flyteadmin application notifications config.
+// in customizable email fields set in the flyteadmin application notifications config. The templates can be overridden
+// per project, and through the execution's matching attributes.
 func ToEmailMessageFromWorkflowExecutionEvent(
 	config runtimeInterfaces.NotificationsConfig,
 	emailNotification admin.EmailNotification,
 	request admin.WorkflowExecutionEventRequest,
-	execution *admin.Execution) *admin.EmailMessage {
+	execution *admin.Execution,
+	attributes map[string]string) (*admin.EmailMessage, error) {
+
+	templates := getNotificationTemplates(config, NotificationTemplates{
+		Subject: config.NotificationsEmailerConfig.Subject,
+		Body:    config.NotificationsEmailerConfig.Body,
+	}, emailTriggerType, execution, attributes)
+	message, err := renderMessage(config, templates, true, request, execution)
+	if err != nil {
+		return nil, err
+	}
+	message.SenderEmail = config.NotificationsEmailerConfig.Sender
+	message.RecipientsEmail = emailNotification.GetRecipientsEmail()
+	return message, nil
+}
 
-	return &admin.EmailMessage{
-		SubjectLine:     substituteEmailParameters(config.NotificationsEmailerConfig.Subject, request, execution),
-		SenderEmail:     config.NotificationsEmailerConfig.Sender,
-		RecipientsEmail: emailNotification.GetRecipientsEmail(),
-		Body:            substituteEmailParameters(config.NotificationsEmailerConfig.Body, request, execution),
+// Converts a terminal execution event and existing execution model to the message natively delivered to Slack, using
+// the slack subject and body templates when they're set.
+func ToSlackMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsConfig,
+	slackNotification admin.SlackNotification,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution,
+	attributes map[string]string) (*admin.EmailMessage, error) {
+
+	templates := getNotificationTemplates(config, NotificationTemplates{
+		Subject: getTemplate(config.NotificationsSlackConfig.Subject, config.NotificationsEmailerConfig.Subject),
+		Body:    getTemplate(config.NotificationsSlackConfig.Body, config.NotificationsEmailerConfig.Body),
+	}, slackTriggerType, execution, attributes)
+	message, err := renderMessage(config, templates, false, request, execution)
+	if err != nil {
+		return nil, err
+	}
+	message.RecipientsEmail = slackNotification.GetRecipientsEmail()
+	return message, nil
+}
+
+// Converts a terminal execution event and existing execution model to the alert natively triggered in PagerDuty, using
+// the pagerduty subject and body templates when they're set.
+func ToPagerDutyMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsConfig,
+	pagerDutyNotification admin.PagerDutyNotification,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution,
+	attributes map[string]string) (*admin.EmailMessage, error) {
+
+	templates := getNotificationTemplates(config, NotificationTemplates{
+		Subject: getTemplate(config.NotificationsPagerDutyConfig.Subject, config.NotificationsEmailerConfig.Subject),
+		Body:    getTemplate(config.NotificationsPagerDutyConfig.Body, config.NotificationsEmailerConfig.Body),
+	}, pagerDutyTriggerType, execution, attributes)
+	message, err := renderMessage(config, templates, false, request, execution)
+	if err != nil {
+		return nil, err
 	}
+	message.RecipientsEmail = pagerDutyNotification.GetRecipientsEmail()
+	return message, nil
 }
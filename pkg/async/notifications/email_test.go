@@ -147,7 +147,8 @@ func TestToEmailMessageFromWorkflowExecutionEvent(t *testing.T) {
 			Phase: core.WorkflowExecution_ABORTED,
 		},
 	}
-	emailMessage := ToEmailMessageFromWorkflowExecutionEvent(notificationsConfig, emailNotification, request, workflowExecution)
+	emailMessage, err := ToEmailMessageFromWorkflowExecutionEvent(notificationsConfig, emailNotification, request, workflowExecution, nil)
+	assert.NoError(t, err)
 	assert.True(t, proto.Equal(emailMessage, &admin.EmailMessage{
 		RecipientsEmail: []string{
 			"a@example.com", "b@example.org",
@@ -159,3 +160,60 @@ func TestToEmailMessageFromWorkflowExecutionEvent(t *testing.T) {
 			"https://example.com/executions/proj/prod/e124</a>.",
 	}), fmt.Sprintf("%+v", emailMessage))
 }
+
+func TestToSlackMessageFromWorkflowExecutionEvent(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Body:    "<a href=\"https://example.com/executions/{{ project }}/{{ domain }}/{{ name }}\">details</a>",
+			Sender:  "no-reply@example.com",
+			Subject: "Notice: Execution \"{{ name }}\" has {{ phase }} in \"{{ domain }}\".",
+		},
+		NotificationsSlackConfig: runtimeInterfaces.NotificationsSlackConfig{
+			Body: "<https://example.com/executions/{{ project }}/{{ domain }}/{{ name }}|details>",
+		},
+	}
+	slackNotification := admin.SlackNotification{
+		RecipientsEmail: []string{"#alerts"},
+	}
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+		},
+	}
+	slackMessage, err := ToSlackMessageFromWorkflowExecutionEvent(notificationsConfig, slackNotification, request,
+		workflowExecution, nil)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(slackMessage, &admin.EmailMessage{
+		RecipientsEmail: []string{"#alerts"},
+		SubjectLine:     "Notice: Execution \"e124\" has failed in \"prod\".",
+		Body:            "<https://example.com/executions/proj/prod/e124|details>",
+	}), fmt.Sprintf("%+v", slackMessage))
+}
+
+func TestToPagerDutyMessageFromWorkflowExecutionEvent(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Body:    "Execution \"{{ name }}\" has {{ phase }}.",
+			Subject: "Notice: Execution \"{{ name }}\" has {{ phase }} in \"{{ domain }}\".",
+		},
+		NotificationsPagerDutyConfig: runtimeInterfaces.NotificationsPagerDutyConfig{
+			Subject: "{{ project }}/{{ domain }}/{{ name }} {{ phase }}",
+		},
+	}
+	pagerDutyNotification := admin.PagerDutyNotification{
+		RecipientsEmail: []string{"routing-key"},
+	}
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_TIMED_OUT,
+		},
+	}
+	pagerDutyMessage, err := ToPagerDutyMessageFromWorkflowExecutionEvent(notificationsConfig, pagerDutyNotification, request,
+		workflowExecution, nil)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(pagerDutyMessage, &admin.EmailMessage{
+		RecipientsEmail: []string{"routing-key"},
+		SubjectLine:     "proj/prod/e124 timed_out",
+		Body:            "Execution \"e124\" has timed_out.",
+	}), fmt.Sprintf("%+v", pagerDutyMessage))
+}
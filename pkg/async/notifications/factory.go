@@ -10,6 +10,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 
@@ -21,14 +22,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/ses"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytepropeller/pkg/controller/nodes/task/secretmanager"
 	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
 )
 
 const maxRetries = 3
 
 var enable64decoding = false
 
-var msgChan chan []byte
+var msgChan chan implementations.SandboxMessage
 var once sync.Once
 
 type PublisherConfig struct {
@@ -48,7 +52,7 @@ type EmailerConfig struct {
 // For sandbox only
 func CreateMsgChan() {
 	once.Do(func() {
-		msgChan = make(chan []byte)
+		msgChan = make(chan implementations.SandboxMessage)
 	})
 }
 
@@ -85,7 +89,43 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 	}
 }
 
-func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Processor {
+// GetSenders returns the senders natively delivering notification types other than email, keyed by the notification
+// type they are published with.
+func GetSenders(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) implementations.Senders {
+	senders := make(implementations.Senders)
+	if !config.NotificationsSlackConfig.Enabled && !config.NotificationsPagerDutyConfig.Enabled &&
+		len(config.NotificationsWebhookConfig.Webhooks) == 0 {
+		return senders
+	}
+	ctx := context.Background()
+	sm := secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig())
+	if config.NotificationsSlackConfig.Enabled {
+		sender, err := implementations.NewSlackSender(ctx, config.NotificationsSlackConfig, sm, scope)
+		if err != nil {
+			panic(err)
+		}
+		senders[proto.MessageName(&admin.SlackNotification{})] = sender
+	}
+	if config.NotificationsPagerDutyConfig.Enabled {
+		sender, err := implementations.NewPagerDutySender(ctx, config.NotificationsPagerDutyConfig, sm, scope)
+		if err != nil {
+			panic(err)
+		}
+		senders[proto.MessageName(&admin.PagerDutyNotification{})] = sender
+	}
+	if len(config.NotificationsWebhookConfig.Webhooks) > 0 {
+		sender, err := implementations.NewWebhookSender(ctx, config.NotificationsWebhookConfig, sm, scope)
+		if err != nil {
+			panic(err)
+		}
+		senders[WebhookNotificationType] = sender
+	}
+	return senders
+}
+
+// The repo records dead-lettered notifications when the db dead-letter type is configured.
+func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig,
+	repo repoInterfaces.NotificationDeadLetterRepoInterface, scope promutils.Scope) interfaces.Processor {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
 	var sub pubsub.Subscriber
@@ -114,7 +154,8 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			panic(err)
 		}
 		emailer = GetEmailer(config, scope)
-		return implementations.NewProcessor(sub, emailer, scope)
+		return implementations.NewProcessor(sub, emailer, GetSenders(config, scope),
+			newDeadLetterHandler(config, repo, scope), scope)
 	case common.GCP:
 		projectID := config.GCPConfig.ProjectID
 		subscription := config.NotificationsProcessorConfig.QueueName
@@ -130,10 +171,45 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			panic(err)
 		}
 		emailer = GetEmailer(config, scope)
-		return implementations.NewGcpProcessor(sub, emailer, scope)
+		return implementations.NewGcpProcessor(sub, emailer, GetSenders(config, scope),
+			newDeadLetterHandler(config, repo, scope), scope)
+	case common.Kafka:
+		topic := config.NotificationsProcessorConfig.QueueName
+		group := config.NotificationsProcessorConfig.ConsumerGroup
+		var err error
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			sub, err = implementations.NewKafkaSubscriber(config.KafkaConfig, topic, group)
+			if err != nil {
+				logger.Warnf(context.TODO(), "Failed to initialize new kafka subscriber for topic [%s] and consumer group [%s] with err: %v", topic, group, err)
+			}
+			return err
+		})
+		if err != nil {
+			panic(err)
+		}
+		emailer = GetEmailer(config, scope)
+		return implementations.NewStreamProcessor(common.Kafka, sub, emailer, GetSenders(config, scope),
+			newDeadLetterHandler(config, repo, scope), scope)
+	case common.NATS:
+		stream := config.NotificationsProcessorConfig.QueueName
+		durable := config.NotificationsProcessorConfig.ConsumerGroup
+		var err error
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			sub, err = implementations.NewNATSSubscriber(config.NATSConfig, stream, durable)
+			if err != nil {
+				logger.Warnf(context.TODO(), "Failed to initialize new nats subscriber for stream [%s] and consumer [%s] with err: %v", stream, durable, err)
+			}
+			return err
+		})
+		if err != nil {
+			panic(err)
+		}
+		emailer = GetEmailer(config, scope)
+		return implementations.NewStreamProcessor(common.NATS, sub, emailer, GetSenders(config, scope),
+			newDeadLetterHandler(config, repo, scope), scope)
 	case common.Sandbox:
 		emailer = GetEmailer(config, scope)
-		return implementations.NewSandboxProcessor(msgChan, emailer)
+		return implementations.NewSandboxProcessor(msgChan, emailer, GetSenders(config, scope))
 	case common.Local:
 		fallthrough
 	default:
@@ -143,48 +219,90 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 	}
 }
 
-func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
+// newPubSubPublisher connects to the topic using the notifications type. Types without topics return nil.
+func newPubSubPublisher(config runtimeInterfaces.NotificationsConfig, topicName string) pubsub.Publisher {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
+	var publisher pubsub.Publisher
+	var err error
 	switch config.Type {
 	case common.AWS:
 		snsConfig := gizmoAWS.SNSConfig{
-			Topic: config.NotificationsPublisherConfig.TopicName,
+			Topic: topicName,
 		}
 		if config.AWSConfig.Region != "" {
 			snsConfig.Region = config.AWSConfig.Region
 		} else {
 			snsConfig.Region = config.Region
 		}
-
-		var publisher pubsub.Publisher
-		var err error
 		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
 			publisher, err = gizmoAWS.NewPublisher(snsConfig)
 			return err
 		})
-
-		// Any persistent errors initiating Publisher with Amazon configurations results in a failed start up.
-		if err != nil {
-			panic(err)
-		}
-		return implementations.NewPublisher(publisher, scope)
 	case common.GCP:
 		pubsubConfig := gizmoGCP.Config{
-			Topic: config.NotificationsPublisherConfig.TopicName,
+			Topic: topicName,
 		}
 		pubsubConfig.ProjectID = config.GCPConfig.ProjectID
-		var publisher pubsub.MultiPublisher
-		var err error
 		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
 			publisher, err = gizmoGCP.NewPublisher(context.TODO(), pubsubConfig)
 			return err
 		})
+	case common.Kafka:
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = implementations.NewKafkaPublisher(config.KafkaConfig, topicName)
+			return err
+		})
+	case common.NATS:
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = implementations.NewNATSPublisher(config.NATSConfig, topicName)
+			return err
+		})
+	default:
+		return nil
+	}
 
-		if err != nil {
-			panic(err)
+	// Any persistent errors initiating the publisher results in a failed start up.
+	if err != nil {
+		panic(err)
+	}
+	return publisher
+}
+
+// newDeadLetterHandler returns the handler dead-lettering the notifications the processor can't process, or nil when
+// no dead-letter type is configured.
+func newDeadLetterHandler(config runtimeInterfaces.NotificationsConfig,
+	repo repoInterfaces.NotificationDeadLetterRepoInterface, scope promutils.Scope) *implementations.DeadLetterHandler {
+	deadLetterConfig := config.NotificationsProcessorConfig.DeadLetter
+	var queue interfaces.DeadLetterQueue
+	switch deadLetterConfig.Type {
+	case "":
+		return nil
+	case runtimeInterfaces.DeadLetterTypeTopic:
+		publisher := newPubSubPublisher(config, deadLetterConfig.TopicName)
+		if publisher == nil {
+			panic(fmt.Errorf("notifications of type [%s] can't be dead-lettered to a topic", config.Type))
 		}
-		return implementations.NewPublisher(publisher, scope)
+		queue = implementations.NewTopicDeadLetterQueue(publisher)
+	case runtimeInterfaces.DeadLetterTypeDB:
+		queue = implementations.NewDBDeadLetterQueue(repo)
+	default:
+		panic(fmt.Errorf("no matching dead-letter implementation for %s", deadLetterConfig.Type))
+	}
+	return implementations.NewDeadLetterHandler(config.Type, queue, deadLetterConfig, scope)
+}
+
+func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
+	// Templates are rendered when publishing, surface broken ones at start up rather than on the first notification.
+	if err := ValidateTemplates(config); err != nil {
+		panic(err)
+	}
+	if err := ValidateNotificationTriggers(config); err != nil {
+		panic(err)
+	}
+	switch config.Type {
+	case common.AWS, common.GCP, common.Kafka, common.NATS:
+		return implementations.NewPublisher(newPubSubPublisher(config, config.NotificationsPublisherConfig.TopicName), scope)
 	case common.Sandbox:
 		CreateMsgChan()
 		return implementations.NewSandboxPublisher(msgChan)
@@ -46,7 +46,7 @@ func TestGetEmailer(t *testing.T) {
 func TestNewNotificationPublisherAndProcessor(t *testing.T) {
 	testSandboxPublisher := NewNotificationsPublisher(notificationsConfig, scope)
 	assert.IsType(t, testSandboxPublisher, &implementations.SandboxPublisher{})
-	testSandboxProcessor := NewNotificationsProcessor(notificationsConfig, scope)
+	testSandboxProcessor := NewNotificationsProcessor(notificationsConfig, nil, scope)
 	assert.IsType(t, testSandboxProcessor, &implementations.SandboxProcessor{})
 
 	go func() {
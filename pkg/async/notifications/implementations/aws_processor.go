@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/NYTimes/gizmo/pubsub"
@@ -19,12 +20,13 @@ import (
 type Processor struct {
 	sub           pubsub.Subscriber
 	email         interfaces.Emailer
+	senders       Senders
+	deadLetters   *DeadLetterHandler
 	systemMetrics processorSystemMetrics
 }
 
-// Currently only email is the supported notification because slack and pagerduty both use
-// email client to trigger those notifications.
-// When Pagerduty and other notifications are supported, a publisher per type should be created.
+// Notifications are delivered by the Sender registered for their type. Notification types without a Sender (and
+// email notifications) are delivered with the emailer.
 func (p *Processor) StartProcessing() {
 	for {
 		logger.Warningf(context.Background(), "Starting notifications processor")
@@ -50,6 +52,7 @@ func (p *Processor) run() error {
 		if err := json.Unmarshal(msg.Message(), &snsJSONFormat); err != nil {
 			p.systemMetrics.MessageDecodingError.Inc()
 			logger.Errorf(context.Background(), "failed to unmarshall JSON message [%s] from processor with err: %v", stringMsg, err)
+			p.deadLetters.reject(context.Background(), "", msg.Message(), err)
 			p.markMessageDone(msg)
 			continue
 		}
@@ -57,10 +60,14 @@ func (p *Processor) run() error {
 		var value interface{}
 		var ok bool
 		var valueString string
+		// SNS sets the key the notification was published with as the message subject.
+		notificationType, _ := snsJSONFormat["Subject"].(string)
 
 		if value, ok = snsJSONFormat["Message"]; !ok {
 			logger.Errorf(context.Background(), "failed to retrieve message from unmarshalled JSON object [%s]", stringMsg)
 			p.systemMetrics.MessageDataError.Inc()
+			p.deadLetters.reject(context.Background(), notificationType, msg.Message(),
+				fmt.Errorf("the message is missing from the JSON object"))
 			p.markMessageDone(msg)
 			continue
 		}
@@ -68,6 +75,8 @@ func (p *Processor) run() error {
 		if valueString, ok = value.(string); !ok {
 			p.systemMetrics.MessageDataError.Inc()
 			logger.Errorf(context.Background(), "failed to retrieve notification message (in string format) from unmarshalled JSON object for message [%s]", stringMsg)
+			p.deadLetters.reject(context.Background(), notificationType, msg.Message(),
+				fmt.Errorf("the message in the JSON object isn't a string"))
 			p.markMessageDone(msg)
 			continue
 		}
@@ -78,6 +87,7 @@ func (p *Processor) run() error {
 		if err != nil {
 			logger.Errorf(context.Background(), "failed to Base64 decode from message string [%s] from message [%s] with err: %v", valueString, stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
+			p.deadLetters.reject(context.Background(), notificationType, msg.Message(), err)
 			p.markMessageDone(msg)
 			continue
 		}
@@ -85,11 +95,12 @@ func (p *Processor) run() error {
 		if err = proto.Unmarshal(notificationBytes, &emailMessage); err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object from decoded string[%s] from message [%s] with err: %v", valueString, stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
+			p.deadLetters.reject(context.Background(), notificationType, msg.Message(), err)
 			p.markMessageDone(msg)
 			continue
 		}
 
-		if err = p.email.SendEmail(context.Background(), emailMessage); err != nil {
+		if err = p.deadLetters.send(context.Background(), p.email, p.senders, notificationType, emailMessage); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
 			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
 		} else {
@@ -129,10 +140,14 @@ func (p *Processor) StopProcessing() error {
 	return err
 }
 
-func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+// Notifications which can't be processed are dead-lettered by deadLetters, if set.
+func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, senders Senders, deadLetters *DeadLetterHandler,
+	scope promutils.Scope) interfaces.Processor {
 	return &Processor{
 		sub:           sub,
 		email:         emailer,
+		senders:       senders,
+		deadLetters:   deadLetters,
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("processor")),
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -127,6 +128,35 @@ func TestProcessor_StartProcessingEmailError(t *testing.T) {
 	assert.Nil(t, testProcessor.(*Processor).run())
 }
 
+func TestProcessor_StartProcessingNativeSender(t *testing.T) {
+	initializeProcessor()
+	slackMessage := map[string]interface{}{}
+	for key, value := range testSubscriberMessage {
+		slackMessage[key] = value
+	}
+	slackMessage["Subject"] = "flyteidl.admin.SlackNotification"
+	testSubscriber.JSONMessages = append(testSubscriber.JSONMessages, slackMessage)
+
+	var emailed, sent bool
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		emailed = true
+		return nil
+	})
+	var slackSender mocks.MockSender
+	slackSender.SetSendFunc(func(ctx context.Context, message admin.EmailMessage) error {
+		assert.Equal(t, testEmail.SubjectLine, message.SubjectLine)
+		sent = true
+		return nil
+	})
+	processor := NewProcessor(mockSub, &emailer, Senders{
+		"flyteidl.admin.SlackNotification": &slackSender,
+	}, nil, promutils.NewTestScope())
+	assert.Nil(t, processor.(*Processor).run())
+	assert.True(t, sent)
+	assert.False(t, emailed)
+}
+
 func TestProcessor_StopProcessing(t *testing.T) {
 	initializeProcessor()
 	assert.Nil(t, testProcessor.StopProcessing())
@@ -0,0 +1,189 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+type deadLetterMetrics struct {
+	Scope           promutils.Scope
+	SendRetry       prometheus.Counter
+	DeadLettered    prometheus.Counter
+	DeadLetterError prometheus.Counter
+}
+
+func newDeadLetterMetrics(scope promutils.Scope) deadLetterMetrics {
+	return deadLetterMetrics{
+		Scope:           scope,
+		SendRetry:       scope.MustNewCounter("send_retry", "count of notifications whose sending is retried"),
+		DeadLettered:    scope.MustNewCounter("dead_lettered", "count of notifications which were dead-lettered"),
+		DeadLetterError: scope.MustNewCounter("dead_letter_error", "count of notifications which failed to be dead-lettered"),
+	}
+}
+
+// DeadLetterHandler retries sending notifications and dead-letters those which fail to decode or exhaust their
+// retries. A nil handler sends notifications once and drops those which can't be processed.
+type DeadLetterHandler struct {
+	processor   string
+	queue       interfaces.DeadLetterQueue
+	maxAttempts int
+	retryDelay  time.Duration
+	metrics     deadLetterMetrics
+}
+
+// send delivers the notification and dead-letters it once all attempts failed, in which case the error of the last
+// attempt is returned.
+func (h *DeadLetterHandler) send(ctx context.Context, emailer interfaces.Emailer, senders Senders,
+	notificationType string, message admin.EmailMessage) error {
+	if h == nil {
+		return sendNotification(ctx, emailer, senders, notificationType, message)
+	}
+	var err error
+	attempts := 0
+	for {
+		attempts++
+		if err = sendNotification(ctx, emailer, senders, notificationType, message); err == nil {
+			return nil
+		}
+		if attempts >= h.maxAttempts {
+			break
+		}
+		h.metrics.SendRetry.Inc()
+		logger.Warningf(ctx, "failed to send notification [%s] on attempt %d, retrying: %v", message.String(),
+			attempts, err)
+		time.Sleep(h.retryDelay)
+	}
+	h.put(ctx, interfaces.DeadLetter{
+		Processor:        h.processor,
+		NotificationType: notificationType,
+		Message:          &message,
+		Reason:           err.Error(),
+		Attempts:         attempts,
+	})
+	return err
+}
+
+// reject dead-letters a notification which couldn't be decoded.
+func (h *DeadLetterHandler) reject(ctx context.Context, notificationType string, rawMessage []byte, reason error) {
+	if h == nil {
+		return
+	}
+	h.put(ctx, interfaces.DeadLetter{
+		Processor:        h.processor,
+		NotificationType: notificationType,
+		RawMessage:       rawMessage,
+		Reason:           reason.Error(),
+	})
+}
+
+func (h *DeadLetterHandler) put(ctx context.Context, deadLetter interfaces.DeadLetter) {
+	if err := h.queue.Put(ctx, deadLetter); err != nil {
+		h.metrics.DeadLetterError.Inc()
+		logger.Errorf(ctx, "failed to dead-letter notification [%+v] with err: %v", deadLetter, err)
+		return
+	}
+	h.metrics.DeadLettered.Inc()
+}
+
+func NewDeadLetterHandler(processor string, queue interfaces.DeadLetterQueue,
+	config runtimeInterfaces.NotificationsDeadLetterConfig, scope promutils.Scope) *DeadLetterHandler {
+	maxAttempts := config.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &DeadLetterHandler{
+		processor:   processor,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+		retryDelay:  config.RetryDelay.Duration,
+		metrics:     newDeadLetterMetrics(scope.NewSubScope("dead_letters")),
+	}
+}
+
+// TopicDeadLetterQueue publishes dead-lettered notifications to a topic. Notifications which were decoded are
+// published like the notifications publisher does, so that a processor can be pointed at the topic to replay them.
+type TopicDeadLetterQueue struct {
+	pub pubsub.Publisher
+}
+
+func (q *TopicDeadLetterQueue) Put(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+	if deadLetter.Message != nil {
+		return q.pub.Publish(ctx, deadLetter.NotificationType, deadLetter.Message)
+	}
+	return q.pub.PublishRaw(ctx, deadLetter.NotificationType, deadLetter.RawMessage)
+}
+
+func NewTopicDeadLetterQueue(pub pubsub.Publisher) interfaces.DeadLetterQueue {
+	return &TopicDeadLetterQueue{
+		pub: pub,
+	}
+}
+
+// DBDeadLetterQueue records dead-lettered notifications in the database.
+type DBDeadLetterQueue struct {
+	repo repoInterfaces.NotificationDeadLetterRepoInterface
+}
+
+func (q *DBDeadLetterQueue) Put(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+	model := models.NotificationDeadLetter{
+		Processor:        deadLetter.Processor,
+		NotificationType: deadLetter.NotificationType,
+		RawMessage:       deadLetter.RawMessage,
+		Reason:           deadLetter.Reason,
+		Attempts:         deadLetter.Attempts,
+	}
+	if deadLetter.Message != nil {
+		message, err := proto.Marshal(deadLetter.Message)
+		if err != nil {
+			return err
+		}
+		model.Message = message
+	}
+	return q.repo.Create(ctx, model)
+}
+
+func NewDBDeadLetterQueue(repo repoInterfaces.NotificationDeadLetterRepoInterface) interfaces.DeadLetterQueue {
+	return &DBDeadLetterQueue{
+		repo: repo,
+	}
+}
+
+// ReplayDeadLetter republishes a notification recorded by the DBDeadLetterQueue and marks it as replayed.
+// Notifications which couldn't be decoded can't be replayed.
+func ReplayDeadLetter(ctx context.Context, repo repoInterfaces.NotificationDeadLetterRepoInterface,
+	publisher interfaces.Publisher, id uint) error {
+	deadLetter, err := repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deadLetter.ReplayedAt != nil {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"dead-lettered notification [%d] was already replayed", id)
+	}
+	if len(deadLetter.Message) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"dead-lettered notification [%d] couldn't be decoded and can't be replayed", id)
+	}
+	var message admin.EmailMessage
+	if err := proto.Unmarshal(deadLetter.Message, &message); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal dead-lettered notification [%d] with err: %v", id, err)
+	}
+	if err := publisher.Publish(ctx, deadLetter.NotificationType, &message); err != nil {
+		return err
+	}
+	return repo.MarkReplayed(ctx, id)
+}
@@ -0,0 +1,200 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	repoMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testDeadLetterConfig = runtimeInterfaces.NotificationsDeadLetterConfig{
+	MaxAttempts: 3,
+	RetryDelay:  config.Duration{Duration: time.Millisecond},
+}
+
+func TestDeadLetterHandler_Send(t *testing.T) {
+	var queue mocks.MockDeadLetterQueue
+	queue.SetPutFunc(func(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+		t.Fatalf("unexpected dead letter %+v", deadLetter)
+		return nil
+	})
+	handler := NewDeadLetterHandler("kafka", &queue, testDeadLetterConfig, promutils.NewTestScope())
+
+	var emailer mocks.MockEmailer
+	attempts := 0
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	assert.NoError(t, handler.send(context.Background(), &emailer, nil, "email", testEmail))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDeadLetterHandler_SendExhaustsRetries(t *testing.T) {
+	var deadLetters []interfaces.DeadLetter
+	var queue mocks.MockDeadLetterQueue
+	queue.SetPutFunc(func(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+		deadLetters = append(deadLetters, deadLetter)
+		return nil
+	})
+	handler := NewDeadLetterHandler("kafka", &queue, testDeadLetterConfig, promutils.NewTestScope())
+
+	var sender mocks.MockSender
+	sender.SetSendFunc(func(ctx context.Context, message admin.EmailMessage) error {
+		return errors.New("unavailable")
+	})
+	err := handler.send(context.Background(), &mocks.MockEmailer{}, Senders{"slack": &sender}, "slack", testEmail)
+	assert.EqualError(t, err, "unavailable")
+	assert.Len(t, deadLetters, 1)
+	assert.Equal(t, "kafka", deadLetters[0].Processor)
+	assert.Equal(t, "slack", deadLetters[0].NotificationType)
+	assert.True(t, proto.Equal(&testEmail, deadLetters[0].Message))
+	assert.Equal(t, "unavailable", deadLetters[0].Reason)
+	assert.Equal(t, 3, deadLetters[0].Attempts)
+
+	m := &dto.Metric{}
+	assert.NoError(t, handler.metrics.SendRetry.Write(m))
+	assert.Equal(t, "counter:<value:2 > ", m.String())
+	assert.NoError(t, handler.metrics.DeadLettered.Write(m))
+	assert.Equal(t, "counter:<value:1 > ", m.String())
+}
+
+func TestDeadLetterHandler_Reject(t *testing.T) {
+	var queue mocks.MockDeadLetterQueue
+	queue.SetPutFunc(func(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+		assert.Equal(t, interfaces.DeadLetter{
+			Processor:        "gcp",
+			NotificationType: "email",
+			RawMessage:       []byte("malformed"),
+			Reason:           "failed to decode",
+		}, deadLetter)
+		return errors.New("unavailable")
+	})
+	handler := NewDeadLetterHandler("gcp", &queue, testDeadLetterConfig, promutils.NewTestScope())
+	handler.reject(context.Background(), "email", []byte("malformed"), errors.New("failed to decode"))
+
+	m := &dto.Metric{}
+	assert.NoError(t, handler.metrics.DeadLetterError.Write(m))
+	assert.Equal(t, "counter:<value:1 > ", m.String())
+}
+
+func TestDeadLetterHandler_Nil(t *testing.T) {
+	var handler *DeadLetterHandler
+	var emailer mocks.MockEmailer
+	attempts := 0
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		attempts++
+		return errors.New("unavailable")
+	})
+	assert.EqualError(t, handler.send(context.Background(), &emailer, nil, "email", testEmail), "unavailable")
+	assert.Equal(t, 1, attempts)
+	handler.reject(context.Background(), "email", []byte("malformed"), errors.New("failed to decode"))
+}
+
+func TestTopicDeadLetterQueue_Put(t *testing.T) {
+	var publisher pubsubtest.TestPublisher
+	queue := NewTopicDeadLetterQueue(&publisher)
+	assert.NoError(t, queue.Put(context.Background(), interfaces.DeadLetter{
+		NotificationType: "email",
+		Message:          &testEmail,
+	}))
+	assert.NoError(t, queue.Put(context.Background(), interfaces.DeadLetter{
+		NotificationType: "slack",
+		RawMessage:       []byte("malformed"),
+	}))
+
+	expected, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	assert.Equal(t, []pubsubtest.TestPublishMsg{
+		{Key: "email", Body: expected},
+		{Key: "slack", Body: []byte("malformed")},
+	}, publisher.Published)
+}
+
+func TestDBDeadLetterQueue_Put(t *testing.T) {
+	expected, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	repo := &repoMocks.NotificationDeadLetterRepoInterface{}
+	repo.OnCreate(context.Background(), models.NotificationDeadLetter{
+		Processor:        "aws",
+		NotificationType: "email",
+		Message:          expected,
+		Reason:           "unavailable",
+		Attempts:         3,
+	}).Return(nil)
+
+	queue := NewDBDeadLetterQueue(repo)
+	assert.NoError(t, queue.Put(context.Background(), interfaces.DeadLetter{
+		Processor:        "aws",
+		NotificationType: "email",
+		Message:          &testEmail,
+		Reason:           "unavailable",
+		Attempts:         3,
+	}))
+	repo.AssertExpectations(t)
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	message, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	repo := &repoMocks.NotificationDeadLetterRepoInterface{}
+	repo.OnGetMatch(mock.Anything, uint(1)).Return(models.NotificationDeadLetter{
+		ID:               1,
+		NotificationType: "email",
+		Message:          message,
+	}, nil)
+	repo.OnMarkReplayedMatch(mock.Anything, uint(1)).Return(nil)
+
+	var publisher mocks.MockPublisher
+	published := false
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = true
+		assert.Equal(t, "email", key)
+		assert.True(t, proto.Equal(&testEmail, msg))
+		return nil
+	})
+	assert.NoError(t, ReplayDeadLetter(context.Background(), repo, &publisher, 1))
+	assert.True(t, published)
+	repo.AssertExpectations(t)
+}
+
+func TestReplayDeadLetter_NotReplayable(t *testing.T) {
+	replayedAt := time.Now()
+	repo := &repoMocks.NotificationDeadLetterRepoInterface{}
+	repo.OnGetMatch(mock.Anything, uint(1)).Return(models.NotificationDeadLetter{
+		ID:         1,
+		Message:    []byte("message"),
+		ReplayedAt: &replayedAt,
+	}, nil)
+	repo.OnGetMatch(mock.Anything, uint(2)).Return(models.NotificationDeadLetter{
+		ID:         2,
+		RawMessage: []byte("malformed"),
+	}, nil)
+
+	var publisher mocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		t.Fatalf("unexpected publish of %v", msg)
+		return nil
+	})
+	assert.EqualError(t, ReplayDeadLetter(context.Background(), repo, &publisher, 1),
+		"dead-lettered notification [1] was already replayed")
+	assert.EqualError(t, ReplayDeadLetter(context.Background(), repo, &publisher, 2),
+		"dead-lettered notification [2] couldn't be decoded and can't be replayed")
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/NYTimes/gizmo/pubsub"
+	gizmoGCP "github.com/NYTimes/gizmo/pubsub/gcp"
 	"github.com/flyteorg/flyteadmin/pkg/async"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -13,17 +14,25 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
+const gcpKeyAttribute = "key"
+
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type GcpProcessor struct {
 	sub           pubsub.Subscriber
 	email         interfaces.Emailer
+	senders       Senders
+	deadLetters   *DeadLetterHandler
 	systemMetrics processorSystemMetrics
 }
 
-func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+// Notifications which can't be processed are dead-lettered by deadLetters, if set.
+func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, senders Senders, deadLetters *DeadLetterHandler,
+	scope promutils.Scope) interfaces.Processor {
 	return &GcpProcessor{
 		sub:           sub,
 		email:         emailer,
+		senders:       senders,
+		deadLetters:   deadLetters,
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("gcp_processor")),
 	}
 }
@@ -46,11 +55,12 @@ func (p *GcpProcessor) run() error {
 		if err := proto.Unmarshal(msg.Message(), &emailMessage); err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object message [%s] with err: %v", string(msg.Message()), err)
 			p.systemMetrics.MessageDecodingError.Inc()
+			p.deadLetters.reject(context.Background(), getGcpNotificationType(msg), msg.Message(), err)
 			p.markMessageDone(msg)
 			continue
 		}
 
-		if err := p.email.SendEmail(context.Background(), emailMessage); err != nil {
+		if err := p.deadLetters.send(context.Background(), p.email, p.senders, getGcpNotificationType(msg), emailMessage); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
 			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
 		} else {
@@ -72,6 +82,14 @@ func (p *GcpProcessor) run() error {
 	return nil
 }
 
+// The gcp publisher stores the key the notification was published with in the message attributes.
+func getGcpNotificationType(message pubsub.SubscriberMessage) string {
+	if gcpMessage, ok := message.(*gizmoGCP.SubMessage); ok {
+		return gcpMessage.Attributes[gcpKeyAttribute]
+	}
+	return ""
+}
+
 func (p *GcpProcessor) markMessageDone(message pubsub.SubscriberMessage) {
 	if err := message.Done(); err != nil {
 		p.systemMetrics.MessageDoneError.Inc()
@@ -0,0 +1,161 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	httpSenderTimeout       = 30 * time.Second
+	maxHTTPSenderRetryDelay = 5 * time.Minute
+)
+
+// httpStatusError is returned when the receiving end of a notification responds with a non-2xx status.
+type httpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("received unexpected status [%d] with body [%s]", e.StatusCode, e.Body)
+}
+
+// Network errors, throttling and server side errors are worth retrying, anything else would fail again.
+func isRetryableHTTPError(err error) bool {
+	switch e := err.(type) {
+	case *httpStatusError:
+		return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+	case *slackAPIError:
+		return e.Code == slackRateLimitedError
+	default:
+		return true
+	}
+}
+
+// httpSender posts notification payloads, retrying transient failures with an exponential backoff.
+type httpSender struct {
+	client        *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+	systemMetrics senderMetrics
+}
+
+func (s *httpSender) postOnce(ctx context.Context, url string, headers map[string]string, body []byte) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return nil, &httpStatusError{StatusCode: response.StatusCode, Body: string(responseBody)}
+	}
+	return responseBody, nil
+}
+
+// post sends the body to the url and returns the response body of the first successful attempt.
+func (s *httpSender) post(ctx context.Context, url string, headers map[string]string, body []byte) ([]byte, error) {
+	delay := s.retryDelay
+	for attempt := 0; ; attempt++ {
+		responseBody, err := s.postOnce(ctx, url, headers, body)
+		if err == nil {
+			return responseBody, nil
+		}
+		if attempt >= s.retryAttempts || !isRetryableHTTPError(err) {
+			logger.Debugf(ctx, "failed to post notification after %d attempts with err: %v", attempt+1, err)
+			return nil, err
+		}
+		logger.Warningf(ctx, "Failed [%v] to post notification on attempt %d of %d", err, attempt, s.retryAttempts)
+		s.systemMetrics.SendRetry.Inc()
+		if delay, err = s.waitToRetry(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitToRetry waits for the delay before the next attempt and returns the delay of the attempt after it.
+func (s *httpSender) waitToRetry(ctx context.Context, delay time.Duration) (time.Duration, error) {
+	select {
+	case <-ctx.Done():
+		return delay, ctx.Err()
+	case <-time.After(delay):
+	}
+	delay *= 2
+	if delay > maxHTTPSenderRetryDelay {
+		delay = maxHTTPSenderRetryDelay
+	}
+	return delay, nil
+}
+
+// sendToEach sends a notification to every recipient. Delivery to one recipient doesn't depend on the others, so
+// every recipient is attempted and only those that failed transiently are retried. The errors of all recipients that
+// still failed are returned together.
+func (s *httpSender) sendToEach(ctx context.Context, recipients []string,
+	send func(ctx context.Context, recipient string) error) error {
+	recipientErrs := make(map[string]error, len(recipients))
+	pending := recipients
+	delay := s.retryDelay
+	for attempt := 0; ; attempt++ {
+		var retry []string
+		for _, recipient := range pending {
+			err := send(ctx, recipient)
+			if err == nil {
+				delete(recipientErrs, recipient)
+				continue
+			}
+			recipientErrs[recipient] = err
+			if isRetryableHTTPError(err) {
+				retry = append(retry, recipient)
+			}
+		}
+		if len(retry) == 0 || attempt >= s.retryAttempts {
+			break
+		}
+		logger.Warningf(ctx, "Failed to send notification to %v on attempt %d of %d", retry, attempt,
+			s.retryAttempts)
+		s.systemMetrics.SendRetry.Inc()
+		var err error
+		if delay, err = s.waitToRetry(ctx, delay); err != nil {
+			return err
+		}
+		pending = retry
+	}
+	if len(recipientErrs) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(recipientErrs))
+	for _, recipient := range recipients {
+		if err, ok := recipientErrs[recipient]; ok {
+			errs = append(errs, fmt.Errorf("[%s]: %v", recipient, err))
+		}
+	}
+	return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+}
+
+func newHTTPSender(retryAttempts, retryDelaySeconds int, systemMetrics senderMetrics) httpSender {
+	return httpSender{
+		client:        &http.Client{Timeout: httpSenderTimeout},
+		retryAttempts: retryAttempts,
+		retryDelay:    time.Duration(retryDelaySeconds) * time.Second,
+		systemMetrics: systemMetrics,
+	}
+}
@@ -0,0 +1,217 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/Shopify/sarama"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+// Kafka messages carry the notification type in this header, leaving the message key unset so that notifications are
+// spread across partitions.
+const kafkaNotificationTypeHeader = "notification_type"
+
+// NewSaramaConfig returns the sarama configuration for publishing and consuming notifications with at-least-once
+// delivery: publishes wait for all in-sync replicas, and a consumer group starting out reads topics from the start.
+func NewSaramaConfig(config runtimeInterfaces.KafkaConfig) (*sarama.Config, error) {
+	saramaConfig := sarama.NewConfig()
+	var err error
+	if saramaConfig.Version, err = sarama.ParseKafkaVersion(config.Version); err != nil {
+		return nil, err
+	}
+	if !saramaConfig.Version.IsAtLeast(sarama.V0_11_0_0) {
+		// Headers were introduced in 0.11.
+		return nil, fmt.Errorf("kafka version [%s] doesn't support message headers, at least 0.11.0 is required",
+			config.Version)
+	}
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	return saramaConfig, nil
+}
+
+// KafkaPublisher publishes notifications to a Kafka topic, implementing pubsub.Publisher.
+type KafkaPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, data)
+}
+
+func (p *KafkaPublisher) PublishRaw(_ context.Context, key string, msg []byte) error {
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Value: sarama.ByteEncoder(msg),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(kafkaNotificationTypeHeader), Value: []byte(key)},
+		},
+	})
+	return err
+}
+
+func NewKafkaPublisher(config runtimeInterfaces.KafkaConfig, topic string) (pubsub.Publisher, error) {
+	saramaConfig, err := NewSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(config.Brokers, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaPublisher{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
+
+// kafkaMessage is a consumed message, whose offset is marked as consumed once it's done.
+type kafkaMessage struct {
+	message  *sarama.ConsumerMessage
+	session  sarama.ConsumerGroupSession
+	doneOnce sync.Once
+	done     chan struct{}
+}
+
+func (m *kafkaMessage) Message() []byte {
+	return m.message.Value
+}
+
+func (m *kafkaMessage) Key() string {
+	for _, header := range m.message.Headers {
+		if header != nil && string(header.Key) == kafkaNotificationTypeHeader {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Kafka has no per-message deadline, a partition is only reassigned when its consumer leaves the group.
+func (m *kafkaMessage) ExtendDoneDeadline(time.Duration) error {
+	return nil
+}
+
+func (m *kafkaMessage) Done() error {
+	m.doneOnce.Do(func() {
+		m.session.MarkMessage(m.message, "")
+		close(m.done)
+	})
+	return nil
+}
+
+// kafkaConsumerGroupHandler hands the messages of the claimed partitions to the subscriber one at a time, so that
+// offsets are only marked once all preceding messages of the partition are done.
+type kafkaConsumerGroupHandler struct {
+	messages chan<- pubsub.SubscriberMessage
+}
+
+func (h *kafkaConsumerGroupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *kafkaConsumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *kafkaConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		msg := &kafkaMessage{
+			message: message,
+			session: session,
+			done:    make(chan struct{}),
+		}
+		select {
+		case h.messages <- msg:
+		case <-session.Context().Done():
+			return nil
+		}
+		select {
+		case <-msg.done:
+		case <-session.Context().Done():
+			// The partition is being reassigned, the message is redelivered unless it's done before offsets are
+			// committed.
+			return nil
+		}
+	}
+	return nil
+}
+
+// KafkaSubscriber consumes notifications from a Kafka topic as a member of a consumer group, implementing
+// pubsub.Subscriber.
+type KafkaSubscriber struct {
+	group  sarama.ConsumerGroup
+	topics []string
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	err    error
+}
+
+func (s *KafkaSubscriber) Start() <-chan pubsub.SubscriberMessage {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mutex.Lock()
+	s.cancel = cancel
+	s.err = nil
+	s.mutex.Unlock()
+
+	messages := make(chan pubsub.SubscriberMessage)
+	go func() {
+		defer close(messages)
+		handler := &kafkaConsumerGroupHandler{messages: messages}
+		// Consume returns whenever the group rebalances, at which point the session has to be joined again.
+		for ctx.Err() == nil {
+			if err := s.group.Consume(ctx, s.topics, handler); err != nil {
+				logger.Errorf(ctx, "Failed to consume notifications from kafka topics %v with err: %v", s.topics, err)
+				s.mutex.Lock()
+				s.err = err
+				s.mutex.Unlock()
+				return
+			}
+		}
+	}()
+	return messages
+}
+
+func (s *KafkaSubscriber) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+func (s *KafkaSubscriber) Stop() error {
+	s.mutex.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mutex.Unlock()
+	return s.group.Close()
+}
+
+func NewKafkaSubscriber(config runtimeInterfaces.KafkaConfig, topic, group string) (pubsub.Subscriber, error) {
+	if len(group) == 0 {
+		return nil, fmt.Errorf("a consumer group is required to consume notifications from kafka")
+	}
+	saramaConfig, err := NewSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	consumerGroup, err := sarama.NewConsumerGroup(config.Brokers, group, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSubscriber{
+		group:  consumerGroup,
+		topics: []string{topic},
+	}, nil
+}
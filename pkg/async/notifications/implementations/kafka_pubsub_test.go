@@ -0,0 +1,155 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/Shopify/sarama"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSyncProducer struct {
+	sarama.SyncProducer
+	messages []*sarama.ProducerMessage
+}
+
+func (p *testSyncProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.messages = append(p.messages, msg)
+	return 0, int64(len(p.messages)), nil
+}
+
+type testConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	mutex  sync.Mutex
+	marked []int64
+}
+
+func (s *testConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.marked = append(s.marked, msg.Offset)
+}
+
+func (s *testConsumerGroupSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testConsumerGroupSession) getMarked() []int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]int64{}, s.marked...)
+}
+
+type testConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *testConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+// testConsumerGroup runs a single session, claiming one partition, until the context is cancelled.
+type testConsumerGroup struct {
+	sarama.ConsumerGroup
+	session *testConsumerGroupSession
+	claim   *testConsumerGroupClaim
+	err     error
+}
+
+func (g *testConsumerGroup) Consume(ctx context.Context, _ []string, handler sarama.ConsumerGroupHandler) error {
+	if g.err != nil {
+		return g.err
+	}
+	g.session.ctx = ctx
+	go func() {
+		// Like sarama, claims are closed once the session ends.
+		<-ctx.Done()
+		close(g.claim.messages)
+	}()
+	return handler.ConsumeClaim(g.session, g.claim)
+}
+
+func (g *testConsumerGroup) Close() error {
+	return nil
+}
+
+func TestNewSaramaConfig(t *testing.T) {
+	config, err := NewSaramaConfig(runtimeInterfaces.KafkaConfig{Version: "2.1.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, sarama.WaitForAll, config.Producer.RequiredAcks)
+	assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+
+	_, err = NewSaramaConfig(runtimeInterfaces.KafkaConfig{Version: "0.10.2.0"})
+	assert.Error(t, err)
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	producer := &testSyncProducer{}
+	publisher := &KafkaPublisher{producer: producer, topic: "notifications"}
+	assert.NoError(t, publisher.Publish(context.Background(), "email", &testEmail))
+
+	assert.Len(t, producer.messages, 1)
+	msg := producer.messages[0]
+	assert.Equal(t, "notifications", msg.Topic)
+	assert.Nil(t, msg.Key)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte(kafkaNotificationTypeHeader), Value: []byte("email")}},
+		msg.Headers)
+	expected, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	value, err := msg.Value.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, value)
+}
+
+func TestKafkaSubscriber(t *testing.T) {
+	claim := &testConsumerGroupClaim{messages: make(chan *sarama.ConsumerMessage, 2)}
+	claim.messages <- &sarama.ConsumerMessage{
+		Offset:  1,
+		Value:   []byte("first"),
+		Headers: []*sarama.RecordHeader{{Key: []byte(kafkaNotificationTypeHeader), Value: []byte("email")}},
+	}
+	claim.messages <- &sarama.ConsumerMessage{Offset: 2, Value: []byte("second")}
+	session := &testConsumerGroupSession{}
+	subscriber := &KafkaSubscriber{
+		group:  &testConsumerGroup{session: session, claim: claim},
+		topics: []string{"notifications"},
+	}
+
+	messages := subscriber.Start()
+	first := <-messages
+	assert.Equal(t, []byte("first"), first.Message())
+	assert.Equal(t, "email", first.(keyedMessage).Key())
+	// Offsets are only marked once messages are done.
+	assert.Empty(t, session.getMarked())
+	assert.NoError(t, first.Done())
+
+	second := <-messages
+	assert.Equal(t, []byte("second"), second.Message())
+	assert.Empty(t, second.(keyedMessage).Key())
+	assert.NoError(t, second.Done())
+	assert.Equal(t, []int64{1, 2}, session.getMarked())
+
+	assert.NoError(t, subscriber.Stop())
+	for range messages {
+	}
+	assert.NoError(t, subscriber.Err())
+}
+
+func TestKafkaSubscriber_ConsumeError(t *testing.T) {
+	expected := errors.New("brokers unavailable")
+	subscriber := &KafkaSubscriber{group: &testConsumerGroup{err: expected}}
+
+	var received []pubsub.SubscriberMessage
+	for msg := range subscriber.Start() {
+		received = append(received, msg)
+	}
+	assert.Empty(t, received)
+	assert.Equal(t, expected, subscriber.Err())
+}
@@ -0,0 +1,217 @@
+package implementations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// NATS messages carry the notification type in this header.
+	natsNotificationTypeHeader = "Flyte-Notification-Type"
+	natsRequestTimeout         = 10 * time.Second
+	natsConnectionName         = "flyteadmin"
+)
+
+// Connects to the NATS server, reconnecting for as long as admin runs.
+func connectNATS(config runtimeInterfaces.NATSConfig) (*nats.Conn, jetstream.JetStream, error) {
+	conn, err := nats.Connect(config.URL, nats.Name(natsConnectionName), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, js, nil
+}
+
+// Returns the JetStream message id of a notification, so that the stream stores retried publishes of it once within
+// its duplicate window.
+func getNATSMsgID(key string, msg []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(key))
+	hash.Write([]byte{0})
+	hash.Write(msg)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// NATSPublisher publishes notifications to a subject captured by a JetStream stream, implementing pubsub.Publisher.
+// Publishes only succeed once the stream acknowledged storing the notification.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, data)
+}
+
+func (p *NATSPublisher) PublishRaw(ctx context.Context, key string, msg []byte) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, natsRequestTimeout)
+		defer cancel()
+	}
+	natsMsg := nats.NewMsg(p.subject)
+	natsMsg.Header.Set(natsNotificationTypeHeader, key)
+	natsMsg.Data = msg
+	_, err := p.js.PublishMsg(ctx, natsMsg, jetstream.WithMsgID(getNATSMsgID(key, msg)))
+	return err
+}
+
+func NewNATSPublisher(config runtimeInterfaces.NATSConfig, subject string) (pubsub.Publisher, error) {
+	conn, js, err := connectNATS(config)
+	if err != nil {
+		return nil, err
+	}
+	return &NATSPublisher{
+		conn:    conn,
+		js:      js,
+		subject: subject,
+	}, nil
+}
+
+// natsMessage is a message pulled from a JetStream consumer, acknowledged once it's done.
+type natsMessage struct {
+	msg jetstream.Msg
+}
+
+func (m *natsMessage) Message() []byte {
+	return m.msg.Data()
+}
+
+func (m *natsMessage) Key() string {
+	return m.msg.Headers().Get(natsNotificationTypeHeader)
+}
+
+// ExtendDoneDeadline tells the server the message is still being processed, resetting its ack wait.
+func (m *natsMessage) ExtendDoneDeadline(time.Duration) error {
+	return m.msg.InProgress()
+}
+
+func (m *natsMessage) Done() error {
+	return m.msg.Ack()
+}
+
+// NATSSubscriber consumes notifications from a JetStream stream through a durable pull consumer, implementing
+// pubsub.Subscriber. Subscribers sharing the durable consumer each receive a share of the notifications, and
+// notifications which aren't acknowledged within the ack wait are redelivered.
+type NATSSubscriber struct {
+	conn     *nats.Conn
+	consumer jetstream.Consumer
+	stream   string
+	mutex    sync.Mutex
+	iter     jetstream.MessagesContext
+	cancel   context.CancelFunc
+	err      error
+}
+
+func (s *NATSSubscriber) setErr(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.err = err
+}
+
+func (s *NATSSubscriber) Start() <-chan pubsub.SubscriberMessage {
+	ctx, cancel := context.WithCancel(context.Background())
+	messages := make(chan pubsub.SubscriberMessage)
+	iter, err := s.consumer.Messages()
+	s.mutex.Lock()
+	s.iter = iter
+	s.cancel = cancel
+	s.err = err
+	s.mutex.Unlock()
+	if err != nil {
+		close(messages)
+		return messages
+	}
+	go func() {
+		defer close(messages)
+		for {
+			msg, err := iter.Next()
+			if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				return
+			}
+			if err != nil {
+				logger.Errorf(ctx, "Failed to pull notifications from JetStream stream [%s] with err: %v",
+					s.stream, err)
+				s.setErr(err)
+				iter.Stop()
+				return
+			}
+			select {
+			case messages <- &natsMessage{msg: msg}:
+			case <-ctx.Done():
+				// Left unacknowledged, the message is redelivered once its ack wait passed.
+				return
+			}
+		}
+	}()
+	return messages
+}
+
+func (s *NATSSubscriber) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+func (s *NATSSubscriber) Stop() error {
+	s.mutex.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.iter != nil {
+		s.iter.Stop()
+	}
+	s.mutex.Unlock()
+	s.conn.Close()
+	return nil
+}
+
+// NewNATSSubscriber creates, unless it already exists, the durable consumer of the stream and subscribes to it.
+func NewNATSSubscriber(config runtimeInterfaces.NATSConfig, stream, durable string) (pubsub.Subscriber, error) {
+	if len(durable) == 0 {
+		return nil, fmt.Errorf("a consumer group is required to consume notifications from nats")
+	}
+	conn, js, err := connectNATS(config)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), natsRequestTimeout)
+	defer cancel()
+	consumer, err := js.CreateOrUpdateConsumer(ctx, stream, jetstream.ConsumerConfig{
+		Durable:       durable,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       config.AckWait.Duration,
+		MaxDeliver:    -1,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &NATSSubscriber{
+		conn:     conn,
+		consumer: consumer,
+		stream:   stream,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/nats-server/v2/server"
+	natsTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Runs a NATS server with JetStream and a stream capturing the notifications subject.
+func runTestNATSServer(t *testing.T) (*server.Server, jetstream.Stream) {
+	opts := natsTest.DefaultTestOptions
+	opts.Port = -1
+	opts.JetStream = true
+	opts.StoreDir = t.TempDir()
+	natsServer := natsTest.RunServer(&opts)
+	t.Cleanup(natsServer.Shutdown)
+
+	conn, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	stream, err := js.CreateStream(context.Background(), jetstream.StreamConfig{
+		Name:     "notifications",
+		Subjects: []string{"flyte.notifications"},
+	})
+	require.NoError(t, err)
+	return natsServer, stream
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	natsServer, stream := runTestNATSServer(t)
+
+	publisher, err := NewNATSPublisher(runtimeInterfaces.NATSConfig{URL: natsServer.ClientURL()}, "flyte.notifications")
+	assert.NoError(t, err)
+	assert.NoError(t, publisher.Publish(context.Background(), "email", &testEmail))
+	// Retried publishes of the same notification are stored once.
+	assert.NoError(t, publisher.Publish(context.Background(), "email", &testEmail))
+
+	info, err := stream.Info(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), info.State.Msgs)
+	msg, err := stream.GetMsg(context.Background(), 1)
+	assert.NoError(t, err)
+	expected, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, msg.Data)
+	assert.Equal(t, "email", msg.Header.Get(natsNotificationTypeHeader))
+}
+
+func TestNATSSubscriber(t *testing.T) {
+	natsServer, stream := runTestNATSServer(t)
+	publisher, err := NewNATSPublisher(runtimeInterfaces.NATSConfig{URL: natsServer.ClientURL()}, "flyte.notifications")
+	require.NoError(t, err)
+	require.NoError(t, publisher.PublishRaw(context.Background(), "email", []byte("first")))
+	require.NoError(t, publisher.PublishRaw(context.Background(), "webhook", []byte("second")))
+
+	config := runtimeInterfaces.NATSConfig{URL: natsServer.ClientURL()}
+	config.AckWait.Duration = time.Minute
+	subscriber, err := NewNATSSubscriber(config, "notifications", "processor")
+	assert.NoError(t, err)
+	consumer, err := stream.Consumer(context.Background(), "processor")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, consumer.CachedInfo().Config.AckWait)
+
+	messages := subscriber.Start()
+	first := <-messages
+	assert.Equal(t, []byte("first"), first.Message())
+	assert.Equal(t, "email", first.(keyedMessage).Key())
+	assert.NoError(t, first.Done())
+	second := <-messages
+	assert.Equal(t, []byte("second"), second.Message())
+	assert.Equal(t, "webhook", second.(keyedMessage).Key())
+	assert.NoError(t, second.ExtendDoneDeadline(time.Minute))
+	assert.NoError(t, second.Done())
+
+	assert.Eventually(t, func() bool {
+		info, err := consumer.Info(context.Background())
+		return err == nil && info.AckFloor.Stream == 2 && info.NumAckPending == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, subscriber.Stop())
+	for range messages {
+	}
+	assert.NoError(t, subscriber.Err())
+}
+
+func TestNewNATSSubscriber_MissingConsumerGroup(t *testing.T) {
+	_, err := NewNATSSubscriber(runtimeInterfaces.NATSConfig{URL: "nats://127.0.0.1:4222"}, "notifications", "")
+	assert.EqualError(t, err, "a consumer group is required to consume notifications from nats")
+}
@@ -0,0 +1,151 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+	defaultPagerDutySeverity  = "error"
+	pagerDutySource           = "flyteadmin"
+	pagerDutyTriggerAction    = "trigger"
+	// PagerDuty rejects events with a longer summary.
+	maxPagerDutySummaryLength = 1024
+)
+
+var pagerDutySeverities = map[string]bool{
+	"critical": true,
+	"error":    true,
+	"warning":  true,
+	"info":     true,
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+// PagerDutySender triggers PagerDuty alerts through the Events v2 API.
+type PagerDutySender struct {
+	httpSender
+	eventsURL  string
+	routingKey string
+	severity   string
+}
+
+// Recipients of PagerDuty notifications are integration keys. Email addresses, which were used before alerts were
+// sent natively, are mapped to the configured default integration key.
+func getPagerDutyRoutingKeys(recipients []string, defaultRoutingKey string) []string {
+	routingKeys := make([]string, 0, len(recipients))
+	seen := make(map[string]bool)
+	for _, recipient := range recipients {
+		routingKey := recipient
+		if strings.Contains(recipient, "@") {
+			routingKey = defaultRoutingKey
+		}
+		if len(routingKey) == 0 || seen[routingKey] {
+			continue
+		}
+		seen[routingKey] = true
+		routingKeys = append(routingKeys, routingKey)
+	}
+	if len(routingKeys) == 0 && len(defaultRoutingKey) > 0 {
+		routingKeys = append(routingKeys, defaultRoutingKey)
+	}
+	return routingKeys
+}
+
+func (s *PagerDutySender) getEvent(routingKey string, message admin.EmailMessage) pagerDutyEvent {
+	summary := message.SubjectLine
+	if len(summary) > maxPagerDutySummaryLength {
+		summary = summary[:maxPagerDutySummaryLength]
+	}
+	event := pagerDutyEvent{
+		RoutingKey:  routingKey,
+		EventAction: pagerDutyTriggerAction,
+		Payload: pagerDutyPayload{
+			Summary:  summary,
+			Source:   pagerDutySource,
+			Severity: s.severity,
+		},
+	}
+	if len(message.Body) > 0 {
+		event.Payload.CustomDetails = map[string]string{
+			"details": message.Body,
+		}
+	}
+	return event
+}
+
+func (s *PagerDutySender) send(ctx context.Context, message admin.EmailMessage) error {
+	routingKeys := getPagerDutyRoutingKeys(message.RecipientsEmail, s.routingKey)
+	if len(routingKeys) == 0 {
+		return fmt.Errorf("no pagerduty integration key to trigger alert [%s] with", message.SubjectLine)
+	}
+	return s.sendToEach(ctx, routingKeys, func(ctx context.Context, routingKey string) error {
+		body, err := json.Marshal(s.getEvent(routingKey, message))
+		if err != nil {
+			return err
+		}
+		_, err = s.postOnce(ctx, s.eventsURL, nil, body)
+		return err
+	})
+}
+
+func (s *PagerDutySender) Send(ctx context.Context, message admin.EmailMessage) error {
+	s.systemMetrics.SendTotal.Inc()
+	if err := s.send(ctx, message); err != nil {
+		logger.Errorf(ctx, "error in triggering pagerduty alert [%s] with err: %v", message.String(), err)
+		s.systemMetrics.SendError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, "errors were seen while triggering pagerduty alerts: %v", err)
+	}
+	logger.Debugf(ctx, "Triggered pagerduty alert sub: %s", message.SubjectLine)
+	s.systemMetrics.SendSuccess.Inc()
+	return nil
+}
+
+func NewPagerDutySender(ctx context.Context, config runtimeInterfaces.NotificationsPagerDutyConfig, sm core.SecretManager,
+	scope promutils.Scope) (interfaces.Sender, error) {
+	sender := &PagerDutySender{
+		httpSender: newHTTPSender(config.RetryAttempts, config.RetryDelaySeconds, newSenderMetrics(scope.NewSubScope("pagerduty"))),
+		eventsURL:  config.EventsURL,
+		severity:   strings.ToLower(config.Severity),
+	}
+	if len(sender.eventsURL) == 0 {
+		sender.eventsURL = defaultPagerDutyEventsURL
+	}
+	if len(sender.severity) == 0 {
+		sender.severity = defaultPagerDutySeverity
+	}
+	if !pagerDutySeverities[sender.severity] {
+		return nil, fmt.Errorf("unsupported pagerduty severity [%s]", config.Severity)
+	}
+	if len(config.RoutingKeySecretName) > 0 {
+		routingKey, err := sm.Get(ctx, config.RoutingKeySecretName)
+		if err != nil {
+			return nil, err
+		}
+		sender.routingKey = strings.TrimSpace(routingKey)
+	}
+	return sender, nil
+}
@@ -0,0 +1,105 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetPagerDutyRoutingKeys(t *testing.T) {
+	assert.Equal(t, []string{"default-key", "team-key"},
+		getPagerDutyRoutingKeys([]string{"oncall@example.com", "team-key", "other@example.com"}, "default-key"))
+	assert.Equal(t, []string{"default-key"}, getPagerDutyRoutingKeys(nil, "default-key"))
+	assert.Empty(t, getPagerDutyRoutingKeys([]string{"oncall@example.com"}, ""))
+}
+
+func TestPagerDutySender_Send(t *testing.T) {
+	var events []pagerDutyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status": "success", "message": "Event processed"}`))
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "pagerduty_key").Return("default-key", nil)
+	sender, err := NewPagerDutySender(context.Background(), runtimeInterfaces.NotificationsPagerDutyConfig{
+		RoutingKeySecretName: "pagerduty_key",
+		EventsURL:            server.URL,
+		Severity:             "Critical",
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	assert.NoError(t, sender.Send(context.Background(), admin.EmailMessage{
+		RecipientsEmail: []string{"oncall@example.com"},
+		SubjectLine:     strings.Repeat("s", 2000),
+		Body:            "Execution e124 has failed.",
+	}))
+	assert.Len(t, events, 1)
+	assert.Equal(t, "default-key", events[0].RoutingKey)
+	assert.Equal(t, "trigger", events[0].EventAction)
+	assert.Equal(t, "critical", events[0].Payload.Severity)
+	assert.Equal(t, "flyteadmin", events[0].Payload.Source)
+	assert.Len(t, events[0].Payload.Summary, maxPagerDutySummaryLength)
+	assert.Equal(t, "Execution e124 has failed.", events[0].Payload.CustomDetails["details"])
+}
+
+func TestPagerDutySender_CollectsErrors(t *testing.T) {
+	attempts := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		attempts[event.RoutingKey]++
+		switch event.RoutingKey {
+		case "invalid-key":
+			w.WriteHeader(http.StatusBadRequest)
+		case "flaky-key":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	sender, err := NewPagerDutySender(context.Background(), runtimeInterfaces.NotificationsPagerDutyConfig{
+		EventsURL:     server.URL,
+		RetryAttempts: 2,
+	}, &mocks.SecretManager{}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	err = sender.Send(context.Background(), admin.EmailMessage{
+		RecipientsEmail: []string{"invalid-key", "team-key", "flaky-key"},
+	})
+	assert.EqualError(t, err, "errors were seen while triggering pagerduty alerts: "+
+		"[invalid-key]: received unexpected status [400] with body [], "+
+		"[flaky-key]: received unexpected status [503] with body []")
+	assert.Equal(t, map[string]int{"invalid-key": 1, "team-key": 1, "flaky-key": 3}, attempts)
+}
+
+func TestPagerDutySender_NoRoutingKey(t *testing.T) {
+	sender, err := NewPagerDutySender(context.Background(), runtimeInterfaces.NotificationsPagerDutyConfig{},
+		&mocks.SecretManager{}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send(context.Background(), admin.EmailMessage{
+		RecipientsEmail: []string{"oncall@example.com"},
+	}))
+}
+
+func TestNewPagerDutySender_InvalidSeverity(t *testing.T) {
+	_, err := NewPagerDutySender(context.Background(), runtimeInterfaces.NotificationsPagerDutyConfig{
+		Severity: "sev1",
+	}, &mocks.SecretManager{}, promutils.NewTestScope())
+	assert.Error(t, err)
+}
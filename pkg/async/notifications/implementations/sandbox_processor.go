@@ -13,7 +13,8 @@ import (
 
 type SandboxProcessor struct {
 	email   interfaces.Emailer
-	subChan <-chan []byte
+	senders Senders
+	subChan <-chan SandboxMessage
 }
 
 func (p *SandboxProcessor) StartProcessing() {
@@ -31,13 +32,13 @@ func (p *SandboxProcessor) run() error {
 	for {
 		select {
 		case msg := <-p.subChan:
-			err := proto.Unmarshal(msg, &emailMessage)
+			err := proto.Unmarshal(msg.Data, &emailMessage)
 			if err != nil {
 				logger.Errorf(context.Background(), "error with unmarshalling message [%v]", err)
 				return err
 			}
 
-			err = p.email.SendEmail(context.Background(), emailMessage)
+			err = sendNotification(context.Background(), p.email, p.senders, msg.NotificationType, emailMessage)
 			if err != nil {
 				logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
 				return err
@@ -54,9 +55,10 @@ func (p *SandboxProcessor) StopProcessing() error {
 	return nil
 }
 
-func NewSandboxProcessor(subChan <-chan []byte, emailer interfaces.Emailer) interfaces.Processor {
+func NewSandboxProcessor(subChan <-chan SandboxMessage, emailer interfaces.Emailer, senders Senders) interfaces.Processor {
 	return &SandboxProcessor{
 		subChan: subChan,
 		email:   emailer,
+		senders: senders,
 	}
 }
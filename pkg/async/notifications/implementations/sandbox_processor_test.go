@@ -14,9 +14,9 @@ import (
 var mockSandboxEmailer mocks.MockEmailer
 
 func TestSandboxProcessor_StartProcessingSuccess(t *testing.T) {
-	msgChan := make(chan []byte, 1)
-	msgChan <- msg
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	msgChan := make(chan SandboxMessage, 1)
+	msgChan <- SandboxMessage{Data: msg}
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 
 	sendEmailValidationFunc := func(ctx context.Context, email admin.EmailMessage) error {
 		assert.Equal(t, testEmail.Body, email.Body)
@@ -30,16 +30,40 @@ func TestSandboxProcessor_StartProcessingSuccess(t *testing.T) {
 	assert.Nil(t, testSandboxProcessor.(*SandboxProcessor).run())
 }
 
+func TestSandboxProcessor_StartProcessingNativeSender(t *testing.T) {
+	msgChan := make(chan SandboxMessage, 2)
+	msgChan <- SandboxMessage{NotificationType: "flyteidl.admin.PagerDutyNotification", Data: msg}
+	msgChan <- SandboxMessage{NotificationType: "flyteidl.admin.EmailNotification", Data: msg}
+
+	var emails, alerts int
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		emails++
+		return nil
+	})
+	var pagerDutySender mocks.MockSender
+	pagerDutySender.SetSendFunc(func(ctx context.Context, message admin.EmailMessage) error {
+		alerts++
+		return nil
+	})
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &emailer, Senders{
+		"flyteidl.admin.PagerDutyNotification": &pagerDutySender,
+	})
+	assert.Nil(t, testSandboxProcessor.(*SandboxProcessor).run())
+	assert.Equal(t, 1, emails)
+	assert.Equal(t, 1, alerts)
+}
+
 func TestSandboxProcessor_StartProcessingNoMessage(t *testing.T) {
-	msgChan := make(chan []byte, 1)
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	msgChan := make(chan SandboxMessage, 1)
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 	go testSandboxProcessor.StartProcessing()
 	time.Sleep(1 * time.Second)
 }
 
 func TestSandboxProcessor_StartProcessingError(t *testing.T) {
-	msgChan := make(chan []byte, 1)
-	msgChan <- msg
+	msgChan := make(chan SandboxMessage, 1)
+	msgChan <- SandboxMessage{Data: msg}
 
 	emailError := errors.New("error running processor")
 	sendEmailValidationFunc := func(ctx context.Context, email admin.EmailMessage) error {
@@ -47,7 +71,7 @@ func TestSandboxProcessor_StartProcessingError(t *testing.T) {
 	}
 	mockSandboxEmailer.SetSendEmailFunc(sendEmailValidationFunc)
 
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 	go testSandboxProcessor.StartProcessing()
 
 	// give time to receive the err in StartProcessing
@@ -56,17 +80,17 @@ func TestSandboxProcessor_StartProcessingError(t *testing.T) {
 }
 
 func TestSandboxProcessor_StartProcessingMessageError(t *testing.T) {
-	msgChan := make(chan []byte, 1)
+	msgChan := make(chan SandboxMessage, 1)
 	invalidProtoMessage := []byte("invalid message")
-	msgChan <- invalidProtoMessage
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	msgChan <- SandboxMessage{Data: invalidProtoMessage}
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 	assert.NotNil(t, testSandboxProcessor.(*SandboxProcessor).run())
 }
 
 func TestSandboxProcessor_StartProcessingEmailError(t *testing.T) {
-	msgChan := make(chan []byte, 1)
-	msgChan <- msg
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	msgChan := make(chan SandboxMessage, 1)
+	msgChan <- SandboxMessage{Data: msg}
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 
 	emailError := errors.New("error sending email")
 	sendEmailValidationFunc := func(ctx context.Context, email admin.EmailMessage) error {
@@ -78,7 +102,7 @@ func TestSandboxProcessor_StartProcessingEmailError(t *testing.T) {
 }
 
 func TestSandboxProcessor_StopProcessing(t *testing.T) {
-	msgChan := make(chan []byte, 1)
-	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer)
+	msgChan := make(chan SandboxMessage, 1)
+	testSandboxProcessor := NewSandboxProcessor(msgChan, &mockSandboxEmailer, nil)
 	assert.Nil(t, testSandboxProcessor.StopProcessing())
 }
@@ -7,8 +7,14 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
+// SandboxMessage is a marshalled notification along with the notification type it was published with.
+type SandboxMessage struct {
+	NotificationType string
+	Data             []byte
+}
+
 type SandboxPublisher struct {
-	pubChan chan<- []byte
+	pubChan chan<- SandboxMessage
 }
 
 func (p *SandboxPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
@@ -21,12 +27,15 @@ func (p *SandboxPublisher) Publish(ctx context.Context, notificationType string,
 		return err
 	}
 
-	p.pubChan <- data
+	p.pubChan <- SandboxMessage{
+		NotificationType: notificationType,
+		Data:             data,
+	}
 
 	return nil
 }
 
-func NewSandboxPublisher(pubChan chan<- []byte) *SandboxPublisher {
+func NewSandboxPublisher(pubChan chan<- SandboxMessage) *SandboxPublisher {
 	return &SandboxPublisher{
 		pubChan: pubChan,
 	}
@@ -17,7 +17,7 @@ func (m *mockMessage) ProtoMessage()            {}
 func (m *mockMessage) Marshal() ([]byte, error) { return nil, errors.New("forced marshal error") }
 
 func TestSandboxPublisher_Publish(t *testing.T) {
-	msgChan := make(chan []byte, 1)
+	msgChan := make(chan SandboxMessage, 1)
 	publisher := NewSandboxPublisher(msgChan)
 
 	err := publisher.Publish(context.Background(), "NOTIFICATION_TYPE", &testEmail)
@@ -27,7 +27,7 @@ func TestSandboxPublisher_Publish(t *testing.T) {
 }
 
 func TestSandboxPublisher_PublishMarshalError(t *testing.T) {
-	msgChan := make(chan []byte, 1)
+	msgChan := make(chan SandboxMessage, 1)
 	publisher := NewSandboxPublisher(msgChan)
 
 	err := publisher.Publish(context.Background(), "testMarshallError", &mockMessage{})
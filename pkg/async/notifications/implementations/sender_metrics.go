@@ -0,0 +1,24 @@
+package implementations
+
+import (
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type senderMetrics struct {
+	Scope       promutils.Scope
+	SendSuccess prometheus.Counter
+	SendError   prometheus.Counter
+	SendRetry   prometheus.Counter
+	SendTotal   prometheus.Counter
+}
+
+func newSenderMetrics(scope promutils.Scope) senderMetrics {
+	return senderMetrics{
+		Scope:       scope,
+		SendSuccess: scope.MustNewCounter("send_success", "Number of notifications successfully delivered via Sender."),
+		SendError:   scope.MustNewCounter("send_error", "Number of notifications that failed to be delivered via Sender"),
+		SendRetry:   scope.MustNewCounter("send_retry", "Number of delivery attempts retried after a transient error"),
+		SendTotal:   scope.MustNewCounter("send_total", "Total number of notifications attempted to be delivered"),
+	}
+}
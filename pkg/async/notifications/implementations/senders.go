@@ -0,0 +1,21 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Senders maps the notification type a message was published with to the Sender natively delivering it.
+type Senders = map[string]interfaces.Sender
+
+// sendNotification delivers the message using the Sender registered for the notification type, if any, and with the
+// emailer otherwise. Messages published before native delivery existed have no usable type and are always emailed.
+func sendNotification(ctx context.Context, emailer interfaces.Emailer, senders Senders, notificationType string,
+	message admin.EmailMessage) error {
+	if sender, ok := senders[notificationType]; ok {
+		return sender.Send(ctx, message)
+	}
+	return emailer.SendEmail(ctx, message)
+}
@@ -0,0 +1,156 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultSlackAPIURL = "https://slack.com/api"
+	// The Web API error code of throttled requests, which are worth retrying.
+	slackRateLimitedError = "ratelimited"
+)
+
+type slackMessage struct {
+	Channel string `json:"channel,omitempty"`
+	Text    string `json:"text"`
+}
+
+type slackAPIResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// slackAPIError is returned when the Web API fails to post a message.
+type slackAPIError struct {
+	Channel string
+	Code    string
+}
+
+func (e *slackAPIError) Error() string {
+	return fmt.Sprintf("chat.postMessage to channel [%s] failed with error [%s]", e.Channel, e.Code)
+}
+
+// SlackSender posts notifications to Slack, either through an incoming webhook or, when a bot token is configured,
+// with chat.postMessage to every recipient channel.
+type SlackSender struct {
+	httpSender
+	webhookURL     string
+	token          string
+	apiURL         string
+	defaultChannel string
+}
+
+func getSlackText(message admin.EmailMessage) string {
+	if len(message.SubjectLine) == 0 {
+		return message.Body
+	}
+	return fmt.Sprintf("*%s*\n%s", message.SubjectLine, message.Body)
+}
+
+// Slack notifications used to be delivered through email integrations, so recipients may still be email addresses.
+// Those can't be posted to and are skipped.
+func getSlackChannels(recipients []string, defaultChannel string) []string {
+	channels := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		if !strings.Contains(recipient, "@") {
+			channels = append(channels, recipient)
+		}
+	}
+	if len(channels) == 0 && len(defaultChannel) > 0 {
+		channels = append(channels, defaultChannel)
+	}
+	return channels
+}
+
+func (s *SlackSender) postMessage(ctx context.Context, channel, text string) error {
+	body, err := json.Marshal(slackMessage{Channel: channel, Text: text})
+	if err != nil {
+		return err
+	}
+	responseBody, err := s.postOnce(ctx, s.apiURL+"/chat.postMessage", map[string]string{
+		"Authorization": "Bearer " + s.token,
+	}, body)
+	if err != nil {
+		return err
+	}
+	// The Web API reports most failures with a successful status code.
+	var response slackAPIResponse
+	if err = json.Unmarshal(responseBody, &response); err != nil {
+		return err
+	}
+	if !response.OK {
+		return &slackAPIError{Channel: channel, Code: response.Error}
+	}
+	return nil
+}
+
+func (s *SlackSender) send(ctx context.Context, message admin.EmailMessage) error {
+	text := getSlackText(message)
+	if len(s.token) == 0 {
+		body, err := json.Marshal(slackMessage{Text: text})
+		if err != nil {
+			return err
+		}
+		_, err = s.post(ctx, s.webhookURL, nil, body)
+		return err
+	}
+	channels := getSlackChannels(message.RecipientsEmail, s.defaultChannel)
+	if len(channels) == 0 {
+		return fmt.Errorf("no slack channel to post notification [%s] to", message.SubjectLine)
+	}
+	return s.sendToEach(ctx, channels, func(ctx context.Context, channel string) error {
+		return s.postMessage(ctx, channel, text)
+	})
+}
+
+func (s *SlackSender) Send(ctx context.Context, message admin.EmailMessage) error {
+	s.systemMetrics.SendTotal.Inc()
+	if err := s.send(ctx, message); err != nil {
+		logger.Errorf(ctx, "error in sending slack notification [%s] with err: %v", message.String(), err)
+		s.systemMetrics.SendError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, "errors were seen while sending slack notifications: %v", err)
+	}
+	logger.Debugf(ctx, "Sent slack notification to %s sub: %s", message.RecipientsEmail, message.SubjectLine)
+	s.systemMetrics.SendSuccess.Inc()
+	return nil
+}
+
+func NewSlackSender(ctx context.Context, config runtimeInterfaces.NotificationsSlackConfig, sm core.SecretManager,
+	scope promutils.Scope) (interfaces.Sender, error) {
+	sender := &SlackSender{
+		httpSender:     newHTTPSender(config.RetryAttempts, config.RetryDelaySeconds, newSenderMetrics(scope.NewSubScope("slack"))),
+		apiURL:         strings.TrimSuffix(config.APIURL, "/"),
+		defaultChannel: config.DefaultChannel,
+	}
+	if len(sender.apiURL) == 0 {
+		sender.apiURL = defaultSlackAPIURL
+	}
+	var err error
+	if len(config.TokenSecretName) > 0 {
+		if sender.token, err = sm.Get(ctx, config.TokenSecretName); err != nil {
+			return nil, err
+		}
+		sender.token = strings.TrimSpace(sender.token)
+		return sender, nil
+	}
+	if len(config.WebhookURLSecretName) == 0 {
+		return nil, fmt.Errorf("either a slack webhook url or a bot token secret name must be configured")
+	}
+	if sender.webhookURL, err = sm.Get(ctx, config.WebhookURLSecretName); err != nil {
+		return nil, err
+	}
+	sender.webhookURL = strings.TrimSpace(sender.webhookURL)
+	return sender, nil
+}
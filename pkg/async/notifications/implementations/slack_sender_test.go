@@ -0,0 +1,171 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testSlackMessage = admin.EmailMessage{
+	RecipientsEmail: []string{"#alerts", "legacy@example.slack.com", "C024BE91L"},
+	SubjectLine:     "Execution failed",
+	Body:            "Execution e124 has failed.",
+}
+
+func TestGetSlackChannels(t *testing.T) {
+	assert.Equal(t, []string{"#alerts", "C024BE91L"}, getSlackChannels(testSlackMessage.RecipientsEmail, "#default"))
+	assert.Equal(t, []string{"#default"}, getSlackChannels([]string{"legacy@example.slack.com"}, "#default"))
+	assert.Empty(t, getSlackChannels(nil, ""))
+}
+
+func TestSlackSender_Webhook(t *testing.T) {
+	var received []slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var message slackMessage
+		assert.NoError(t, json.Unmarshal(body, &message))
+		received = append(received, message)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_webhook").Return(server.URL+"\n", nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		WebhookURLSecretName: "slack_webhook",
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	assert.NoError(t, sender.Send(context.Background(), testSlackMessage))
+	assert.Equal(t, []slackMessage{{Text: "*Execution failed*\nExecution e124 has failed."}}, received)
+}
+
+func TestSlackSender_PostMessage(t *testing.T) {
+	var channels []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		var message slackMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		channels = append(channels, message.Channel)
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_token").Return("xoxb-token", nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		TokenSecretName: "slack_token",
+		APIURL:          server.URL + "/",
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	assert.NoError(t, sender.Send(context.Background(), testSlackMessage))
+	assert.Equal(t, []string{"#alerts", "C024BE91L"}, channels)
+}
+
+func TestSlackSender_PostMessageAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_token").Return("xoxb-token", nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		TokenSecretName: "slack_token",
+		APIURL:          server.URL,
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send(context.Background(), testSlackMessage))
+}
+
+func TestSlackSender_RetriesFailedChannels(t *testing.T) {
+	attempts := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message slackMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		attempts[message.Channel]++
+		switch {
+		case message.Channel == "#alerts":
+			_, _ = w.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+		case attempts[message.Channel] < 2:
+			_, _ = w.Write([]byte(`{"ok": false, "error": "ratelimited"}`))
+		default:
+			_, _ = w.Write([]byte(`{"ok": true}`))
+		}
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_token").Return("xoxb-token", nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		TokenSecretName: "slack_token",
+		APIURL:          server.URL,
+		RetryAttempts:   3,
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+	err = sender.Send(context.Background(), testSlackMessage)
+	assert.EqualError(t, err, "errors were seen while sending slack notifications: "+
+		"[#alerts]: chat.postMessage to channel [#alerts] failed with error [channel_not_found]")
+	assert.Equal(t, map[string]int{"#alerts": 1, "C024BE91L": 2}, attempts)
+}
+
+func TestSlackSender_RetriesServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_webhook").Return(server.URL, nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		WebhookURLSecretName: "slack_webhook",
+		RetryAttempts:        3,
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.NoError(t, sender.Send(context.Background(), testSlackMessage))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestSlackSender_NoRetryOnClientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "slack_webhook").Return(server.URL, nil)
+	sender, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{
+		WebhookURLSecretName: "slack_webhook",
+		RetryAttempts:        3,
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send(context.Background(), testSlackMessage))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestNewSlackSender_MissingSecret(t *testing.T) {
+	_, err := NewSlackSender(context.Background(), runtimeInterfaces.NotificationsSlackConfig{},
+		&mocks.SecretManager{}, promutils.NewTestScope())
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package implementations
+
+import (
+	"context"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/flyteorg/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+)
+
+// keyedMessage is implemented by subscriber messages carrying the key, i.e. the notification type, they were
+// published with.
+type keyedMessage interface {
+	Key() string
+}
+
+// StreamProcessor processes notifications consumed from streams such as Kafka topics and NATS JetStream streams. The
+// messages are serialized admin.EmailMessages and are only acknowledged once processed, so that notifications whose
+// processing was interrupted are redelivered.
+type StreamProcessor struct {
+	name          string
+	sub           pubsub.Subscriber
+	email         interfaces.Emailer
+	senders       Senders
+	deadLetters   *DeadLetterHandler
+	systemMetrics processorSystemMetrics
+}
+
+// Notifications which can't be processed are dead-lettered by deadLetters, if set.
+func NewStreamProcessor(name string, sub pubsub.Subscriber, emailer interfaces.Emailer, senders Senders,
+	deadLetters *DeadLetterHandler, scope promutils.Scope) interfaces.Processor {
+	return &StreamProcessor{
+		name:          name,
+		sub:           sub,
+		email:         emailer,
+		senders:       senders,
+		deadLetters:   deadLetters,
+		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope(name + "_processor")),
+	}
+}
+
+func (p *StreamProcessor) StartProcessing() {
+	for {
+		logger.Warningf(context.Background(), "Starting %s notifications processor", p.name)
+		err := p.run()
+		logger.Errorf(context.Background(), "error with running %s processor err: [%v] ", p.name, err)
+		time.Sleep(async.RetryDelay)
+	}
+}
+
+func (p *StreamProcessor) run() error {
+	for msg := range p.sub.Start() {
+		p.systemMetrics.MessageTotal.Inc()
+
+		var notificationType string
+		if keyed, ok := msg.(keyedMessage); ok {
+			notificationType = keyed.Key()
+		}
+
+		var emailMessage admin.EmailMessage
+		if err := proto.Unmarshal(msg.Message(), &emailMessage); err != nil {
+			logger.Debugf(context.Background(), "failed to unmarshal to notification object message [%s] with err: %v", string(msg.Message()), err)
+			p.systemMetrics.MessageDecodingError.Inc()
+			p.deadLetters.reject(context.Background(), notificationType, msg.Message(), err)
+			p.markMessageDone(msg)
+			continue
+		}
+
+		if err := p.deadLetters.send(context.Background(), p.email, p.senders, notificationType, emailMessage); err != nil {
+			p.systemMetrics.MessageProcessorError.Inc()
+			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
+		} else {
+			p.systemMetrics.MessageSuccess.Inc()
+		}
+
+		p.markMessageDone(msg)
+	}
+
+	if err := p.sub.Err(); err != nil {
+		p.systemMetrics.ChannelClosedError.Inc()
+		logger.Warningf(context.Background(), "The stream for the subscriber channel closed with err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *StreamProcessor) markMessageDone(message pubsub.SubscriberMessage) {
+	if err := message.Done(); err != nil {
+		p.systemMetrics.MessageDoneError.Inc()
+		logger.Errorf(context.Background(), "failed to mark message as Done() in processor with err: %v", err)
+	}
+}
+
+func (p *StreamProcessor) StopProcessing() error {
+	if err := p.sub.Stop(); err != nil {
+		p.systemMetrics.StopError.Inc()
+		logger.Errorf(context.Background(), "Failed to stop the subscriber channel gracefully with err: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testKeyedMessage struct {
+	data []byte
+	key  string
+	done bool
+}
+
+func (m *testKeyedMessage) Message() []byte {
+	return m.data
+}
+
+func (m *testKeyedMessage) Key() string {
+	return m.key
+}
+
+func (m *testKeyedMessage) ExtendDoneDeadline(time.Duration) error {
+	return nil
+}
+
+func (m *testKeyedMessage) Done() error {
+	m.done = true
+	return nil
+}
+
+type testStreamSubscriber struct {
+	messages []pubsub.SubscriberMessage
+	err      error
+}
+
+func (s *testStreamSubscriber) Start() <-chan pubsub.SubscriberMessage {
+	messages := make(chan pubsub.SubscriberMessage, len(s.messages))
+	for _, msg := range s.messages {
+		messages <- msg
+	}
+	close(messages)
+	return messages
+}
+
+func (s *testStreamSubscriber) Err() error {
+	return s.err
+}
+
+func (s *testStreamSubscriber) Stop() error {
+	return nil
+}
+
+func TestStreamProcessor_StartProcessing(t *testing.T) {
+	data, err := proto.Marshal(&testEmail)
+	assert.NoError(t, err)
+	email := &testKeyedMessage{data: data, key: "email"}
+	slack := &testKeyedMessage{data: data, key: proto.MessageName(&admin.SlackNotification{})}
+	malformed := &testKeyedMessage{data: []byte("malformed"), key: "email"}
+	sub := &testStreamSubscriber{messages: []pubsub.SubscriberMessage{email, slack, malformed}}
+
+	var emailer mocks.MockEmailer
+	var emails int
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		emails++
+		assert.True(t, proto.Equal(&testEmail, &email))
+		return nil
+	})
+	var sender mocks.MockSender
+	var sent int
+	sender.SetSendFunc(func(ctx context.Context, message admin.EmailMessage) error {
+		sent++
+		return nil
+	})
+	processor := NewStreamProcessor("kafka", sub, &emailer, Senders{
+		proto.MessageName(&admin.SlackNotification{}): &sender,
+	}, nil, promutils.NewTestScope())
+
+	assert.NoError(t, processor.(*StreamProcessor).run())
+	assert.Equal(t, 1, emails)
+	assert.Equal(t, 1, sent)
+	// Messages are acknowledged once processed, including those which can never be processed.
+	assert.True(t, email.done)
+	assert.True(t, slack.done)
+	assert.True(t, malformed.done)
+
+	m := &dto.Metric{}
+	assert.NoError(t, processor.(*StreamProcessor).systemMetrics.MessageSuccess.Write(m))
+	assert.Equal(t, "counter:<value:2 > ", m.String())
+	assert.NoError(t, processor.(*StreamProcessor).systemMetrics.MessageDecodingError.Write(m))
+	assert.Equal(t, "counter:<value:1 > ", m.String())
+}
+
+func TestStreamProcessor_StartProcessingError(t *testing.T) {
+	expected := errors.New("connection lost")
+	processor := NewStreamProcessor("nats", &testStreamSubscriber{err: expected}, &mocks.MockEmailer{}, nil, nil,
+		promutils.NewTestScope())
+	assert.Equal(t, expected, processor.(*StreamProcessor).run())
+}
+
+func TestStreamProcessor_DeadLetters(t *testing.T) {
+	malformed := &testKeyedMessage{data: []byte("malformed"), key: "email"}
+	sub := &testStreamSubscriber{messages: []pubsub.SubscriberMessage{malformed}}
+
+	var deadLetters []interfaces.DeadLetter
+	var queue mocks.MockDeadLetterQueue
+	queue.SetPutFunc(func(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+		deadLetters = append(deadLetters, deadLetter)
+		return nil
+	})
+	scope := promutils.NewTestScope()
+	processor := NewStreamProcessor("nats", sub, &mocks.MockEmailer{}, nil,
+		NewDeadLetterHandler("nats", &queue, testDeadLetterConfig, scope), scope)
+
+	assert.NoError(t, processor.(*StreamProcessor).run())
+	assert.True(t, malformed.done)
+	assert.Len(t, deadLetters, 1)
+	assert.Equal(t, "email", deadLetters[0].NotificationType)
+	assert.Equal(t, []byte("malformed"), deadLetters[0].RawMessage)
+	assert.Nil(t, deadLetters[0].Message)
+}
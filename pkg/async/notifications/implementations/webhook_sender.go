@@ -0,0 +1,104 @@
+package implementations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// WebhookSignatureHeader holds the hex encoded HMAC-SHA256 of the request body, prefixed with "sha256=".
+	WebhookSignatureHeader = "X-Flyte-Signature-256"
+	webhookSignaturePrefix = "sha256="
+)
+
+type webhook struct {
+	url    string
+	secret []byte
+}
+
+// WebhookSender posts JSON payloads to the configured webhooks. Every message is addressed to webhooks by name and
+// its body, already rendered by the publisher, is posted as is.
+type WebhookSender struct {
+	httpSender
+	webhooks map[string]webhook
+}
+
+// SignWebhookPayload returns the signature header value receivers can use to authenticate a payload.
+func SignWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	// Writes to a hash never fail.
+	_, _ = mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *WebhookSender) send(ctx context.Context, name string, body []byte) error {
+	target, ok := s.webhooks[name]
+	if !ok {
+		return fmt.Errorf("no webhook named [%s] is configured", name)
+	}
+	var headers map[string]string
+	if len(target.secret) > 0 {
+		headers = map[string]string{
+			WebhookSignatureHeader: SignWebhookPayload(target.secret, body),
+		}
+	}
+	_, err := s.post(ctx, target.url, headers, body)
+	return err
+}
+
+func (s *WebhookSender) Send(ctx context.Context, message admin.EmailMessage) error {
+	s.systemMetrics.SendTotal.Inc()
+	var failed []string
+	for _, name := range message.RecipientsEmail {
+		if err := s.send(ctx, name, []byte(message.Body)); err != nil {
+			logger.Errorf(ctx, "error in posting [%s] to webhook [%s] with err: %v", message.SubjectLine, name, err)
+			failed = append(failed, name)
+		}
+	}
+	if len(failed) > 0 {
+		s.systemMetrics.SendError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, "errors were seen while posting to webhooks %v", failed)
+	}
+	logger.Debugf(ctx, "Posted [%s] to webhooks %s", message.SubjectLine, message.RecipientsEmail)
+	s.systemMetrics.SendSuccess.Inc()
+	return nil
+}
+
+func NewWebhookSender(ctx context.Context, config runtimeInterfaces.NotificationsWebhookConfig, sm core.SecretManager,
+	scope promutils.Scope) (interfaces.Sender, error) {
+	sender := &WebhookSender{
+		httpSender: newHTTPSender(config.RetryAttempts, config.RetryDelaySeconds, newSenderMetrics(scope.NewSubScope("webhook"))),
+		webhooks:   make(map[string]webhook, len(config.Webhooks)),
+	}
+	for _, webhookConfig := range config.Webhooks {
+		if len(webhookConfig.Name) == 0 || len(webhookConfig.URL) == 0 {
+			return nil, fmt.Errorf("webhooks require both a name and a url, got [%+v]", webhookConfig)
+		}
+		if _, ok := sender.webhooks[webhookConfig.Name]; ok {
+			return nil, fmt.Errorf("webhook name [%s] is not unique", webhookConfig.Name)
+		}
+		target := webhook{url: webhookConfig.URL}
+		if len(webhookConfig.SecretName) > 0 {
+			secret, err := sm.Get(ctx, webhookConfig.SecretName)
+			if err != nil {
+				return nil, err
+			}
+			target.secret = []byte(strings.TrimSpace(secret))
+		}
+		sender.webhooks[webhookConfig.Name] = target
+	}
+	return sender, nil
+}
@@ -0,0 +1,94 @@
+package implementations
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testWebhookBody = `{"execution":{},"event":{"phase":"FAILED"}}`
+
+func TestSignWebhookPayload(t *testing.T) {
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		SignWebhookPayload([]byte("key"), []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+func TestWebhookSender_Send(t *testing.T) {
+	var signatures []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, testWebhookBody, string(body))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		signatures = append(signatures, r.Header.Get(WebhookSignatureHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sm := &mocks.SecretManager{}
+	sm.OnGetMatch(mock.Anything, "incident_bot_secret").Return("s3cr3t\n", nil)
+	sender, err := NewWebhookSender(context.Background(), runtimeInterfaces.NotificationsWebhookConfig{
+		Webhooks: []runtimeInterfaces.WebhookConfig{
+			{Name: "incident-bot", URL: server.URL + "/signed", SecretName: "incident_bot_secret"},
+			{Name: "chatops", URL: server.URL + "/unsigned"},
+		},
+	}, sm, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	assert.NoError(t, sender.Send(context.Background(), admin.EmailMessage{
+		RecipientsEmail: []string{"incident-bot", "chatops"},
+		Body:            testWebhookBody,
+	}))
+	assert.Equal(t, []string{SignWebhookPayload([]byte("s3cr3t"), []byte(testWebhookBody)), ""}, signatures)
+}
+
+func TestWebhookSender_SendFailures(t *testing.T) {
+	delivered := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delivered++
+	}))
+	defer server.Close()
+
+	sender, err := NewWebhookSender(context.Background(), runtimeInterfaces.NotificationsWebhookConfig{
+		Webhooks: []runtimeInterfaces.WebhookConfig{
+			{Name: "broken", URL: server.URL + "/broken"},
+			{Name: "working", URL: server.URL + "/working"},
+		},
+	}, &mocks.SecretManager{}, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	// A failing webhook doesn't prevent delivering to the others.
+	assert.Error(t, sender.Send(context.Background(), admin.EmailMessage{
+		RecipientsEmail: []string{"broken", "unknown", "working"},
+		Body:            testWebhookBody,
+	}))
+	assert.Equal(t, 1, delivered)
+}
+
+func TestNewWebhookSender_InvalidConfig(t *testing.T) {
+	_, err := NewWebhookSender(context.Background(), runtimeInterfaces.NotificationsWebhookConfig{
+		Webhooks: []runtimeInterfaces.WebhookConfig{{Name: "no-url"}},
+	}, &mocks.SecretManager{}, promutils.NewTestScope())
+	assert.Error(t, err)
+
+	_, err = NewWebhookSender(context.Background(), runtimeInterfaces.NotificationsWebhookConfig{
+		Webhooks: []runtimeInterfaces.WebhookConfig{
+			{Name: "dup", URL: "http://localhost/a"},
+			{Name: "dup", URL: "http://localhost/b"},
+		},
+	}, &mocks.SecretManager{}, promutils.NewTestScope())
+	assert.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// DeadLetter is a notification which a processor failed to decode, or failed to send after exhausting its retries.
+type DeadLetter struct {
+	// The processor which received the notification, e.g. aws or kafka.
+	Processor string
+	// The key the notification was published with, if known.
+	NotificationType string
+	// Unset when the notification couldn't be decoded.
+	Message *admin.EmailMessage
+	// The message as it was received, set when the notification couldn't be decoded.
+	RawMessage []byte
+	// Why the notification couldn't be processed.
+	Reason string
+	// How many times sending the notification was attempted.
+	Attempts int
+}
+
+// A DeadLetterQueue keeps notifications which couldn't be processed so that they can be inspected and replayed.
+type DeadLetterQueue interface {
+	Put(ctx context.Context, deadLetter DeadLetter) error
+}
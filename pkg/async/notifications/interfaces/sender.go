@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// A Sender natively delivers notifications of a single type other than email (e.g. Slack or PagerDuty).
+// Processors pick the Sender registered for the notification type a message was published with and fall back to the
+// Emailer when there is none.
+type Sender interface {
+	// The message has already been rendered using the templates configured for the notification type.
+	Send(ctx context.Context, message admin.EmailMessage) error
+}
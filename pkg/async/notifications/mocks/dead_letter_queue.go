@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+)
+
+type PutFunc func(ctx context.Context, deadLetter interfaces.DeadLetter) error
+
+type MockDeadLetterQueue struct {
+	putFunc PutFunc
+}
+
+func (m *MockDeadLetterQueue) SetPutFunc(put PutFunc) {
+	m.putFunc = put
+}
+
+func (m *MockDeadLetterQueue) Put(ctx context.Context, deadLetter interfaces.DeadLetter) error {
+	if m.putFunc != nil {
+		return m.putFunc(ctx, deadLetter)
+	}
+	return nil
+}
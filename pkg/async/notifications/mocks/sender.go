@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type SendFunc func(ctx context.Context, message admin.EmailMessage) error
+
+type MockSender struct {
+	sendFunc SendFunc
+}
+
+func (m *MockSender) SetSendFunc(send SendFunc) {
+	m.sendFunc = send
+}
+
+func (m *MockSender) Send(ctx context.Context, message admin.EmailMessage) error {
+	if m.sendFunc != nil {
+		return m.sendFunc(ctx, message)
+	}
+	return nil
+}
@@ -0,0 +1,326 @@
+package notifications
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/ptypes"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// LegacyTemplateEngine only substitutes the fixed set of {{ project }}-style parameters. It's the default.
+	LegacyTemplateEngine = "legacy"
+	// GoTemplateEngine renders templates with text/template, and email bodies with html/template, against TemplateData.
+	GoTemplateEngine = "gotemplate"
+)
+
+// Keys of the cluster resource attributes which override the notification templates of a notification type, for
+// instance "notifications.slack.body". Like other matchable attributes they're stored per project, domain, workflow and
+// launch plan, and can be changed without redeploying.
+const templateOverrideKeyFormat = "notifications.%s.%s"
+
+// The most parsed templates the GoTemplateEngine keeps, for each of text/template and html/template.
+const maxCachedTemplates = 256
+
+// TemplateData is what templates rendered with the GoTemplateEngine are executed against.
+type TemplateData struct {
+	Execution *admin.Execution
+	Event     *event.WorkflowExecutionEvent
+	Project   string
+	Domain    string
+	Name      string
+	// The lower-cased phase the execution transitioned to, e.g. "failed".
+	Phase string
+	Error *core.ExecutionError
+	// How long the execution ran for, zero if it never started.
+	Duration time.Duration
+	// Link to the execution in the Flyte console, empty unless NotificationsConfig.ConsoleURL is set.
+	ConsoleURL  string
+	Workflow    *core.Identifier
+	LaunchPlan  *core.Identifier
+	Labels      map[string]string
+	Annotations map[string]string
+	// Only set when rendering node and task notification triggers, in which case Event is unset.
+	NodeID       string
+	Task         *core.Identifier
+	RetryAttempt uint32
+	NodeEvent    *event.NodeExecutionEvent
+	TaskEvent    *event.TaskExecutionEvent
+}
+
+func getExecutionDuration(request admin.WorkflowExecutionEventRequest, execution *admin.Execution) time.Duration {
+	if execution.GetClosure().GetDuration() != nil {
+		if duration, err := ptypes.Duration(execution.Closure.Duration); err == nil && duration > 0 {
+			return duration
+		}
+	}
+	if execution.GetClosure().GetStartedAt() == nil || request.Event.GetOccurredAt() == nil {
+		return 0
+	}
+	startedAt, err := ptypes.Timestamp(execution.Closure.StartedAt)
+	if err != nil {
+		return 0
+	}
+	occurredAt, err := ptypes.Timestamp(request.Event.OccurredAt)
+	if err != nil || occurredAt.Before(startedAt) {
+		return 0
+	}
+	return occurredAt.Sub(startedAt)
+}
+
+// GetExecutionConsoleURL returns the link to an execution in the console hosted at consoleURL, if any.
+func GetExecutionConsoleURL(consoleURL string, id *core.WorkflowExecutionIdentifier) string {
+	if len(consoleURL) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s/domains/%s/executions/%s", strings.TrimSuffix(consoleURL, "/"),
+		id.GetProject(), id.GetDomain(), id.GetName())
+}
+
+func NewTemplateData(config runtimeInterfaces.NotificationsConfig, request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) TemplateData {
+	return TemplateData{
+		Execution:   execution,
+		Event:       request.Event,
+		Project:     execution.GetId().GetProject(),
+		Domain:      execution.GetId().GetDomain(),
+		Name:        execution.GetId().GetName(),
+		Phase:       strings.ToLower(request.Event.GetPhase().String()),
+		Error:       request.Event.GetError(),
+		Duration:    getExecutionDuration(request, execution),
+		ConsoleURL:  GetExecutionConsoleURL(config.ConsoleURL, execution.GetId()),
+		Workflow:    execution.GetClosure().GetWorkflowId(),
+		LaunchPlan:  execution.GetSpec().GetLaunchPlan(),
+		Labels:      execution.GetSpec().GetLabels().GetValues(),
+		Annotations: execution.GetSpec().GetAnnotations().GetValues(),
+	}
+}
+
+// A TemplateEngine renders notification subjects and bodies.
+type TemplateEngine interface {
+	// Renders the template. html is set for bodies of emails, whose substituted values must be escaped.
+	Render(template string, data TemplateData, html bool) (string, error)
+}
+
+type legacyTemplateEngine struct{}
+
+func (legacyTemplateEngine) Render(template string, data TemplateData, _ bool) (string, error) {
+	if data.Event == nil {
+		return substituteTriggerParameters(template, data), nil
+	}
+	return substituteEmailParameters(template, admin.WorkflowExecutionEventRequest{Event: data.Event}, data.Execution), nil
+}
+
+var templateFuncs = map[string]interface{}{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// Both *textTemplate.Template and *htmlTemplate.Template execute templates.
+type executableTemplate interface {
+	Execute(wr io.Writer, data interface{}) error
+}
+
+func parseTemplate(template string, html bool) (executableTemplate, error) {
+	if html {
+		return htmlTemplate.New("notification").Funcs(templateFuncs).Parse(template)
+	}
+	return textTemplate.New("notification").Funcs(templateFuncs).Parse(template)
+}
+
+// Checks the template parses with the engine it's rendered with.
+func validateTemplate(template string, html bool) error {
+	parsed, err := parseTemplate(template, html)
+	if err != nil {
+		return err
+	}
+	if html {
+		// html/template only escapes templates when they're first executed, which fails before evaluating any of the
+		// data for templates which can't be escaped.
+		var escapeErr *htmlTemplate.Error
+		if err := parsed.Execute(io.Discard, TemplateData{}); errors.As(err, &escapeErr) {
+			return err
+		}
+	}
+	return nil
+}
+
+type goTemplateEngine struct {
+	// Parsed templates keyed by their text, configured templates are few and rendered often.
+	textTemplates *lru.Cache
+	htmlTemplates *lru.Cache
+}
+
+func (e *goTemplateEngine) Render(template string, data TemplateData, html bool) (string, error) {
+	templates := e.textTemplates
+	if html {
+		templates = e.htmlTemplates
+	}
+	var parsed executableTemplate
+	if cached, ok := templates.Get(template); ok {
+		parsed = cached.(executableTemplate)
+	} else {
+		var err error
+		if parsed, err = parseTemplate(template, html); err != nil {
+			return "", err
+		}
+		templates.Add(template, parsed)
+	}
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func newGoTemplateEngine() *goTemplateEngine {
+	// lru.New only fails for non-positive sizes.
+	textTemplates, _ := lru.New(maxCachedTemplates)
+	htmlTemplates, _ := lru.New(maxCachedTemplates)
+	return &goTemplateEngine{
+		textTemplates: textTemplates,
+		htmlTemplates: htmlTemplates,
+	}
+}
+
+var templateEngines = map[string]TemplateEngine{
+	"":                   legacyTemplateEngine{},
+	LegacyTemplateEngine: legacyTemplateEngine{},
+	GoTemplateEngine:     newGoTemplateEngine(),
+}
+
+// GetTemplateEngine returns the engine with the given name, as set in NotificationsConfig.TemplateEngine.
+func GetTemplateEngine(name string) (TemplateEngine, error) {
+	engine, ok := templateEngines[name]
+	if !ok {
+		return nil, fmt.Errorf("no matching template engine for [%s]", name)
+	}
+	return engine, nil
+}
+
+// NotificationTemplates are the subject and body templates a notification is rendered with.
+type NotificationTemplates struct {
+	Subject string
+	Body    string
+}
+
+// Launch plan overrides are the most specific, followed by those for a workflow and then a domain.
+func getTemplateOverrideSpecificity(override runtimeInterfaces.NotificationTemplateOverrideConfig) int {
+	specificity := 0
+	if len(override.LaunchPlan) > 0 {
+		specificity += 4
+	}
+	if len(override.Workflow) > 0 {
+		specificity += 2
+	}
+	if len(override.Domain) > 0 {
+		specificity++
+	}
+	return specificity
+}
+
+// Returns the most specific of the template overrides matching the execution, if any.
+func getTemplateOverride(config runtimeInterfaces.NotificationsConfig,
+	execution *admin.Execution) *runtimeInterfaces.NotificationTemplateOverrideConfig {
+	var match *runtimeInterfaces.NotificationTemplateOverrideConfig
+	for idx, override := range config.TemplateOverrides {
+		if override.Project != execution.GetId().GetProject() ||
+			(len(override.Domain) > 0 && override.Domain != execution.GetId().GetDomain()) ||
+			(len(override.Workflow) > 0 && override.Workflow != execution.GetClosure().GetWorkflowId().GetName()) ||
+			(len(override.LaunchPlan) > 0 && override.LaunchPlan != execution.GetSpec().GetLaunchPlan().GetName()) {
+			continue
+		}
+		if match == nil || getTemplateOverrideSpecificity(override) > getTemplateOverrideSpecificity(*match) {
+			match = &config.TemplateOverrides[idx]
+		}
+	}
+	return match
+}
+
+// Returns the templates of the notification type (email, slack or pagerduty) overridden for the execution. Those
+// overridden in the execution's matching attributes take precedence over the configured overrides, which fall back to
+// the configured templates.
+func getNotificationTemplates(config runtimeInterfaces.NotificationsConfig, configured NotificationTemplates,
+	notificationType string, execution *admin.Execution, attributes map[string]string) NotificationTemplates {
+	if override := getTemplateOverride(config, execution); override != nil {
+		var overridden runtimeInterfaces.NotificationTemplatesConfig
+		switch notificationType {
+		case emailTriggerType:
+			overridden = override.Email
+		case slackTriggerType:
+			overridden = override.Slack
+		case pagerDutyTriggerType:
+			overridden = override.PagerDuty
+		}
+		if len(overridden.Subject) > 0 {
+			configured.Subject = overridden.Subject
+		}
+		if len(overridden.Body) > 0 {
+			configured.Body = overridden.Body
+		}
+	}
+	if subject, ok := attributes[fmt.Sprintf(templateOverrideKeyFormat, notificationType, "subject")]; ok {
+		configured.Subject = subject
+	}
+	if body, ok := attributes[fmt.Sprintf(templateOverrideKeyFormat, notificationType, "body")]; ok {
+		configured.Body = body
+	}
+	return configured
+}
+
+// ValidateTemplates checks the configured template engine exists and, for the GoTemplateEngine, that all configured
+// templates parse with the engine they're rendered with. Email bodies are rendered with html/template, everything
+// else with text/template.
+func ValidateTemplates(config runtimeInterfaces.NotificationsConfig) error {
+	if _, err := GetTemplateEngine(config.TemplateEngine); err != nil {
+		return err
+	}
+	for _, override := range config.TemplateOverrides {
+		if len(override.Project) == 0 {
+			return fmt.Errorf("notification template override [%+v] has no project", override)
+		}
+	}
+	if config.TemplateEngine != GoTemplateEngine {
+		return nil
+	}
+	emailTemplates := []runtimeInterfaces.NotificationTemplatesConfig{{
+		Subject: config.NotificationsEmailerConfig.Subject,
+		Body:    config.NotificationsEmailerConfig.Body,
+	}}
+	otherTemplates := []runtimeInterfaces.NotificationTemplatesConfig{
+		{Subject: config.NotificationsSlackConfig.Subject, Body: config.NotificationsSlackConfig.Body},
+		{Subject: config.NotificationsPagerDutyConfig.Subject, Body: config.NotificationsPagerDutyConfig.Body},
+	}
+	for _, override := range config.TemplateOverrides {
+		emailTemplates = append(emailTemplates, override.Email)
+		otherTemplates = append(otherTemplates, override.Slack, override.PagerDuty)
+	}
+	for _, templates := range emailTemplates {
+		if err := validateTemplate(templates.Subject, false); err != nil {
+			return err
+		}
+		if err := validateTemplate(templates.Body, true); err != nil {
+			return err
+		}
+	}
+	for _, templates := range otherTemplates {
+		if err := validateTemplate(templates.Subject, false); err != nil {
+			return err
+		}
+		if err := validateTemplate(templates.Body, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package notifications
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var goTemplateConfig = runtimeInterfaces.NotificationsConfig{
+	TemplateEngine: GoTemplateEngine,
+	ConsoleURL:     "https://flyte.example.com/console/",
+	NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+		Sender:  "no-reply@example.com",
+		Subject: "{{ .Name }} {{ .Phase }}",
+		Body:    "<a href=\"{{ .ConsoleURL }}\">{{ .Name }}</a>{{ with .Error }} failed with {{ .Message }}{{ end }}",
+	},
+}
+
+func getFailedEventRequest() admin.WorkflowExecutionEventRequest {
+	return admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "<oops>",
+				},
+			},
+		},
+	}
+}
+
+func TestGetExecutionConsoleURL(t *testing.T) {
+	assert.Empty(t, GetExecutionConsoleURL("", workflowExecution.Id))
+	assert.Equal(t, "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124",
+		GetExecutionConsoleURL("https://flyte.example.com/console/", workflowExecution.Id))
+}
+
+func TestNewTemplateData(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	startedAtProto, _ := ptypes.TimestampProto(startedAt)
+	occurredAtProto, _ := ptypes.TimestampProto(startedAt.Add(time.Minute))
+	execution := &admin.Execution{
+		Id: workflowExecution.Id,
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: workflowExecution.Spec.LaunchPlan,
+			Labels: &admin.Labels{
+				Values: map[string]string{"team": "ml"},
+			},
+		},
+		Closure: &admin.ExecutionClosure{
+			WorkflowId: workflowExecution.Closure.WorkflowId,
+			StartedAt:  startedAtProto,
+		},
+	}
+	request := getFailedEventRequest()
+	request.Event.OccurredAt = occurredAtProto
+
+	data := NewTemplateData(goTemplateConfig, request, execution)
+	assert.Equal(t, "proj", data.Project)
+	assert.Equal(t, "prod", data.Domain)
+	assert.Equal(t, "e124", data.Name)
+	assert.Equal(t, "failed", data.Phase)
+	assert.Equal(t, "<oops>", data.Error.Message)
+	assert.Equal(t, time.Minute, data.Duration)
+	assert.Equal(t, "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124", data.ConsoleURL)
+	assert.Equal(t, workflowNameValue, data.Workflow.Name)
+	assert.Equal(t, launchPlanNameValue, data.LaunchPlan.Name)
+	assert.Equal(t, map[string]string{"team": "ml"}, data.Labels)
+	assert.Empty(t, data.Annotations)
+}
+
+func TestGetTemplateEngine(t *testing.T) {
+	engine, err := GetTemplateEngine("")
+	assert.NoError(t, err)
+	assert.IsType(t, legacyTemplateEngine{}, engine)
+
+	engine, err = GetTemplateEngine(GoTemplateEngine)
+	assert.NoError(t, err)
+	assert.IsType(t, &goTemplateEngine{}, engine)
+
+	_, err = GetTemplateEngine("mustache")
+	assert.Error(t, err)
+}
+
+func TestGoTemplateEngine_Render(t *testing.T) {
+	engine := newGoTemplateEngine()
+	data := NewTemplateData(goTemplateConfig, getFailedEventRequest(), workflowExecution)
+
+	rendered, err := engine.Render("{{ .Project }}/{{ .Domain }}/{{ .Name }} {{ upper .Phase }}: {{ .Error.Message }}", data, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "proj/prod/e124 FAILED: <oops>", rendered)
+
+	rendered, err = engine.Render("<b>{{ .Error.Message }}</b>", data, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "<b>&lt;oops&gt;</b>", rendered)
+
+	_, err = engine.Render("{{ .Missing }}", data, false)
+	assert.Error(t, err)
+	_, err = engine.Render("{{ .Name ", data, false)
+	assert.Error(t, err)
+}
+
+func TestGoTemplateEngine_BoundsCache(t *testing.T) {
+	engine := newGoTemplateEngine()
+	data := NewTemplateData(goTemplateConfig, getFailedEventRequest(), workflowExecution)
+	for i := 0; i <= maxCachedTemplates; i++ {
+		rendered, err := engine.Render(fmt.Sprintf("%d {{ .Name }}", i), data, false)
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d e124", i), rendered)
+	}
+	assert.Equal(t, maxCachedTemplates, engine.textTemplates.Len())
+	assert.Equal(t, 0, engine.htmlTemplates.Len())
+}
+
+func TestToEmailMessageFromWorkflowExecutionEvent_GoTemplate(t *testing.T) {
+	emailNotification := admin.EmailNotification{
+		RecipientsEmail: []string{"a@example.com"},
+	}
+	emailMessage, err := ToEmailMessageFromWorkflowExecutionEvent(goTemplateConfig, emailNotification,
+		getFailedEventRequest(), workflowExecution, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "e124 failed", emailMessage.SubjectLine)
+	assert.Equal(t, "<a href=\"https://flyte.example.com/console/projects/proj/domains/prod/executions/e124\">e124</a>"+
+		" failed with &lt;oops&gt;", emailMessage.Body)
+	assert.Equal(t, "no-reply@example.com", emailMessage.SenderEmail)
+	assert.Equal(t, []string{"a@example.com"}, emailMessage.RecipientsEmail)
+}
+
+func TestToSlackMessageFromWorkflowExecutionEvent_Overrides(t *testing.T) {
+	slackNotification := admin.SlackNotification{
+		RecipientsEmail: []string{"#alerts"},
+	}
+	config := goTemplateConfig
+	config.TemplateOverrides = []runtimeInterfaces.NotificationTemplateOverrideConfig{
+		{
+			Project: "proj",
+			Slack:   runtimeInterfaces.NotificationTemplatesConfig{Body: "unused"},
+		},
+		{
+			Project:    "proj",
+			LaunchPlan: workflowExecution.Spec.LaunchPlan.Name,
+			Slack:      runtimeInterfaces.NotificationTemplatesConfig{Body: "<{{ .ConsoleURL }}|{{ .Name }}> {{ .Error.Message }}"},
+			Email:      runtimeInterfaces.NotificationTemplatesConfig{Body: "unused"},
+		},
+		{
+			Project: "proj",
+			Domain:  "prod",
+			Slack:   runtimeInterfaces.NotificationTemplatesConfig{Body: "unused"},
+		},
+		{
+			Project: "other",
+			Slack:   runtimeInterfaces.NotificationTemplatesConfig{Subject: "unused"},
+		},
+	}
+	slackMessage, err := ToSlackMessageFromWorkflowExecutionEvent(config, slackNotification,
+		getFailedEventRequest(), workflowExecution, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "e124 failed", slackMessage.SubjectLine)
+	// Slack bodies aren't html escaped.
+	assert.Equal(t, "<https://flyte.example.com/console/projects/proj/domains/prod/executions/e124|e124> <oops>",
+		slackMessage.Body)
+
+	config.TemplateOverrides[1].Slack.Subject = "{{ .Missing }}"
+	_, err = ToSlackMessageFromWorkflowExecutionEvent(config, slackNotification,
+		getFailedEventRequest(), workflowExecution, nil)
+	assert.Error(t, err)
+
+	// Templates overridden in the matching attributes take precedence over the configured overrides.
+	attributes := map[string]string{
+		"notifications.slack.subject": "{{ .Project }} {{ .Phase }}",
+		"notifications.slack.body":    "{{ .Name }} {{ .Error.Message }}",
+		"notifications.email.body":    "unused",
+		"notifications.slack.other":   "unused",
+	}
+	slackMessage, err = ToSlackMessageFromWorkflowExecutionEvent(config, slackNotification,
+		getFailedEventRequest(), workflowExecution, attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, "proj failed", slackMessage.SubjectLine)
+	assert.Equal(t, "e124 <oops>", slackMessage.Body)
+}
+
+func TestValidateTemplates(t *testing.T) {
+	assert.NoError(t, ValidateTemplates(runtimeInterfaces.NotificationsConfig{}))
+	assert.NoError(t, ValidateTemplates(goTemplateConfig))
+	assert.Error(t, ValidateTemplates(runtimeInterfaces.NotificationsConfig{TemplateEngine: "mustache"}))
+
+	invalid := goTemplateConfig
+	invalid.NotificationsSlackConfig.Body = "{{ .Name "
+	assert.Error(t, ValidateTemplates(invalid))
+
+	// Email bodies are html templates, which fail to escape values in ambiguous contexts.
+	unescapable := goTemplateConfig
+	unescapable.NotificationsEmailerConfig.Body = "<a href=\"{{ .ConsoleURL }}"
+	assert.Error(t, ValidateTemplates(unescapable))
+	unescapable.NotificationsEmailerConfig.Body = ""
+	unescapable.NotificationsSlackConfig.Body = "<a href=\"{{ .ConsoleURL }}"
+	assert.NoError(t, ValidateTemplates(unescapable))
+
+	overridden := goTemplateConfig
+	overridden.TemplateOverrides = []runtimeInterfaces.NotificationTemplateOverrideConfig{
+		{Project: "proj", Email: runtimeInterfaces.NotificationTemplatesConfig{Body: "<a href=\"{{ .ConsoleURL }}"}},
+	}
+	assert.Error(t, ValidateTemplates(overridden))
+	overridden.TemplateOverrides[0].Email.Body = "{{ .Name }}"
+	assert.NoError(t, ValidateTemplates(overridden))
+	overridden.TemplateOverrides[0].Project = ""
+	assert.Error(t, ValidateTemplates(overridden))
+	// Legacy templates aren't go templates.
+	invalid.TemplateEngine = LegacyTemplateEngine
+	assert.NoError(t, ValidateTemplates(invalid))
+}
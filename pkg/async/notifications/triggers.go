@@ -0,0 +1,302 @@
+package notifications
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/proto"
+)
+
+// The ways notification triggers can be delivered, compared case-insensitively.
+const (
+	emailTriggerType     = "email"
+	slackTriggerType     = "slack"
+	pagerDutyTriggerType = "pagerduty"
+	webhookTriggerType   = "webhook"
+)
+
+const (
+	nodeWebhookPayloadType = "node"
+	taskWebhookPayloadType = "task"
+)
+
+// Parameters the legacy template engine substitutes in node and task notification triggers, on top of the ones
+// substituted in execution notifications.
+const (
+	nodeIDPlaceholder       = "node_id"
+	taskNamePlaceholder     = "task.name"
+	taskVersionPlaceholder  = "task.version"
+	retryAttemptPlaceholder = "retry_attempt"
+)
+
+func substituteTriggerParameters(message string, data TemplateData) string {
+	var errorMessage string
+	if data.Error != nil {
+		errorMessage = fmt.Sprintf(executionError, data.Error.Message)
+	}
+	values := map[string]string{
+		project:                 data.Project,
+		domain:                  data.Domain,
+		name:                    data.Name,
+		phase:                   data.Phase,
+		errorPlaceholder:        errorMessage,
+		workflowProject:         data.Workflow.GetProject(),
+		workflowDomain:          data.Workflow.GetDomain(),
+		workflowName:            data.Workflow.GetName(),
+		workflowVersion:         data.Workflow.GetVersion(),
+		launchPlanProject:       data.LaunchPlan.GetProject(),
+		launchPlanDomain:        data.LaunchPlan.GetDomain(),
+		launchPlanName:          data.LaunchPlan.GetName(),
+		launchPlanVersion:       data.LaunchPlan.GetVersion(),
+		nodeIDPlaceholder:       data.NodeID,
+		taskNamePlaceholder:     data.Task.GetName(),
+		taskVersionPlaceholder:  data.Task.GetVersion(),
+		retryAttemptPlaceholder: strconv.FormatUint(uint64(data.RetryAttempt), 10),
+	}
+	for template, value := range values {
+		message = strings.Replace(message, fmt.Sprintf(substitutionParam, template), value, replaceAllInstances)
+		message = strings.Replace(message, fmt.Sprintf(substitutionParamNoSpaces, template), value, replaceAllInstances)
+	}
+	return message
+}
+
+func matchesTriggerScope(trigger runtimeInterfaces.NotificationTriggerConfig, id *core.WorkflowExecutionIdentifier,
+	nodeID string) bool {
+	return (len(trigger.Project) == 0 || trigger.Project == id.GetProject()) &&
+		(len(trigger.Domain) == 0 || trigger.Domain == id.GetDomain()) &&
+		(len(trigger.NodeID) == 0 || trigger.NodeID == nodeID)
+}
+
+func matchesTriggerPhase(trigger runtimeInterfaces.NotificationTriggerConfig, phase string, isTerminal bool) bool {
+	if len(trigger.Phases) == 0 {
+		return isTerminal
+	}
+	for _, triggerPhase := range trigger.Phases {
+		if strings.EqualFold(triggerPhase, phase) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNodeNotificationTriggers returns the configured node triggers a node execution phase change fires.
+func GetNodeNotificationTriggers(config runtimeInterfaces.NotificationsConfig,
+	nodeEvent *event.NodeExecutionEvent) []runtimeInterfaces.NotificationTriggerConfig {
+	var triggers []runtimeInterfaces.NotificationTriggerConfig
+	for _, trigger := range config.NodeTriggers {
+		if matchesTriggerScope(trigger, nodeEvent.GetId().GetExecutionId(), nodeEvent.GetId().GetNodeId()) &&
+			matchesTriggerPhase(trigger, nodeEvent.GetPhase().String(), common.IsNodeExecutionTerminal(nodeEvent.GetPhase())) {
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers
+}
+
+// GetTaskNotificationTriggers returns the configured task triggers a task execution phase change fires.
+func GetTaskNotificationTriggers(config runtimeInterfaces.NotificationsConfig,
+	taskEvent *event.TaskExecutionEvent) []runtimeInterfaces.NotificationTriggerConfig {
+	var triggers []runtimeInterfaces.NotificationTriggerConfig
+	for _, trigger := range config.TaskTriggers {
+		if matchesTriggerScope(trigger, taskEvent.GetParentNodeExecutionId().GetExecutionId(),
+			taskEvent.GetParentNodeExecutionId().GetNodeId()) &&
+			(len(trigger.TaskName) == 0 || trigger.TaskName == taskEvent.GetTaskId().GetName()) &&
+			taskEvent.GetRetryAttempt() >= trigger.MinRetryAttempts &&
+			matchesTriggerPhase(trigger, taskEvent.GetPhase().String(), common.IsTaskExecutionTerminal(taskEvent.GetPhase())) {
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers
+}
+
+func newTriggerTemplateData(config runtimeInterfaces.NotificationsConfig, execution *admin.Execution, phase string,
+	executionError *core.ExecutionError) TemplateData {
+	return TemplateData{
+		Execution:   execution,
+		Project:     execution.GetId().GetProject(),
+		Domain:      execution.GetId().GetDomain(),
+		Name:        execution.GetId().GetName(),
+		Phase:       strings.ToLower(phase),
+		Error:       executionError,
+		ConsoleURL:  GetExecutionConsoleURL(config.ConsoleURL, execution.GetId()),
+		Workflow:    execution.GetClosure().GetWorkflowId(),
+		LaunchPlan:  execution.GetSpec().GetLaunchPlan(),
+		Labels:      execution.GetSpec().GetLabels().GetValues(),
+		Annotations: execution.GetSpec().GetAnnotations().GetValues(),
+	}
+}
+
+// NewNodeTemplateData returns what node notification triggers are rendered with. The execution must at least have
+// its identifier set.
+func NewNodeTemplateData(config runtimeInterfaces.NotificationsConfig, nodeEvent *event.NodeExecutionEvent,
+	execution *admin.Execution) TemplateData {
+	data := newTriggerTemplateData(config, execution, nodeEvent.GetPhase().String(), nodeEvent.GetError())
+	data.NodeID = nodeEvent.GetId().GetNodeId()
+	data.NodeEvent = nodeEvent
+	return data
+}
+
+// NewTaskTemplateData returns what task notification triggers are rendered with. The execution must at least have
+// its identifier set.
+func NewTaskTemplateData(config runtimeInterfaces.NotificationsConfig, taskEvent *event.TaskExecutionEvent,
+	execution *admin.Execution) TemplateData {
+	data := newTriggerTemplateData(config, execution, taskEvent.GetPhase().String(), taskEvent.GetError())
+	data.NodeID = taskEvent.GetParentNodeExecutionId().GetNodeId()
+	data.Task = taskEvent.GetTaskId()
+	data.RetryAttempt = taskEvent.GetRetryAttempt()
+	data.TaskEvent = taskEvent
+	return data
+}
+
+func getDefaultTriggerSubject(data TemplateData) string {
+	if data.TaskEvent != nil {
+		return fmt.Sprintf("Task %s (attempt %d) of node %s in execution %s/%s/%s has %s", data.Task.GetName(),
+			data.RetryAttempt, data.NodeID, data.Project, data.Domain, data.Name, data.Phase)
+	}
+	return fmt.Sprintf("Node %s in execution %s/%s/%s has %s", data.NodeID, data.Project, data.Domain, data.Name,
+		data.Phase)
+}
+
+func getDefaultTriggerBody(data TemplateData, isHTML bool) string {
+	body := getDefaultTriggerSubject(data) + "."
+	if data.Error != nil {
+		body += fmt.Sprintf(executionError, data.Error.Message)
+	}
+	if len(data.ConsoleURL) > 0 {
+		body += " View details at " + data.ConsoleURL
+	}
+	if isHTML {
+		return html.EscapeString(body)
+	}
+	return body
+}
+
+func renderTriggerMessage(config runtimeInterfaces.NotificationsConfig, trigger runtimeInterfaces.NotificationTriggerConfig,
+	data TemplateData, isHTML bool) (*admin.EmailMessage, error) {
+	engine, err := GetTemplateEngine(config.TemplateEngine)
+	if err != nil {
+		return nil, err
+	}
+	message := &admin.EmailMessage{
+		SubjectLine:     getDefaultTriggerSubject(data),
+		Body:            getDefaultTriggerBody(data, isHTML),
+		RecipientsEmail: trigger.Recipients,
+	}
+	if len(trigger.Subject) > 0 {
+		if message.SubjectLine, err = engine.Render(trigger.Subject, data, false); err != nil {
+			return nil, fmt.Errorf("failed to render subject template with err: %w", err)
+		}
+	}
+	if len(trigger.Body) > 0 {
+		if message.Body, err = engine.Render(trigger.Body, data, isHTML); err != nil {
+			return nil, fmt.Errorf("failed to render body template with err: %w", err)
+		}
+	}
+	return message, nil
+}
+
+func toWebhookMessageFromTrigger(trigger runtimeInterfaces.NotificationTriggerConfig, data TemplateData) (
+	*admin.EmailMessage, error) {
+	payloadType, event := nodeWebhookPayloadType, proto.Message(data.NodeEvent)
+	if data.TaskEvent != nil {
+		payloadType, event = taskWebhookPayloadType, data.TaskEvent
+	}
+	body, err := getWebhookBody(payloadType, data.Execution, event)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.EmailMessage{
+		SubjectLine:     getDefaultTriggerSubject(data),
+		RecipientsEmail: trigger.Recipients,
+		Body:            body,
+	}, nil
+}
+
+// ToMessageFromNotificationTrigger renders the message a fired node or task notification trigger publishes, and
+// returns it along with the notification type it's published under. Like execution notifications, Slack and PagerDuty
+// triggers are delivered by email unless they're natively delivered.
+func ToMessageFromNotificationTrigger(config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTriggerConfig, data TemplateData) (string, *admin.EmailMessage, error) {
+	switch strings.ToLower(trigger.Type) {
+	case webhookTriggerType:
+		message, err := toWebhookMessageFromTrigger(trigger, data)
+		return WebhookNotificationType, message, err
+	case slackTriggerType:
+		if config.NotificationsSlackConfig.Enabled {
+			message, err := renderTriggerMessage(config, trigger, data, false)
+			return proto.MessageName(&admin.SlackNotification{}), message, err
+		}
+	case pagerDutyTriggerType:
+		if config.NotificationsPagerDutyConfig.Enabled {
+			message, err := renderTriggerMessage(config, trigger, data, false)
+			return proto.MessageName(&admin.PagerDutyNotification{}), message, err
+		}
+	case "", emailTriggerType:
+	default:
+		return "", nil, fmt.Errorf("unsupported notification trigger type [%s]", trigger.Type)
+	}
+	message, err := renderTriggerMessage(config, trigger, data, true)
+	if err != nil {
+		return "", nil, err
+	}
+	message.SenderEmail = config.NotificationsEmailerConfig.Sender
+	return proto.MessageName(&admin.EmailNotification{}), message, nil
+}
+
+func validateNotificationTrigger(config runtimeInterfaces.NotificationsConfig,
+	trigger runtimeInterfaces.NotificationTriggerConfig, phases map[string]int32) error {
+	switch strings.ToLower(trigger.Type) {
+	case "", emailTriggerType, slackTriggerType, pagerDutyTriggerType, webhookTriggerType:
+	default:
+		return fmt.Errorf("unsupported notification trigger type [%s]", trigger.Type)
+	}
+	if len(trigger.Recipients) == 0 {
+		return fmt.Errorf("notification trigger [%+v] has no recipients", trigger)
+	}
+	for _, phase := range trigger.Phases {
+		if _, ok := phases[strings.ToUpper(phase)]; !ok {
+			return fmt.Errorf("notification trigger [%+v] has unknown phase [%s]", trigger, phase)
+		}
+	}
+	if config.TemplateEngine != GoTemplateEngine {
+		return nil
+	}
+	if err := validateTemplate(trigger.Subject, false); err != nil {
+		return err
+	}
+	// Bodies of the notifications sent as emails are rendered with html/template.
+	var isHTML bool
+	switch strings.ToLower(trigger.Type) {
+	case "", emailTriggerType:
+		isHTML = true
+	case slackTriggerType:
+		isHTML = !config.NotificationsSlackConfig.Enabled
+	case pagerDutyTriggerType:
+		isHTML = !config.NotificationsPagerDutyConfig.Enabled
+	}
+	return validateTemplate(trigger.Body, isHTML)
+}
+
+// ValidateNotificationTriggers checks the configured node and task notification triggers are well formed.
+func ValidateNotificationTriggers(config runtimeInterfaces.NotificationsConfig) error {
+	for _, trigger := range config.NodeTriggers {
+		if len(trigger.TaskName) > 0 || trigger.MinRetryAttempts > 0 {
+			return fmt.Errorf("node notification trigger [%+v] can't filter on tasks", trigger)
+		}
+		if err := validateNotificationTrigger(config, trigger, core.NodeExecution_Phase_value); err != nil {
+			return err
+		}
+	}
+	for _, trigger := range config.TaskTriggers {
+		if err := validateNotificationTrigger(config, trigger, core.TaskExecution_Phase_value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+var nodeEvent = &event.NodeExecutionEvent{
+	Id: &core.NodeExecutionIdentifier{
+		NodeId:      "n0",
+		ExecutionId: workflowExecution.Id,
+	},
+	Phase: core.NodeExecution_FAILED,
+	OutputResult: &event.NodeExecutionEvent_Error{
+		Error: &core.ExecutionError{
+			Message: "<oops>",
+		},
+	},
+}
+
+var taskEvent = &event.TaskExecutionEvent{
+	TaskId: &core.Identifier{
+		Name:    "my_task",
+		Version: "v1",
+	},
+	ParentNodeExecutionId: nodeEvent.Id,
+	Phase:                 core.TaskExecution_RUNNING,
+	RetryAttempt:          2,
+}
+
+func getTriggerRecipients(triggers []runtimeInterfaces.NotificationTriggerConfig) []string {
+	var recipients []string
+	for _, trigger := range triggers {
+		recipients = append(recipients, trigger.Recipients...)
+	}
+	return recipients
+}
+
+func TestGetNodeNotificationTriggers(t *testing.T) {
+	config := runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{
+			{Recipients: []string{"terminal"}},
+			{Project: "proj", Domain: "prod", NodeID: "n0", Phases: []string{"failed"}, Recipients: []string{"n0"}},
+			{NodeID: "n1", Recipients: []string{"n1"}},
+			{Domain: "dev", Recipients: []string{"dev"}},
+			{Phases: []string{"RUNNING"}, Recipients: []string{"running"}},
+		},
+	}
+	assert.Equal(t, []string{"terminal", "n0"}, getTriggerRecipients(GetNodeNotificationTriggers(config, nodeEvent)))
+	assert.Equal(t, []string{"running"}, getTriggerRecipients(GetNodeNotificationTriggers(config,
+		&event.NodeExecutionEvent{Id: nodeEvent.Id, Phase: core.NodeExecution_RUNNING})))
+}
+
+func TestGetTaskNotificationTriggers(t *testing.T) {
+	config := runtimeInterfaces.NotificationsConfig{
+		TaskTriggers: []runtimeInterfaces.NotificationTriggerConfig{
+			{Recipients: []string{"terminal"}},
+			{TaskName: "my_task", Phases: []string{"RUNNING"}, Recipients: []string{"my_task"}},
+			{TaskName: "other_task", Phases: []string{"RUNNING"}, Recipients: []string{"other_task"}},
+			{MinRetryAttempts: 2, Phases: []string{"RUNNING"}, Recipients: []string{"retried twice"}},
+			{MinRetryAttempts: 3, Phases: []string{"RUNNING"}, Recipients: []string{"retried thrice"}},
+		},
+	}
+	assert.Equal(t, []string{"my_task", "retried twice"},
+		getTriggerRecipients(GetTaskNotificationTriggers(config, taskEvent)))
+}
+
+func TestToMessageFromNotificationTrigger(t *testing.T) {
+	config := runtimeInterfaces.NotificationsConfig{
+		ConsoleURL: "https://flyte.example.com/console",
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender: "no-reply@example.com",
+		},
+		NotificationsSlackConfig: runtimeInterfaces.NotificationsSlackConfig{
+			Enabled: true,
+		},
+	}
+	trigger := runtimeInterfaces.NotificationTriggerConfig{
+		Recipients: []string{"a@example.com"},
+	}
+	data := NewNodeTemplateData(config, nodeEvent, workflowExecution)
+
+	notificationType, message, err := ToMessageFromNotificationTrigger(config, trigger, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "flyteidl.admin.EmailNotification", notificationType)
+	assert.Equal(t, "Node n0 in execution proj/prod/e124 has failed", message.SubjectLine)
+	assert.Equal(t, "Node n0 in execution proj/prod/e124 has failed. The execution failed with error: [&lt;oops&gt;]. "+
+		"View details at https://flyte.example.com/console/projects/proj/domains/prod/executions/e124", message.Body)
+	assert.Equal(t, "no-reply@example.com", message.SenderEmail)
+
+	trigger.Type = "Slack"
+	trigger.Subject = "{{ node_id }} of {{ workflow.name }} {{ phase }}{{ error }}"
+	trigger.Body = "{{ name }}"
+	notificationType, message, err = ToMessageFromNotificationTrigger(config, trigger, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "flyteidl.admin.SlackNotification", notificationType)
+	assert.Equal(t, "n0 of wf_name failed The execution failed with error: [<oops>].", message.SubjectLine)
+	assert.Equal(t, "e124", message.Body)
+
+	config.TemplateEngine = GoTemplateEngine
+	trigger.Type = "pagerDuty"
+	trigger.Subject = "{{ .Task.Name }} attempt {{ .RetryAttempt }} of {{ .Workflow.Name }} is {{ .Phase }}"
+	trigger.Body = ""
+	notificationType, message, err = ToMessageFromNotificationTrigger(config, trigger,
+		NewTaskTemplateData(config, taskEvent, workflowExecution))
+	assert.NoError(t, err)
+	// PagerDuty isn't natively delivered.
+	assert.Equal(t, "flyteidl.admin.EmailNotification", notificationType)
+	assert.Equal(t, "my_task attempt 2 of wf_name is running", message.SubjectLine)
+	assert.Equal(t, "Task my_task (attempt 2) of node n0 in execution proj/prod/e124 has running. "+
+		"View details at https://flyte.example.com/console/projects/proj/domains/prod/executions/e124", message.Body)
+
+	trigger.Type = "webhook"
+	notificationType, message, err = ToMessageFromNotificationTrigger(config, trigger, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "webhook", notificationType)
+	assert.Contains(t, message.Body, `"type":"node"`)
+
+	trigger.Type = "carrier pigeon"
+	_, _, err = ToMessageFromNotificationTrigger(config, trigger, data)
+	assert.Error(t, err)
+}
+
+func TestValidateNotificationTriggers(t *testing.T) {
+	valid := runtimeInterfaces.NotificationTriggerConfig{
+		Phases:     []string{"failed"},
+		Type:       "webhook",
+		Recipients: []string{"hook"},
+	}
+	config := runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{valid},
+		TaskTriggers: []runtimeInterfaces.NotificationTriggerConfig{valid},
+	}
+	assert.NoError(t, ValidateNotificationTriggers(config))
+
+	invalid := valid
+	invalid.Phases = []string{"RETRYING"}
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		TaskTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+
+	invalid = valid
+	invalid.MinRetryAttempts = 1
+	assert.NoError(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		TaskTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+
+	invalid = valid
+	invalid.Recipients = nil
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+
+	invalid = valid
+	invalid.Type = "sms"
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+
+	invalid = valid
+	invalid.Subject = "{{ .NodeID "
+	assert.NoError(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		NodeTriggers: []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		TemplateEngine: GoTemplateEngine,
+		NodeTriggers:   []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+
+	// Bodies of the triggers sent as emails are html templates, which fail to escape values in ambiguous contexts.
+	invalid = valid
+	invalid.Type = "slack"
+	invalid.Body = "<a href=\"{{ .ConsoleURL }}"
+	assert.Error(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		TemplateEngine: GoTemplateEngine,
+		NodeTriggers:   []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+	assert.NoError(t, ValidateNotificationTriggers(runtimeInterfaces.NotificationsConfig{
+		TemplateEngine:           GoTemplateEngine,
+		NotificationsSlackConfig: runtimeInterfaces.NotificationsSlackConfig{Enabled: true},
+		NodeTriggers:             []runtimeInterfaces.NotificationTriggerConfig{invalid},
+	}))
+}
+
+func TestNewTaskTemplateData(t *testing.T) {
+	data := NewTaskTemplateData(runtimeInterfaces.NotificationsConfig{}, taskEvent, &admin.Execution{Id: workflowExecution.Id})
+	assert.Equal(t, "n0", data.NodeID)
+	assert.Equal(t, "my_task", data.Task.Name)
+	assert.Equal(t, uint32(2), data.RetryAttempt)
+	assert.Equal(t, "running", data.Phase)
+	assert.Nil(t, data.Event)
+	assert.Nil(t, data.Workflow)
+	assert.Empty(t, data.ConsoleURL)
+}
@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// WebhookNotificationType is the notification type webhook payloads are published with.
+const WebhookNotificationType = "webhook"
+
+// WebhookPayload is the JSON document posted to webhooks for a workflow execution phase change.
+type WebhookPayload struct {
+	// Either node or task for payloads posted by notification triggers, in which case Event is the node or task
+	// execution event. Unset for workflow execution events.
+	Type      string          `json:"type,omitempty"`
+	Execution json.RawMessage `json:"execution"`
+	Event     json.RawMessage `json:"event"`
+}
+
+func isWebhookPhase(webhook runtimeInterfaces.WebhookConfig, phase core.WorkflowExecution_Phase) bool {
+	if len(webhook.Phases) == 0 {
+		return common.IsExecutionTerminal(phase)
+	}
+	for _, webhookPhase := range webhook.Phases {
+		if strings.EqualFold(webhookPhase, phase.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWebhooksForPhase returns the names of the webhooks a workflow execution phase change should be posted to.
+func GetWebhooksForPhase(config runtimeInterfaces.NotificationsConfig, phase core.WorkflowExecution_Phase) []string {
+	var webhooks []string
+	for _, webhook := range config.NotificationsWebhookConfig.Webhooks {
+		if isWebhookPhase(webhook, phase) {
+			webhooks = append(webhooks, webhook.Name)
+		}
+	}
+	return webhooks
+}
+
+func marshalJSON(marshaler *jsonpb.Marshaler, msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func getWebhookBody(payloadType string, execution *admin.Execution, event proto.Message) (string, error) {
+	marshaler := jsonpb.Marshaler{}
+	payload := WebhookPayload{
+		Type: payloadType,
+	}
+	var err error
+	if payload.Execution, err = marshalJSON(&marshaler, execution); err != nil {
+		return "", fmt.Errorf("failed to marshal execution [%+v] with err: %w", execution.Id, err)
+	}
+	if payload.Event, err = marshalJSON(&marshaler, event); err != nil {
+		return "", fmt.Errorf("failed to marshal event for execution [%+v] with err: %w", execution.Id, err)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// Converts a workflow execution event and the execution it updated into the message posted to a webhook, whose body
+// is the JSON rendering of both.
+func ToWebhookMessageFromWorkflowExecutionEvent(
+	webhook string,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) (*admin.EmailMessage, error) {
+
+	body, err := getWebhookBody("", execution, request.Event)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.EmailMessage{
+		SubjectLine: fmt.Sprintf("%s/%s/%s %s", execution.Id.Project, execution.Id.Domain, execution.Id.Name,
+			strings.ToLower(request.Event.Phase.String())),
+		RecipientsEmail: []string{webhook},
+		Body:            body,
+	}, nil
+}
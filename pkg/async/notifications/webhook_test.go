@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"encoding/json"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebhooksForPhase(t *testing.T) {
+	config := runtimeInterfaces.NotificationsConfig{
+		NotificationsWebhookConfig: runtimeInterfaces.NotificationsWebhookConfig{
+			Webhooks: []runtimeInterfaces.WebhookConfig{
+				{Name: "terminal"},
+				{Name: "running", Phases: []string{"running", "FAILED"}},
+			},
+		},
+	}
+	assert.Equal(t, []string{"running"}, GetWebhooksForPhase(config, core.WorkflowExecution_RUNNING))
+	assert.Equal(t, []string{"terminal", "running"}, GetWebhooksForPhase(config, core.WorkflowExecution_FAILED))
+	assert.Equal(t, []string{"terminal"}, GetWebhooksForPhase(config, core.WorkflowExecution_SUCCEEDED))
+	assert.Empty(t, GetWebhooksForPhase(config, core.WorkflowExecution_QUEUED))
+}
+
+func TestToWebhookMessageFromWorkflowExecutionEvent(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "uh-oh",
+				},
+			},
+		},
+	}
+	message, err := ToWebhookMessageFromWorkflowExecutionEvent("incident-bot", request, workflowExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"incident-bot"}, message.RecipientsEmail)
+	assert.Equal(t, "proj/prod/e124 failed", message.SubjectLine)
+
+	var payload map[string]map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(message.Body), &payload))
+	assert.Equal(t, "e124", payload["execution"]["id"].(map[string]interface{})["name"])
+	assert.Equal(t, "FAILED", payload["event"]["phase"])
+	assert.Equal(t, "uh-oh", payload["event"]["error"].(map[string]interface{})["message"])
+}
@@ -0,0 +1,403 @@
+package implementations
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/retention/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+)
+
+const archiveDateFormat = "2006-01-02"
+
+// archivedNodeExecution is a node execution as served by admin, along with what's recorded for it besides.
+type archivedNodeExecution struct {
+	NodeExecution json.RawMessage `json:"node_execution"`
+	// Unset for node executions at level 0.
+	ParentNodeID                          string `json:"parent_node_id,omitempty"`
+	DynamicWorkflowRemoteClosureReference string `json:"dynamic_workflow_remote_closure_reference,omitempty"`
+}
+
+// archivedEvent is an execution event, or a node execution event when the node id is set.
+type archivedEvent struct {
+	NodeID     string    `json:"node_id,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Phase      string    `json:"phase"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// archivedExecution is a line of an archive file.
+type archivedExecution struct {
+	Execution      json.RawMessage         `json:"execution"`
+	InputsURI      string                  `json:"inputs_uri,omitempty"`
+	UserInputsURI  string                  `json:"user_inputs_uri,omitempty"`
+	NodeExecutions []archivedNodeExecution `json:"node_executions,omitempty"`
+	TaskExecutions []json.RawMessage       `json:"task_executions,omitempty"`
+	Events         []archivedEvent         `json:"events,omitempty"`
+}
+
+// Executions of a project and domain created on the same date are archived to the same file.
+type archivePartition struct {
+	project string
+	domain  string
+	date    string
+}
+
+type archiverMetrics struct {
+	Scope              promutils.Scope
+	ExecutionsArchived prometheus.Counter
+	ExecutionsRestored prometheus.Counter
+}
+
+type archiver struct {
+	repo          repositoryInterfaces.Repository
+	storageClient *storage.DataStore
+	storagePrefix []string
+	metrics       archiverMetrics
+}
+
+func marshalJSON(marshaler *jsonpb.Marshaler, msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func getArchivePartition(execution models.Execution) archivePartition {
+	createdAt := execution.CreatedAt
+	if execution.ExecutionCreatedAt != nil {
+		createdAt = *execution.ExecutionCreatedAt
+	}
+	return archivePartition{
+		project: execution.Project,
+		domain:  execution.Domain,
+		date:    createdAt.UTC().Format(archiveDateFormat),
+	}
+}
+
+// Returns the archived executions of the records, in the order of the executions.
+func getArchivedExecutions(ctx context.Context, records repositoryInterfaces.ExecutionRecords) (
+	[]archivedExecution, error) {
+	marshaler := jsonpb.Marshaler{}
+	archived := make([]archivedExecution, len(records.Executions))
+	indexes := make(map[models.ExecutionKey]int, len(records.Executions))
+	for idx, executionModel := range records.Executions {
+		execution, err := transformers.FromExecutionModel(ctx, executionModel,
+			&transformers.ExecutionTransformerOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if archived[idx].Execution, err = marshalJSON(&marshaler, execution); err != nil {
+			return nil, err
+		}
+		archived[idx].InputsURI = executionModel.InputsURI.String()
+		archived[idx].UserInputsURI = executionModel.UserInputsURI.String()
+		indexes[executionModel.ExecutionKey] = idx
+	}
+	for _, nodeExecutionModel := range records.NodeExecutions {
+		nodeExecution, err := transformers.FromNodeExecutionModel(nodeExecutionModel, nil)
+		if err != nil {
+			return nil, err
+		}
+		serialized, err := marshalJSON(&marshaler, nodeExecution)
+		if err != nil {
+			return nil, err
+		}
+		idx := indexes[nodeExecutionModel.ExecutionKey]
+		archived[idx].NodeExecutions = append(archived[idx].NodeExecutions, archivedNodeExecution{
+			NodeExecution:                         serialized,
+			ParentNodeID:                          records.NodeExecutionParents[nodeExecutionModel.NodeExecutionKey],
+			DynamicWorkflowRemoteClosureReference: nodeExecutionModel.DynamicWorkflowRemoteClosureReference,
+		})
+	}
+	for _, taskExecutionModel := range records.TaskExecutions {
+		taskExecution, err := transformers.FromTaskExecutionModel(taskExecutionModel, nil)
+		if err != nil {
+			return nil, err
+		}
+		serialized, err := marshalJSON(&marshaler, taskExecution)
+		if err != nil {
+			return nil, err
+		}
+		idx := indexes[taskExecutionModel.ExecutionKey]
+		archived[idx].TaskExecutions = append(archived[idx].TaskExecutions, serialized)
+	}
+	for _, event := range records.ExecutionEvents {
+		idx := indexes[event.ExecutionKey]
+		archived[idx].Events = append(archived[idx].Events, archivedEvent{
+			RequestID:  event.RequestID,
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	for _, event := range records.NodeExecutionEvents {
+		idx := indexes[event.ExecutionKey]
+		archived[idx].Events = append(archived[idx].Events, archivedEvent{
+			NodeID:     event.NodeID,
+			RequestID:  event.RequestID,
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	return archived, nil
+}
+
+func (a *archiver) writeArchive(ctx context.Context, reference storage.DataReference,
+	executions []archivedExecution) error {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(writer)
+	for _, execution := range executions {
+		if err := encoder.Encode(execution); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return a.storageClient.WriteRaw(ctx, reference, int64(buf.Len()), storage.Options{}, &buf)
+}
+
+func (a *archiver) Archive(ctx context.Context, executions []models.ExecutionKey) ([]storage.DataReference, error) {
+	records, err := a.repo.ExecutionRepo().GetRecords(ctx, executions)
+	if err != nil {
+		return nil, err
+	}
+	archived, err := getArchivedExecutions(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	var partitions []archivePartition
+	partitionExecutions := make(map[archivePartition][]int)
+	for idx, execution := range records.Executions {
+		partition := getArchivePartition(execution)
+		if _, ok := partitionExecutions[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		partitionExecutions[partition] = append(partitionExecutions[partition], idx)
+	}
+	references := make([]storage.DataReference, 0, len(partitions))
+	for _, partition := range partitions {
+		indexes := partitionExecutions[partition]
+		// Executions are ordered by id, which names the file so that archiving the same executions again overwrites
+		// it.
+		name := fmt.Sprintf("executions-%d-%d.jsonl.gz", records.Executions[indexes[0]].ID,
+			records.Executions[indexes[len(indexes)-1]].ID)
+		nestedKeys := append(append([]string{}, a.storagePrefix...), partition.project, partition.domain,
+			partition.date, name)
+		reference, err := a.storageClient.ConstructReference(ctx, a.storageClient.GetBaseContainerFQN(ctx),
+			nestedKeys...)
+		if err != nil {
+			return references, err
+		}
+		partitionArchived := make([]archivedExecution, len(indexes))
+		for i, idx := range indexes {
+			partitionArchived[i] = archived[idx]
+		}
+		if err := a.writeArchive(ctx, reference, partitionArchived); err != nil {
+			return references, fmt.Errorf("failed to write archive [%s]: %w", reference, err)
+		}
+		references = append(references, reference)
+	}
+	a.metrics.ExecutionsArchived.Add(float64(len(records.Executions)))
+	return references, nil
+}
+
+// Returns the id of the entity, or 0 when it no longer exists.
+func getEntityID(get func() (uint, error)) (uint, error) {
+	id, err := get()
+	if err != nil {
+		if flyteAdminError, ok := err.(errors.FlyteAdminError); ok && flyteAdminError.Code() == codes.NotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+func toIdentifier(id *core.Identifier) repositoryInterfaces.Identifier {
+	return repositoryInterfaces.Identifier{
+		Project: id.GetProject(),
+		Domain:  id.GetDomain(),
+		Name:    id.GetName(),
+		Version: id.GetVersion(),
+	}
+}
+
+// Sets the ids of the launch plan or task and the workflow of the execution, which are only referred to by their
+// identifiers in archives. They are left unset for those no longer registered.
+func (a *archiver) setEntityIDs(ctx context.Context, execution *admin.Execution, executionModel *models.Execution) error {
+	var err error
+	launchEntity := execution.GetSpec().GetLaunchPlan()
+	if launchEntity != nil && launchEntity.ResourceType == core.ResourceType_TASK {
+		executionModel.TaskID, err = getEntityID(func() (uint, error) {
+			task, err := a.repo.TaskRepo().Get(ctx, toIdentifier(launchEntity))
+			return task.ID, err
+		})
+	} else if launchEntity != nil {
+		executionModel.LaunchPlanID, err = getEntityID(func() (uint, error) {
+			launchPlan, err := a.repo.LaunchPlanRepo().Get(ctx, toIdentifier(launchEntity))
+			return launchPlan.ID, err
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if workflowID := execution.GetClosure().GetWorkflowId(); workflowID != nil {
+		executionModel.WorkflowID, err = getEntityID(func() (uint, error) {
+			workflow, err := a.repo.WorkflowRepo().Get(ctx, toIdentifier(workflowID))
+			return workflow.ID, err
+		})
+	}
+	return err
+}
+
+// Appends the rows of an archived execution to the records.
+func (a *archiver) appendRecords(ctx context.Context, records *repositoryInterfaces.ExecutionRecords,
+	archived archivedExecution, execution *admin.Execution) error {
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	executionModel, err := transformers.FromExecutionProto(execution)
+	if err != nil {
+		return err
+	}
+	executionModel.InputsURI = storage.DataReference(archived.InputsURI)
+	executionModel.UserInputsURI = storage.DataReference(archived.UserInputsURI)
+	if err := a.setEntityIDs(ctx, execution, executionModel); err != nil {
+		return err
+	}
+	records.Executions = append(records.Executions, *executionModel)
+
+	for _, archivedNodeExecution := range archived.NodeExecutions {
+		var nodeExecution admin.NodeExecution
+		if err := unmarshaler.Unmarshal(bytes.NewReader(archivedNodeExecution.NodeExecution),
+			&nodeExecution); err != nil {
+			return err
+		}
+		nodeExecutionModel, err := transformers.FromNodeExecutionProto(&nodeExecution)
+		if err != nil {
+			return err
+		}
+		nodeExecutionModel.DynamicWorkflowRemoteClosureReference =
+			archivedNodeExecution.DynamicWorkflowRemoteClosureReference
+		records.NodeExecutions = append(records.NodeExecutions, *nodeExecutionModel)
+		if len(archivedNodeExecution.ParentNodeID) > 0 {
+			records.NodeExecutionParents[nodeExecutionModel.NodeExecutionKey] = archivedNodeExecution.ParentNodeID
+		}
+	}
+	for _, archivedTaskExecution := range archived.TaskExecutions {
+		var taskExecution admin.TaskExecution
+		if err := unmarshaler.Unmarshal(bytes.NewReader(archivedTaskExecution), &taskExecution); err != nil {
+			return err
+		}
+		taskExecutionModel, err := transformers.FromTaskExecutionProto(&taskExecution)
+		if err != nil {
+			return err
+		}
+		records.TaskExecutions = append(records.TaskExecutions, *taskExecutionModel)
+	}
+	for _, event := range archived.Events {
+		if len(event.NodeID) == 0 {
+			records.ExecutionEvents = append(records.ExecutionEvents, models.ExecutionEvent{
+				ExecutionKey: executionModel.ExecutionKey,
+				RequestID:    event.RequestID,
+				OccurredAt:   event.OccurredAt,
+				Phase:        event.Phase,
+			})
+			continue
+		}
+		records.NodeExecutionEvents = append(records.NodeExecutionEvents, models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: executionModel.ExecutionKey, NodeID: event.NodeID},
+			RequestID:        event.RequestID,
+			OccurredAt:       event.OccurredAt,
+			Phase:            event.Phase,
+		})
+	}
+	return nil
+}
+
+func (a *archiver) Restore(ctx context.Context, reference storage.DataReference, names []string) (int, error) {
+	restoreNames := make(map[string]bool, len(names))
+	for _, name := range names {
+		restoreNames[name] = true
+	}
+	reader, err := a.storageClient.ReadRaw(ctx, reference)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid archive [%s]: %v", reference, err)
+	}
+	defer gzipReader.Close()
+
+	records := repositoryInterfaces.ExecutionRecords{
+		NodeExecutionParents: make(map[models.NodeExecutionKey]string),
+	}
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	decoder := json.NewDecoder(gzipReader)
+	for {
+		var archived archivedExecution
+		if err := decoder.Decode(&archived); err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid archive [%s]: %v", reference, err)
+		}
+		var execution admin.Execution
+		if err := unmarshaler.Unmarshal(bytes.NewReader(archived.Execution), &execution); err != nil {
+			return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid archive [%s]: %v", reference, err)
+		}
+		if len(restoreNames) > 0 && !restoreNames[execution.GetId().GetName()] {
+			continue
+		}
+		if err := a.appendRecords(ctx, &records, archived, &execution); err != nil {
+			return 0, err
+		}
+	}
+	if len(restoreNames) > len(records.Executions) {
+		return 0, errors.NewFlyteAdminErrorf(codes.NotFound, "only %d of the %d executions to restore are in archive [%s]",
+			len(records.Executions), len(restoreNames), reference)
+	}
+	if err := a.repo.ExecutionRepo().CreateRecords(ctx, records); err != nil {
+		return 0, err
+	}
+	a.metrics.ExecutionsRestored.Add(float64(len(records.Executions)))
+	logger.Infof(ctx, "Restored %d executions from archive [%s]", len(records.Executions), reference)
+	return len(records.Executions), nil
+}
+
+// NewArchiver returns an archiver writing archive files under the storage prefix of the data store.
+func NewArchiver(repo repositoryInterfaces.Repository, storageClient *storage.DataStore, storagePrefix []string,
+	scope promutils.Scope) interfaces.Archiver {
+	return &archiver{
+		repo:          repo,
+		storageClient: storageClient,
+		storagePrefix: storagePrefix,
+		metrics: archiverMetrics{
+			Scope:              scope,
+			ExecutionsArchived: scope.MustNewCounter("executions_archived", "number of executions archived"),
+			ExecutionsRestored: scope.MustNewCounter("executions_restored",
+				"number of executions restored from archives"),
+		},
+	}
+}
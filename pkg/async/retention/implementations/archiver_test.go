@@ -0,0 +1,226 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+func getTestExecutionRecords(t *testing.T) repositoryInterfaces.ExecutionRecords {
+	createdAt := time.Date(2022, time.March, 1, 23, 0, 0, 0, time.UTC)
+	nextDay := createdAt.Add(2 * time.Hour)
+	records := repositoryInterfaces.ExecutionRecords{
+		NodeExecutionParents: make(map[models.NodeExecutionKey]string),
+	}
+	for idx, name := range []string{"a", "b", "c"} {
+		spec, err := proto.Marshal(&admin.ExecutionSpec{
+			LaunchPlan: &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "lp", Version: "v1"},
+			Metadata:   &admin.ExecutionMetadata{Principal: "principal"},
+		})
+		require.NoError(t, err)
+		closure, err := proto.Marshal(&admin.ExecutionClosure{
+			Phase:      core.WorkflowExecution_SUCCEEDED,
+			WorkflowId: &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "wf", Version: "v1"},
+			StateChangeDetails: &admin.ExecutionStateChangeDetails{
+				State: admin.ExecutionState_EXECUTION_ACTIVE,
+			},
+		})
+		require.NoError(t, err)
+		executionCreatedAt := createdAt
+		if name == "c" {
+			executionCreatedAt = nextDay
+		}
+		key := models.ExecutionKey{Project: "project", Domain: "domain", Name: name}
+		records.Executions = append(records.Executions, models.Execution{
+			BaseModel:          models.BaseModel{ID: uint(idx + 1)},
+			ExecutionKey:       key,
+			Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+			Spec:               spec,
+			Closure:            closure,
+			ExecutionCreatedAt: &executionCreatedAt,
+			InputsURI:          storage.DataReference("s3://bucket/metadata/project/domain/" + name + "/inputs"),
+		})
+		records.ExecutionEvents = append(records.ExecutionEvents, models.ExecutionEvent{
+			ExecutionKey: key,
+			RequestID:    "request",
+			OccurredAt:   createdAt,
+			Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+		})
+	}
+
+	key := records.Executions[0].ExecutionKey
+	for _, nodeID := range []string{"n0", "n0-0-dn0"} {
+		closure, err := proto.Marshal(&admin.NodeExecutionClosure{Phase: core.NodeExecution_SUCCEEDED})
+		require.NoError(t, err)
+		metadata, err := proto.Marshal(&admin.NodeExecutionMetaData{SpecNodeId: nodeID})
+		require.NoError(t, err)
+		records.NodeExecutions = append(records.NodeExecutions, models.NodeExecution{
+			NodeExecutionKey:      models.NodeExecutionKey{ExecutionKey: key, NodeID: nodeID},
+			Phase:                 core.NodeExecution_SUCCEEDED.String(),
+			Closure:               closure,
+			NodeExecutionMetadata: metadata,
+		})
+		records.NodeExecutionEvents = append(records.NodeExecutionEvents, models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: key, NodeID: nodeID},
+			OccurredAt:       createdAt,
+			Phase:            core.NodeExecution_SUCCEEDED.String(),
+		})
+	}
+	records.NodeExecutions[0].DynamicWorkflowRemoteClosureReference = "s3://bucket/closure"
+	records.NodeExecutionParents[records.NodeExecutions[1].NodeExecutionKey] = "n0"
+
+	closure, err := proto.Marshal(&admin.TaskExecutionClosure{Phase: core.TaskExecution_SUCCEEDED})
+	require.NoError(t, err)
+	retryAttempt := uint32(1)
+	records.TaskExecutions = append(records.TaskExecutions, models.TaskExecution{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey:          models.TaskKey{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+			NodeExecutionKey: records.NodeExecutions[1].NodeExecutionKey,
+			RetryAttempt:     &retryAttempt,
+		},
+		Phase:   core.TaskExecution_SUCCEEDED.String(),
+		Closure: closure,
+	})
+	return records
+}
+
+func TestArchiveAndRestore(t *testing.T) {
+	dataStore := getTestDataStore(t)
+	records := getTestExecutionRecords(t)
+	repo := repositoryMocks.NewMockRepository()
+	executionRepo := repo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	executionRepo.SetGetRecordsCallback(func(ctx context.Context, executions []models.ExecutionKey) (
+		repositoryInterfaces.ExecutionRecords, error) {
+		assert.Len(t, executions, 3)
+		return records, nil
+	})
+	repo.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input repositoryInterfaces.Identifier) (models.LaunchPlan, error) {
+			assert.Equal(t, "lp", input.Name)
+			return models.LaunchPlan{BaseModel: models.BaseModel{ID: 5}}, nil
+		})
+	repo.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input repositoryInterfaces.Identifier) (models.Workflow, error) {
+			return models.Workflow{}, errors.GetMissingEntityError("workflow", &core.Identifier{Name: input.Name})
+		})
+	archiver := NewArchiver(repo, dataStore, []string{"archive"}, promutils.NewTestScope())
+
+	keys := make([]models.ExecutionKey, len(records.Executions))
+	for idx, execution := range records.Executions {
+		keys[idx] = execution.ExecutionKey
+	}
+	references, err := archiver.Archive(context.Background(), keys)
+	assert.NoError(t, err)
+	// Executions are partitioned by the date they were created on.
+	assert.Equal(t, []storage.DataReference{
+		"/archive/project/domain/2022-03-01/executions-1-2.jsonl.gz",
+		"/archive/project/domain/2022-03-02/executions-3-3.jsonl.gz",
+	}, references)
+
+	var restored repositoryInterfaces.ExecutionRecords
+	executionRepo.SetCreateRecordsCallback(func(ctx context.Context, records repositoryInterfaces.ExecutionRecords) error {
+		restored = records
+		return nil
+	})
+	count, err := archiver.Restore(context.Background(), references[0], []string{"a"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, restored.Executions, 1)
+	execution := restored.Executions[0]
+	assert.Equal(t, records.Executions[0].ExecutionKey, execution.ExecutionKey)
+	assert.Equal(t, records.Executions[0].InputsURI, execution.InputsURI)
+	assert.Equal(t, "SUCCEEDED", execution.Phase)
+	assert.Equal(t, "principal", execution.User)
+	assert.Equal(t, uint(5), execution.LaunchPlanID)
+	// The workflow is no longer registered.
+	assert.Zero(t, execution.WorkflowID)
+	// Rows are created anew.
+	assert.Zero(t, execution.ID)
+
+	require.Len(t, restored.NodeExecutions, 2)
+	assert.Equal(t, "n0", restored.NodeExecutions[0].NodeID)
+	assert.Equal(t, "s3://bucket/closure", restored.NodeExecutions[0].DynamicWorkflowRemoteClosureReference)
+	assert.Equal(t, records.NodeExecutionParents, restored.NodeExecutionParents)
+	require.Len(t, restored.TaskExecutions, 1)
+	assert.Equal(t, records.TaskExecutions[0].TaskExecutionKey, restored.TaskExecutions[0].TaskExecutionKey)
+	assert.Equal(t, records.ExecutionEvents[:1], restored.ExecutionEvents)
+	assert.Equal(t, records.NodeExecutionEvents, restored.NodeExecutionEvents)
+
+	count, err = archiver.Restore(context.Background(), references[0], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, restored.Executions, 2)
+
+	_, err = archiver.Restore(context.Background(), references[1], []string{"a"})
+	assert.EqualError(t, err, "only 0 of the 1 executions to restore are in archive "+
+		"[/archive/project/domain/2022-03-02/executions-3-3.jsonl.gz]")
+}
+
+func TestPurge_Archive(t *testing.T) {
+	dataStore := getTestDataStore(t)
+	records := getTestExecutionRecords(t)
+	repo := repositoryMocks.NewMockRepository()
+	executionRepo := repo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	executionRepo.SetListCallback(func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+		repositoryInterfaces.ExecutionCollectionOutput, error) {
+		return repositoryInterfaces.ExecutionCollectionOutput{Executions: records.Executions}, nil
+	})
+	executionRepo.SetGetRecordsCallback(func(ctx context.Context, executions []models.ExecutionKey) (
+		repositoryInterfaces.ExecutionRecords, error) {
+		return records, nil
+	})
+	deleted := false
+	executionRepo.SetDeleteCallback(func(ctx context.Context, input repositoryInterfaces.ExecutionDeleteInput) (
+		repositoryInterfaces.ExecutionDeleteOutput, error) {
+		deleted = true
+		return repositoryInterfaces.ExecutionDeleteOutput{}, nil
+	})
+	config := runtimeInterfaces.RetentionConfig{
+		BatchSize: 10,
+		Policies: []runtimeInterfaces.RetentionPolicy{
+			{MaxAge: config.Duration{Duration: time.Hour}},
+		},
+		Archive: runtimeInterfaces.ArchiveConfig{
+			Enabled:       true,
+			StoragePrefix: []string{"archive"},
+		},
+	}
+
+	report, err := NewPurger(repo, dataStore, nil, config, promutils.NewTestScope()).Purge(context.Background(), true)
+	assert.NoError(t, err)
+	// Nothing is archived on a dry run.
+	assert.Zero(t, report.Policies[0].ArchivesWritten)
+
+	report, err = NewPurger(repo, dataStore, nil, config, promutils.NewTestScope()).Purge(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Policies[0].ArchivesWritten)
+	assert.True(t, deleted)
+	metadata, err := dataStore.Head(context.Background(), "/archive/project/domain/2022-03-01/executions-1-2.jsonl.gz")
+	assert.NoError(t, err)
+	assert.True(t, metadata.Exists())
+
+	// Executions aren't purged unless they're archived.
+	deleted = false
+	executionRepo.SetGetRecordsCallback(func(ctx context.Context, executions []models.ExecutionKey) (
+		repositoryInterfaces.ExecutionRecords, error) {
+		return repositoryInterfaces.ExecutionRecords{}, errors.GetInvalidInputError("executions")
+	})
+	_, err = NewPurger(repo, dataStore, nil, config, promutils.NewTestScope()).Purge(context.Background(), false)
+	assert.Error(t, err)
+	assert.False(t, deleted)
+}
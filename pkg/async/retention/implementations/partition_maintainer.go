@@ -0,0 +1,117 @@
+package implementations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/retention/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// PartitionMaintainerLockName is the lock held by the admin instance maintaining the partitions, so that the replicas
+// don't create or detach the same ones at once.
+const PartitionMaintainerLockName = "partition_maintainer"
+
+type partitionMaintainerMetrics struct {
+	Scope              promutils.Scope
+	PartitionsCreated  prometheus.Counter
+	PartitionsDetached prometheus.Counter
+	MaintenanceErrors  prometheus.Counter
+}
+
+// partitionMaintainer creates the monthly partitions of the partitioned execution tables ahead of time, and detaches
+// those of past months which were emptied by purging executions.
+type partitionMaintainer struct {
+	repo     repositoryInterfaces.Repository
+	config   runtimeInterfaces.DbPartitioningConfig
+	metrics  partitionMaintainerMetrics
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func (m *partitionMaintainer) Maintain(ctx context.Context, dryRun bool) (
+	repositoryInterfaces.PartitionMaintenanceOutput, error) {
+	return m.repo.PartitionRepo().Maintain(ctx, repositoryInterfaces.PartitionMaintenanceInput{
+		FutureMonths: m.config.FutureMonths,
+		DryRun:       dryRun,
+	})
+}
+
+func (m *partitionMaintainer) Run() {
+	defer close(m.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-m.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	ticker := time.NewTicker(m.config.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			var output repositoryInterfaces.PartitionMaintenanceOutput
+			acquired, err := m.repo.LockRepo().RunExclusively(ctx, PartitionMaintainerLockName,
+				func(ctx context.Context) error {
+					var err error
+					output, err = m.Maintain(ctx, false)
+					return err
+				})
+			if !acquired && err == nil {
+				logger.Debugf(ctx, "Skipped maintaining partitions, which another instance is maintaining")
+			}
+			if err != nil && ctx.Err() == nil {
+				m.metrics.MaintenanceErrors.Inc()
+				logger.Warnf(ctx, "Failed to maintain the partitions of the execution tables with err [%+v]", err)
+			}
+			m.metrics.PartitionsCreated.Add(float64(len(output.Created)))
+			m.metrics.PartitionsDetached.Add(float64(len(output.Detached)))
+			if len(output.Created) > 0 || len(output.Detached) > 0 {
+				logger.Infof(ctx, "Created partitions %v and detached partitions %v of the execution tables",
+					output.Created, output.Detached)
+			}
+		}
+	}
+}
+
+func (m *partitionMaintainer) Close(ctx context.Context) error {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewPartitionMaintainer returns a maintainer of the partitions of the partitioned execution tables.
+func NewPartitionMaintainer(repo repositoryInterfaces.Repository, config runtimeInterfaces.DbPartitioningConfig,
+	scope promutils.Scope) interfaces.PartitionMaintainer {
+	return &partitionMaintainer{
+		repo:   repo,
+		config: config,
+		metrics: partitionMaintainerMetrics{
+			Scope:              scope,
+			PartitionsCreated:  scope.MustNewCounter("partitions_created", "number of partitions created"),
+			PartitionsDetached: scope.MustNewCounter("partitions_detached", "number of partitions detached and dropped"),
+			MaintenanceErrors: scope.MustNewCounter("maintenance_errors",
+				"number of failures maintaining the partitions"),
+		},
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
@@ -0,0 +1,53 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+func TestPartitionMaintainer_Maintain(t *testing.T) {
+	repo := repositoryMocks.NewMockRepository()
+	partitionRepo := repo.PartitionRepo().(*repositoryMocks.PartitionRepoInterface)
+	partitionRepo.OnMaintain(context.Background(), repositoryInterfaces.PartitionMaintenanceInput{
+		FutureMonths: 2,
+		DryRun:       true,
+	}).Return(repositoryInterfaces.PartitionMaintenanceOutput{
+		Created:  []string{"node_executions_p2023_12"},
+		Detached: []string{"node_executions_p2023_01"},
+	}, nil)
+	maintainer := NewPartitionMaintainer(repo, runtimeInterfaces.DbPartitioningConfig{
+		Enabled:      true,
+		FutureMonths: 2,
+	}, promutils.NewTestScope())
+
+	output, err := maintainer.Maintain(context.Background(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node_executions_p2023_12"}, output.Created)
+	assert.Equal(t, []string{"node_executions_p2023_01"}, output.Detached)
+	partitionRepo.AssertExpectations(t)
+}
+
+func TestPartitionMaintainer_Run(t *testing.T) {
+	repo := repositoryMocks.NewMockRepository()
+	lockRepo := repo.LockRepo().(*repositoryMocks.LockRepoInterface)
+	lockRepo.OnRunExclusivelyMatch(mock.Anything, PartitionMaintainerLockName, mock.Anything).Return(false, nil)
+	maintainer := NewPartitionMaintainer(repo, runtimeInterfaces.DbPartitioningConfig{
+		Enabled:  true,
+		Interval: config.Duration{Duration: time.Millisecond},
+	}, promutils.NewTestScope())
+	go maintainer.Run()
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(t, maintainer.Close(context.Background()))
+	assert.NoError(t, maintainer.Close(context.Background()))
+	lockRepo.AssertCalled(t, "RunExclusively", mock.Anything, PartitionMaintainerLockName, mock.Anything)
+}
@@ -0,0 +1,421 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/retention/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Execution columns the retention policies filter on.
+const (
+	projectField   = "project"
+	domainField    = "domain"
+	phaseField     = "phase"
+	updatedAtField = "updated_at"
+)
+
+// Data offloaded by admin is written under this prefix, as well as under the configured metadata storage prefix.
+const offloadedDataPrefix = "metadata"
+
+// PurgerLockName is the lock held by the admin instance purging executions, so that the replicas don't purge the same
+// ones at once.
+const PurgerLockName = "purger"
+
+type purgerMetrics struct {
+	Scope             promutils.Scope
+	ExecutionsPurged  prometheus.Counter
+	ObjectsDeleted    prometheus.Counter
+	ObjectDeleteFails prometheus.Counter
+	PurgeErrors       prometheus.Counter
+}
+
+// purger deletes terminal executions, along with their node executions, task executions and events, once they're past
+// the retention period of the most specific policy matching their project and domain. Executions are deleted in
+// batches, each in its own transaction.
+type purger struct {
+	repo                  repositoryInterfaces.Repository
+	storageClient         *storage.DataStore
+	metadataStoragePrefix []string
+	config                runtimeInterfaces.RetentionConfig
+	archiver              interfaces.Archiver
+	metrics               purgerMetrics
+	stop                  chan struct{}
+	stopOnce              sync.Once
+	done                  chan struct{}
+}
+
+// Policies for a project and domain are the most specific, followed by those for a project and then a domain.
+func getPolicySpecificity(policy runtimeInterfaces.RetentionPolicy) int {
+	specificity := 0
+	if len(policy.Project) > 0 {
+		specificity += 2
+	}
+	if len(policy.Domain) > 0 {
+		specificity++
+	}
+	return specificity
+}
+
+// Returns whether some executions match both policies.
+func policiesOverlap(policy, other runtimeInterfaces.RetentionPolicy) bool {
+	return (len(policy.Project) == 0 || len(other.Project) == 0 || policy.Project == other.Project) &&
+		(len(policy.Domain) == 0 || len(other.Domain) == 0 || policy.Domain == other.Domain)
+}
+
+func getScopeFilters(policy runtimeInterfaces.RetentionPolicy) ([]common.InlineFilter, error) {
+	var filters []common.InlineFilter
+	if len(policy.Project) > 0 {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, projectField, policy.Project)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(policy.Domain) > 0 {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, domainField, policy.Domain)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func getPolicyPhases(policy runtimeInterfaces.RetentionPolicy) ([]string, error) {
+	if len(policy.Phases) == 0 {
+		var phases []string
+		for value, name := range core.WorkflowExecution_Phase_name {
+			if common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+				phases = append(phases, name)
+			}
+		}
+		sort.Strings(phases)
+		return phases, nil
+	}
+	phases := make([]string, len(policy.Phases))
+	for idx, phase := range policy.Phases {
+		value, ok := core.WorkflowExecution_Phase_value[strings.ToUpper(phase)]
+		if !ok || !common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+			return nil, fmt.Errorf("invalid retention policy phase [%s], phases must be terminal", phase)
+		}
+		phases[idx] = core.WorkflowExecution_Phase(value).String()
+	}
+	return phases, nil
+}
+
+// Returns the filters matching the executions to purge under the policy, which excludes those matched by more specific
+// policies.
+func getPolicyFilters(policy runtimeInterfaces.RetentionPolicy, policies []runtimeInterfaces.RetentionPolicy,
+	updatedBefore time.Time) ([]common.InlineFilter, error) {
+	filters, err := getScopeFilters(policy)
+	if err != nil {
+		return nil, err
+	}
+	phases, err := getPolicyPhases(policy)
+	if err != nil {
+		return nil, err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, phaseField, phases)
+	if err != nil {
+		return nil, err
+	}
+	updatedAtFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThan, updatedAtField,
+		updatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, phaseFilter, updatedAtFilter)
+
+	for _, other := range policies {
+		if getPolicySpecificity(other) <= getPolicySpecificity(policy) || !policiesOverlap(policy, other) {
+			continue
+		}
+		otherFilters, err := getScopeFilters(other)
+		if err != nil {
+			return nil, err
+		}
+		exclusion, err := common.NewNotFilterGroup(otherFilters...)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, exclusion)
+	}
+	return filters, nil
+}
+
+func validatePolicies(policies []runtimeInterfaces.RetentionPolicy) error {
+	scopes := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		scope := fmt.Sprintf("%s/%s", policy.Project, policy.Domain)
+		if scopes[scope] {
+			return fmt.Errorf("multiple retention policies for project [%s] and domain [%s]", policy.Project,
+				policy.Domain)
+		}
+		scopes[scope] = true
+	}
+	return nil
+}
+
+// Returns the prefixes admin offloads the data of the execution under.
+func (p *purger) getOffloadedDataPrefixes(ctx context.Context, key models.ExecutionKey) ([]string, error) {
+	baseContainer := p.storageClient.GetBaseContainerFQN(ctx)
+	nestedKeys := [][]string{
+		{offloadedDataPrefix, key.Project, key.Domain, key.Name},
+		append(append([]string{}, p.metadataStoragePrefix...), key.Project, key.Domain, key.Name),
+	}
+	prefixes := make([]string, len(nestedKeys))
+	for idx, keys := range nestedKeys {
+		prefix, err := p.storageClient.ConstructReference(ctx, baseContainer, keys...)
+		if err != nil {
+			return nil, err
+		}
+		prefixes[idx] = prefix.String() + "/"
+	}
+	return prefixes, nil
+}
+
+// Returns the data offloaded by admin for the executions, among the data references recorded for them. Other data,
+// such as that written by executions themselves, isn't admin's to delete.
+func (p *purger) getOffloadedData(ctx context.Context, executions []models.Execution,
+	references []storage.DataReference) ([]storage.DataReference, error) {
+	var prefixes []string
+	for _, execution := range executions {
+		executionPrefixes, err := p.getOffloadedDataPrefixes(ctx, execution.ExecutionKey)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, executionPrefixes...)
+		// Outputs are offloaded when they're sent inline in execution events.
+		var closure admin.ExecutionClosure
+		if err := proto.Unmarshal(execution.Closure, &closure); err == nil && len(closure.GetOutputs().GetUri()) > 0 {
+			references = append(references, storage.DataReference(closure.GetOutputs().GetUri()))
+		}
+	}
+	var offloaded []storage.DataReference
+	for _, reference := range references {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(reference.String(), prefix) {
+				offloaded = append(offloaded, reference)
+				break
+			}
+		}
+	}
+	return offloaded, nil
+}
+
+// Deletes the offloaded data, failures are logged rather than returned since the executions were already deleted.
+// Returns the number of objects deleted.
+func (p *purger) deleteOffloadedData(ctx context.Context, references []storage.DataReference) int {
+	deleted := 0
+	for _, reference := range references {
+		if err := p.storageClient.Delete(ctx, reference); err != nil {
+			p.metrics.ObjectDeleteFails.Inc()
+			logger.Warnf(ctx, "Failed to delete offloaded data [%s] of a purged execution with err [%+v]",
+				reference, err)
+			continue
+		}
+		deleted++
+	}
+	p.metrics.ObjectsDeleted.Add(float64(deleted))
+	return deleted
+}
+
+func (p *purger) purgePolicy(ctx context.Context, policy runtimeInterfaces.RetentionPolicy, dryRun bool) (
+	interfaces.PolicyReport, error) {
+	report := interfaces.PolicyReport{
+		Policy:      policy,
+		RowsDeleted: make(map[string]int64),
+	}
+	filters, err := getPolicyFilters(policy, p.config.Policies, time.Now().Add(-policy.MaxAge.Duration))
+	if err != nil {
+		return report, err
+	}
+	sortParameter, err := common.NewSortParameter(&admin.Sort{Key: "id", Direction: admin.Sort_ASCENDING},
+		models.ExecutionColumns)
+	if err != nil {
+		return report, err
+	}
+	offset := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		output, err := p.repo.ExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			Limit:         p.config.BatchSize,
+			Offset:        offset,
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return report, err
+		}
+		if len(output.Executions) == 0 {
+			return report, nil
+		}
+		keys := make([]models.ExecutionKey, len(output.Executions))
+		for idx, execution := range output.Executions {
+			keys[idx] = execution.ExecutionKey
+		}
+		if p.archiver != nil && !dryRun {
+			// Executions which fail to be archived aren't purged.
+			archives, err := p.archiver.Archive(ctx, keys)
+			report.ArchivesWritten += len(archives)
+			if err != nil {
+				return report, fmt.Errorf("failed to archive executions: %w", err)
+			}
+		}
+		deleteOutput, err := p.repo.ExecutionRepo().Delete(ctx, repositoryInterfaces.ExecutionDeleteInput{
+			Executions: keys,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return report, err
+		}
+		report.Executions += len(keys)
+		for table, rows := range deleteOutput.RowsAffected {
+			report.RowsDeleted[table] += rows
+		}
+
+		if p.config.DeleteOffloadedData {
+			offloaded, err := p.getOffloadedData(ctx, output.Executions, deleteOutput.DataReferences)
+			if err != nil {
+				return report, err
+			}
+			if dryRun {
+				report.ObjectsDeleted += len(offloaded)
+			} else {
+				report.ObjectsDeleted += p.deleteOffloadedData(ctx, offloaded)
+			}
+		}
+
+		if dryRun {
+			// Nothing was deleted, so the next batch follows this one.
+			offset += len(keys)
+		} else {
+			p.metrics.ExecutionsPurged.Add(float64(len(keys)))
+		}
+		if len(keys) < p.config.BatchSize {
+			return report, nil
+		}
+	}
+}
+
+func (p *purger) Purge(ctx context.Context, dryRun bool) (interfaces.PurgeReport, error) {
+	report := interfaces.PurgeReport{
+		DryRun: dryRun,
+	}
+	if err := validatePolicies(p.config.Policies); err != nil {
+		return report, err
+	}
+	for _, policy := range p.config.Policies {
+		// Executions of the policy are kept forever.
+		if policy.MaxAge.Duration <= 0 {
+			continue
+		}
+		policyReport, err := p.purgePolicy(ctx, policy, dryRun)
+		report.Policies = append(report.Policies, policyReport)
+		if err != nil {
+			return report, fmt.Errorf("failed to purge executions of project [%s] and domain [%s]: %w",
+				policy.Project, policy.Domain, err)
+		}
+	}
+	return report, nil
+}
+
+func (p *purger) Run() {
+	defer close(p.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-p.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	ticker := time.NewTicker(p.config.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			var report interfaces.PurgeReport
+			acquired, err := p.repo.LockRepo().RunExclusively(ctx, PurgerLockName, func(ctx context.Context) error {
+				var err error
+				report, err = p.Purge(ctx, false)
+				return err
+			})
+			if !acquired && err == nil {
+				logger.Debugf(ctx, "Skipped purging executions, which another instance is purging")
+			}
+			if err != nil && ctx.Err() == nil {
+				p.metrics.PurgeErrors.Inc()
+				logger.Warnf(ctx, "Failed to purge executions with err [%+v]", err)
+			}
+			for _, policyReport := range report.Policies {
+				if policyReport.Executions > 0 {
+					logger.Infof(ctx, "Purged %d executions of project [%s] and domain [%s]",
+						policyReport.Executions, policyReport.Policy.Project, policyReport.Policy.Domain)
+				}
+			}
+		}
+	}
+}
+
+func (p *purger) Close(ctx context.Context) error {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewPurger returns a purger of the executions past their retention period, which also archives them and deletes the
+// data admin offloaded for them when configured to.
+func NewPurger(repo repositoryInterfaces.Repository, storageClient *storage.DataStore,
+	metadataStoragePrefix []string, config runtimeInterfaces.RetentionConfig, scope promutils.Scope) interfaces.Purger {
+	var archiver interfaces.Archiver
+	if config.Archive.Enabled {
+		archiver = NewArchiver(repo, storageClient, config.Archive.StoragePrefix, scope.NewSubScope("archiver"))
+	}
+	return &purger{
+		repo:                  repo,
+		storageClient:         storageClient,
+		metadataStoragePrefix: metadataStoragePrefix,
+		config:                config,
+		archiver:              archiver,
+		metrics: purgerMetrics{
+			Scope:            scope,
+			ExecutionsPurged: scope.MustNewCounter("executions_purged", "number of executions purged"),
+			ObjectsDeleted: scope.MustNewCounter("objects_deleted",
+				"number of offloaded data objects of purged executions deleted"),
+			ObjectDeleteFails: scope.MustNewCounter("object_delete_failures",
+				"number of failures deleting offloaded data objects of purged executions"),
+			PurgeErrors: scope.MustNewCounter("purge_errors", "number of failures purging executions"),
+		},
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
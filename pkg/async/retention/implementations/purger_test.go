@@ -0,0 +1,222 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+func getFilterQueries(t *testing.T, filters []common.InlineFilter) []string {
+	queries := make([]string, len(filters))
+	for idx, filter := range filters {
+		expr, err := filter.GetGormQueryExpr()
+		require.NoError(t, err)
+		queries[idx] = expr.Query
+	}
+	return queries
+}
+
+func TestGetPolicyFilters(t *testing.T) {
+	policies := []runtimeInterfaces.RetentionPolicy{
+		{MaxAge: config.Duration{Duration: time.Hour}},
+		{Domain: "development", MaxAge: config.Duration{Duration: time.Hour}},
+		{Project: "flytesnacks", MaxAge: config.Duration{Duration: time.Hour}, Phases: []string{"failed"}},
+		{Project: "flytesnacks", Domain: "production"},
+		{Project: "other", Domain: "production"},
+	}
+	updatedBefore := time.Now()
+
+	filters, err := getPolicyFilters(policies[0], policies, updatedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"phase in (?)", "updated_at < ?", "NOT (execution_domain = ?)",
+		"NOT (execution_project = ?)", "NOT (execution_project = ? AND execution_domain = ?)",
+		"NOT (execution_project = ? AND execution_domain = ?)"}, getFilterQueries(t, filters))
+	phaseExpr, err := filters[0].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ABORTED", "FAILED", "SUCCEEDED", "TIMED_OUT"}, phaseExpr.Args)
+
+	// Policies of other domains don't apply to the executions of the policy.
+	filters, err = getPolicyFilters(policies[1], policies, updatedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"execution_domain = ?", "phase in (?)", "updated_at < ?",
+		"NOT (execution_project = ?)"}, getFilterQueries(t, filters))
+
+	filters, err = getPolicyFilters(policies[2], policies, updatedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"execution_project = ?", "phase in (?)", "updated_at < ?",
+		"NOT (execution_project = ? AND execution_domain = ?)"}, getFilterQueries(t, filters))
+	phaseExpr, err = filters[1].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"FAILED"}, phaseExpr.Args)
+
+	_, err = getPolicyFilters(runtimeInterfaces.RetentionPolicy{Phases: []string{"RUNNING"}}, nil, updatedBefore)
+	assert.EqualError(t, err, "invalid retention policy phase [RUNNING], phases must be terminal")
+}
+
+func getTestDataStore(t *testing.T) *storage.DataStore {
+	dataStore, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	require.NoError(t, err)
+	return dataStore
+}
+
+func writeTestObject(t *testing.T, dataStore *storage.DataStore, nestedKeys ...string) storage.DataReference {
+	ctx := context.Background()
+	reference, err := dataStore.ConstructReference(ctx, dataStore.GetBaseContainerFQN(ctx), nestedKeys...)
+	require.NoError(t, err)
+	require.NoError(t, dataStore.WriteRaw(ctx, reference, 4, storage.Options{}, bytes.NewReader([]byte("data"))))
+	return reference
+}
+
+func TestPurge(t *testing.T) {
+	dataStore := getTestDataStore(t)
+	inputs := writeTestObject(t, dataStore, "metadata", "project", "domain", "a", "inputs")
+	outputs := writeTestObject(t, dataStore, "metadata", "project", "domain", "a", "offloaded_outputs")
+	closure := writeTestObject(t, dataStore, "metadata", "admin", "project", "domain", "a", "n0", "closure")
+	// Written by the execution rather than offloaded by admin.
+	rawOutputs := writeTestObject(t, dataStore, "raw", "project", "domain", "a", "outputs")
+	executionClosure, err := proto.Marshal(&admin.ExecutionClosure{
+		OutputResult: &admin.ExecutionClosure_Outputs{
+			Outputs: &admin.LiteralMapBlob{Data: &admin.LiteralMapBlob_Uri{Uri: outputs.String()}},
+		},
+	})
+	require.NoError(t, err)
+
+	executions := []models.Execution{
+		{ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "a"}, Closure: executionClosure},
+		{ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "b"}},
+		{ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "c"}},
+	}
+	repo := repositoryMocks.NewMockRepository()
+	executionRepo := repo.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	var listed []int
+	executionRepo.SetListCallback(func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+		repositoryInterfaces.ExecutionCollectionOutput, error) {
+		assert.Equal(t, 2, input.Limit)
+		assert.Equal(t, "id asc", input.SortParameter.GetGormOrderExpr())
+		assert.Len(t, input.InlineFilters, 4)
+		listed = append(listed, input.Offset)
+		if input.Offset >= len(executions) {
+			return repositoryInterfaces.ExecutionCollectionOutput{}, nil
+		}
+		end := input.Offset + input.Limit
+		if end > len(executions) {
+			end = len(executions)
+		}
+		return repositoryInterfaces.ExecutionCollectionOutput{Executions: executions[input.Offset:end]}, nil
+	})
+	var deleted [][]models.ExecutionKey
+	executionRepo.SetDeleteCallback(func(ctx context.Context, input repositoryInterfaces.ExecutionDeleteInput) (
+		repositoryInterfaces.ExecutionDeleteOutput, error) {
+		assert.True(t, input.DryRun)
+		deleted = append(deleted, input.Executions)
+		output := repositoryInterfaces.ExecutionDeleteOutput{
+			RowsAffected: map[string]int64{"executions": int64(len(input.Executions)), "node_executions": 2},
+		}
+		if input.Executions[0].Name == "a" {
+			output.DataReferences = []storage.DataReference{inputs, closure, rawOutputs}
+		}
+		return output, nil
+	})
+
+	purger := NewPurger(repo, dataStore, []string{"metadata", "admin"}, runtimeInterfaces.RetentionConfig{
+		BatchSize:           2,
+		DeleteOffloadedData: true,
+		Policies: []runtimeInterfaces.RetentionPolicy{
+			{Project: "project", MaxAge: config.Duration{Duration: time.Hour}},
+			// Kept forever.
+			{Project: "project", Domain: "production"},
+		},
+	}, promutils.NewTestScope())
+
+	report, err := purger.Purge(context.Background(), true)
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Policies, 1)
+	assert.Equal(t, 3, report.Policies[0].Executions)
+	assert.Equal(t, map[string]int64{"executions": 3, "node_executions": 4}, report.Policies[0].RowsDeleted)
+	assert.Equal(t, 3, report.Policies[0].ObjectsDeleted)
+	// Nothing is deleted on a dry run, so executions are listed page by page.
+	assert.Equal(t, []int{0, 2}, listed)
+	assert.Len(t, deleted, 2)
+	for _, reference := range []storage.DataReference{inputs, outputs, closure, rawOutputs} {
+		_, err := dataStore.Head(context.Background(), reference)
+		assert.NoError(t, err)
+	}
+
+	listed = nil
+	deleted = nil
+	executionRepo.SetDeleteCallback(func(ctx context.Context, input repositoryInterfaces.ExecutionDeleteInput) (
+		repositoryInterfaces.ExecutionDeleteOutput, error) {
+		assert.False(t, input.DryRun)
+		deleted = append(deleted, input.Executions)
+		output := repositoryInterfaces.ExecutionDeleteOutput{
+			RowsAffected: map[string]int64{"executions": int64(len(input.Executions))},
+		}
+		if input.Executions[0].Name == "a" {
+			output.DataReferences = []storage.DataReference{inputs, closure, rawOutputs}
+		}
+		// Deleted executions are no longer listed.
+		executions = executions[len(input.Executions):]
+		return output, nil
+	})
+	report, err = purger.Purge(context.Background(), false)
+	assert.NoError(t, err)
+	require.Len(t, report.Policies, 1)
+	assert.Equal(t, 3, report.Policies[0].Executions)
+	assert.Equal(t, 3, report.Policies[0].ObjectsDeleted)
+	assert.Equal(t, []int{0, 0}, listed)
+	assert.Len(t, deleted, 2)
+	for _, reference := range []storage.DataReference{inputs, outputs, closure} {
+		metadata, err := dataStore.Head(context.Background(), reference)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	}
+	metadata, err := dataStore.Head(context.Background(), rawOutputs)
+	assert.NoError(t, err)
+	assert.True(t, metadata.Exists())
+}
+
+func TestPurge_DuplicatePolicies(t *testing.T) {
+	purger := NewPurger(repositoryMocks.NewMockRepository(), getTestDataStore(t), nil,
+		runtimeInterfaces.RetentionConfig{
+			BatchSize: 2,
+			Policies: []runtimeInterfaces.RetentionPolicy{
+				{Project: "project", MaxAge: config.Duration{Duration: time.Hour}},
+				{Project: "project", MaxAge: config.Duration{Duration: time.Minute}},
+			},
+		}, promutils.NewTestScope())
+	_, err := purger.Purge(context.Background(), true)
+	assert.EqualError(t, err, "multiple retention policies for project [project] and domain []")
+}
+
+func TestPurger_Run(t *testing.T) {
+	repo := repositoryMocks.NewMockRepository()
+	lockRepo := repo.LockRepo().(*repositoryMocks.LockRepoInterface)
+	lockRepo.OnRunExclusivelyMatch(mock.Anything, PurgerLockName, mock.Anything).Return(false, nil)
+	purger := NewPurger(repo, getTestDataStore(t), nil,
+		runtimeInterfaces.RetentionConfig{
+			Interval:  config.Duration{Duration: time.Millisecond},
+			BatchSize: 2,
+		}, promutils.NewTestScope())
+	go purger.Run()
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(t, purger.Close(context.Background()))
+	assert.NoError(t, purger.Close(context.Background()))
+	lockRepo.AssertCalled(t, "RunExclusively", mock.Anything, PurgerLockName, mock.Anything)
+}
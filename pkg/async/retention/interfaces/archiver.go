@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type Archiver interface {
+	// Writes the executions, along with their node executions, task executions and events, to gzipped archive files of
+	// newline-delimited JSON in the data store. Executions are partitioned by project, domain and the date they were
+	// created on, and the references of the files written are returned.
+	Archive(ctx context.Context, executions []models.ExecutionKey) ([]storage.DataReference, error)
+	// Restores the executions of an archive file into the database, all of them unless names are given. Returns the
+	// number of executions restored.
+	Restore(ctx context.Context, reference storage.DataReference, names []string) (int, error)
+}
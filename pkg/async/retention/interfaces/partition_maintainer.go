@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+//go:generate mockery -name=PartitionMaintainer -output=../mocks -case=underscore
+
+type PartitionMaintainer interface {
+	// Creates the partitions of the months to come and detaches those of past months which are no longer needed. When
+	// dryRun is set nothing is changed, and the output describes what would be.
+	Maintain(ctx context.Context, dryRun bool) (repositoryInterfaces.PartitionMaintenanceOutput, error)
+	// Periodically maintains the partitions until Close is called, unless another admin instance is maintaining them.
+	Run()
+	// Stops maintaining the partitions and waits, at most until ctx is done, for the ongoing maintenance. May be
+	// called more than once.
+	Close(ctx context.Context) error
+}
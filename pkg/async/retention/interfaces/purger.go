@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+//go:generate mockery -name=Purger -output=../mocks -case=underscore
+
+// PolicyReport describes what was purged, or what would be purged on a dry run, for a retention policy.
+type PolicyReport struct {
+	Policy runtimeInterfaces.RetentionPolicy
+	// The number of executions purged.
+	Executions int
+	// The number of rows deleted from each table.
+	RowsDeleted map[string]int64
+	// The number of offloaded data objects deleted.
+	ObjectsDeleted int
+	// The number of archive files written, executions aren't archived on a dry run.
+	ArchivesWritten int
+}
+
+type PurgeReport struct {
+	DryRun   bool
+	Policies []PolicyReport
+}
+
+type Purger interface {
+	// Purges the executions past their retention period. When dryRun is set nothing is deleted, and the report
+	// describes what would be.
+	Purge(ctx context.Context, dryRun bool) (PurgeReport, error)
+	// Periodically purges executions until Close is called, unless another admin instance is purging them.
+	Run()
+	// Stops purging and waits, at most until ctx is done, for the batch being purged. May be called more than once.
+	Close(ctx context.Context) error
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule/aws/interfaces"
 	scheduleInterfaces "github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	appInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -81,9 +83,30 @@ func getScheduleDescription(identifier core.Identifier) string {
 		identifier.Project, identifier.Domain, identifier.Name)
 }
 
+// isUTC returns whether the location is at UTC all year round, like Etc/UTC, rather than only outside daylight saving
+// time, like Europe/London.
+func isUTC(location *time.Location) bool {
+	year := time.Now().Year()
+	for _, month := range []time.Month{time.January, time.July} {
+		if _, offset := time.Date(year, month, 1, 0, 0, 0, 0, location).Zone(); offset != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func getScheduleExpression(schedule admin.Schedule) (string, error) {
 	if schedule.GetCronExpression() != "" {
-		return fmt.Sprintf(cronExpression, schedule.GetCronExpression()), nil
+		location, expression, err := common.SplitCronTimeZone(schedule.GetCronExpression())
+		if err != nil {
+			return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid cron expression: %v", err)
+		}
+		// CloudWatch evaluates cron expressions in UTC, regardless of daylight saving time.
+		if location != nil && !isUTC(location) {
+			return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"time zone [%s] is unsupported, cloudwatch schedules are evaluated in UTC", location)
+		}
+		return fmt.Sprintf(cronExpression, expression), nil
 	}
 	if schedule.GetRate() != nil {
 		// AWS uses pluralization for units of values not equal to 1.
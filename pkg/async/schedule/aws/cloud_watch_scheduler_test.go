@@ -89,6 +89,28 @@ func TestGetScheduleExpression(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
 }
 
+func TestGetScheduleExpression_TimeZone(t *testing.T) {
+	for _, cronExpression := range []string{"CRON_TZ=UTC 0 9 * * ? *", "CRON_TZ=Etc/UTC 0 9 * * ? *", "TZ=Zulu 0 9 * * ? *"} {
+		expression, err := getScheduleExpression(admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronExpression{
+				CronExpression: cronExpression,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "cron(0 9 * * ? *)", expression)
+	}
+
+	for _, cronExpression := range []string{"CRON_TZ=Europe/Berlin 0 9 * * ? *", "CRON_TZ=Europe/London 0 9 * * ? *",
+		"CRON_TZ=Europe/Nowhere 0 9 * * ? *"} {
+		_, err := getScheduleExpression(admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronExpression{
+				CronExpression: cronExpression,
+			},
+		})
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
 func TestFormatEventScheduleInputs(t *testing.T) {
 	inputTransformer := formatEventScheduleInputs(&testSerializedPayload)
 	assert.EqualValues(t, map[string]*string{
@@ -16,6 +16,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
 	flytescheduler "github.com/flyteorg/flyteadmin/scheduler/dbapi"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
@@ -85,6 +86,9 @@ func NewWorkflowScheduler(db repoInterfaces.Repository, cfg WorkflowSchedulerCon
 	case common.Local:
 		logger.Infof(context.Background(),
 			"Using default flyte scheduler implementation")
+		if err := schedulerCore.ValidateCatchUpPolicies(cfg.SchedulerConfig.GetCatchUpPolicies()); err != nil {
+			panic(err)
+		}
 		eventScheduler = flytescheduler.New(db)
 	default:
 		logger.Infof(context.Background(),
@@ -18,6 +18,8 @@ type AddScheduleInput struct {
 	Payload *string
 	// Optional: The application-wide prefix to be applied for schedule names.
 	ScheduleNamePrefix string
+	// Optional: Which missed runs of the schedule are launched by the native scheduler.
+	CatchUpPolicy *appInterfaces.ScheduleCatchUpPolicy
 }
 
 type RemoveScheduleInput struct {
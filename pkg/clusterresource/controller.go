@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
@@ -78,6 +79,8 @@ type controllerMetrics struct {
 	TemplateDecodeErrors            prometheus.Counter
 	AppliedTemplateExists           prometheus.Counter
 	TemplateUpdateErrors            prometheus.Counter
+	NamespacesDeleted               prometheus.Counter
+	NamespaceDeleteErrors           prometheus.Counter
 	Panics                          prometheus.Counter
 }
 
@@ -417,6 +420,49 @@ func (c *controller) syncNamespace(ctx context.Context, project *admin.Project,
 	return stats, nil
 }
 
+// deleteArchivedNamespaces deletes the namespaces of archived projects in every target cluster, except those which are
+// also the namespace of an active project. The templates applied to deleted namespaces are forgotten so that they're
+// applied anew should the project be unarchived.
+func (c *controller) deleteArchivedNamespaces(ctx context.Context, activeNamespaces sets.String) error {
+	projects, err := c.adminDataProvider.GetArchivedProjects(ctx)
+	if err != nil {
+		return err
+	}
+	collectedErrs := make([]error, 0)
+	for _, project := range projects.Projects {
+		for _, domain := range project.Domains {
+			namespace := common.GetNamespaceName(c.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), project.Id, domain.Name)
+			if activeNamespaces.Has(namespace) {
+				continue
+			}
+			deleted := true
+			for _, target := range c.listTargets.GetValidTargets() {
+				err := target.Client.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+				if err != nil && !k8serrors.IsNotFound(err) {
+					c.metrics.NamespaceDeleteErrors.Inc()
+					logger.Warningf(ctx, "Failed to delete namespace [%s] of archived project [%s] in cluster [%s] with err: %v",
+						namespace, project.Id, target.ID, err)
+					collectedErrs = append(collectedErrs, err)
+					deleted = false
+					continue
+				}
+				if err == nil {
+					c.metrics.NamespacesDeleted.Inc()
+					logger.Infof(ctx, "Deleted namespace [%s] of archived project [%s] in cluster [%s]",
+						namespace, project.Id, target.ID)
+				}
+			}
+			if deleted {
+				delete(c.appliedTemplates, namespace)
+			}
+		}
+	}
+	if len(collectedErrs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, collectedErrs)
+	}
+	return nil
+}
+
 var metadataAccessor = meta.NewAccessor()
 
 // getLastApplied get last applied manifest from object's annotation
@@ -586,10 +632,12 @@ func (c *controller) Sync(ctx context.Context) error {
 	}
 
 	stats := ResourceSyncStats{}
+	activeNamespaces := sets.NewString()
 
 	for _, project := range projects.Projects {
 		for _, domain := range project.Domains {
 			namespace := common.GetNamespaceName(c.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), project.Id, domain.Name)
+			activeNamespaces.Insert(namespace)
 			customTemplateValues, err := c.getCustomTemplateValues(
 				ctx, project.Id, domain.Id, domainTemplateValues[domain.Id])
 			if err != nil {
@@ -614,6 +662,13 @@ func (c *controller) Sync(ctx context.Context) error {
 
 	logger.Infof(ctx, "Completed cluster resource creation loop with stats: [%+v]", stats)
 
+	if c.config.ClusterResourceConfiguration().ShouldDeleteArchivedNamespaces() {
+		if err := c.deleteArchivedNamespaces(ctx, activeNamespaces); err != nil {
+			logger.Warningf(ctx, "Failed to delete namespaces of archived projects with err: %v", err)
+			errs = append(errs, err)
+		}
+	}
+
 	if len(errs) > 0 {
 		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
 	}
@@ -658,6 +713,10 @@ func newMetrics(scope promutils.Scope) controllerMetrics {
 		TemplateUpdateErrors: scope.MustNewCounter("template_update_errors",
 			"Number of times an attempt at updating an already existing kubernetes resource with a template"+
 				"file failed"),
+		NamespacesDeleted: scope.MustNewCounter("namespaces_deleted",
+			"overall count of namespaces of archived projects deleted in kubernetes"),
+		NamespaceDeleteErrors: scope.MustNewCounter("namespace_delete_errors",
+			"overall count of errors encountered deleting namespaces of archived projects in kubernetes"),
 		Panics: scope.MustNewCounter("panics",
 			"overall count of panics encountered in primary ClusterResourceController loop"),
 	}
@@ -11,15 +11,22 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/clusterresource/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
 	execClusterMocks "github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const proj = "project-foo"
@@ -613,3 +620,84 @@ func Test_controller_createPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteArchivedNamespaces(t *testing.T) {
+	ctx := context.Background()
+	namespaceMapping := &runtimeMocks.NamespaceMappingConfiguration{}
+	namespaceMapping.OnGetNamespaceTemplate().Return("{{ project }}-{{ domain }}")
+	config := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, namespaceMapping)
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "archived-development"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "active-development"}},
+	).Build()
+	listTargets := execClusterMocks.ListTargetsInterface{}
+	listTargets.OnGetValidTargets().Return(map[string]*executioncluster.ExecutionTarget{
+		"cluster": {ID: "cluster", Client: k8sClient},
+	})
+	adminDataProvider := mocks.FlyteAdminDataProvider{}
+	adminDataProvider.OnGetArchivedProjects(ctx).Return(&admin.Projects{
+		Projects: []*admin.Project{
+			{
+				Id: "archived",
+				Domains: []*admin.Domain{
+					{Id: "development", Name: "development"},
+					{Id: "production", Name: "production"},
+				},
+			},
+		},
+	}, nil)
+	testController := controller{
+		config:            config,
+		metrics:           newMetrics(mockScope.NewTestScope()),
+		adminDataProvider: &adminDataProvider,
+		listTargets:       &listTargets,
+		appliedTemplates: NamespaceCache{
+			"archived-development": TemplateChecksums{"namespace.yaml": [16]byte{}},
+		},
+	}
+
+	err := testController.deleteArchivedNamespaces(ctx, sets.NewString())
+	assert.NoError(t, err)
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: "archived-development"}, &corev1.Namespace{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: "active-development"}, &corev1.Namespace{})
+	assert.NoError(t, err)
+	// Templates are applied anew should the project be unarchived.
+	assert.NotContains(t, testController.appliedTemplates, "archived-development")
+}
+
+func TestDeleteArchivedNamespaces_SharedNamespace(t *testing.T) {
+	ctx := context.Background()
+	namespaceMapping := &runtimeMocks.NamespaceMappingConfiguration{}
+	namespaceMapping.OnGetNamespaceTemplate().Return("{{ domain }}")
+	config := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, namespaceMapping)
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "development"}},
+	).Build()
+	listTargets := execClusterMocks.ListTargetsInterface{}
+	listTargets.OnGetValidTargets().Return(map[string]*executioncluster.ExecutionTarget{
+		"cluster": {ID: "cluster", Client: k8sClient},
+	})
+	adminDataProvider := mocks.FlyteAdminDataProvider{}
+	adminDataProvider.OnGetArchivedProjects(ctx).Return(&admin.Projects{
+		Projects: []*admin.Project{
+			{
+				Id:      "archived",
+				Domains: []*admin.Domain{{Id: "development", Name: "development"}},
+			},
+		},
+	}, nil)
+	testController := controller{
+		config:            config,
+		metrics:           newMetrics(mockScope.NewTestScope()),
+		adminDataProvider: &adminDataProvider,
+		listTargets:       &listTargets,
+		appliedTemplates:  make(NamespaceCache),
+	}
+
+	// The namespace is shared with an active project.
+	err := testController.deleteArchivedNamespaces(ctx, sets.NewString("development"))
+	assert.NoError(t, err)
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: "development"}, &corev1.Namespace{})
+	assert.NoError(t, err)
+}
@@ -35,6 +35,8 @@ func (p serviceAdminProvider) GetClusterResourceAttributes(ctx context.Context,
 
 var activeProjectsFilter = fmt.Sprintf("ne(state,%d)", admin.Project_ARCHIVED)
 
+var archivedProjectsFilter = fmt.Sprintf("eq(state,%d)", admin.Project_ARCHIVED)
+
 var descCreatedAtSortParam = admin.Sort{
 	Direction: admin.Sort_DESCENDING,
 	Key:       "created_at",
@@ -43,10 +45,18 @@ var descCreatedAtSortParam = admin.Sort{
 var descCreatedAtSortDBParam, _ = common.NewSortParameter(&descCreatedAtSortParam, models.ProjectColumns)
 
 func (p serviceAdminProvider) GetProjects(ctx context.Context) (*admin.Projects, error) {
+	return p.listProjects(ctx, activeProjectsFilter)
+}
+
+func (p serviceAdminProvider) GetArchivedProjects(ctx context.Context) (*admin.Projects, error) {
+	return p.listProjects(ctx, archivedProjectsFilter)
+}
+
+func (p serviceAdminProvider) listProjects(ctx context.Context, filters string) (*admin.Projects, error) {
 	projects := make([]*admin.Project, 0)
 	listReq := &admin.ProjectListRequest{
 		Limit:   100,
-		Filters: activeProjectsFilter,
+		Filters: filters,
 		// Prefer to sync projects most newly created to ensure their resources get created first when other resources exist.
 		SortBy: &descCreatedAtSortParam,
 	}
@@ -117,3 +117,33 @@ func TestServiceGetProjects(t *testing.T) {
 		assert.EqualError(t, err, errFoo.Error())
 	})
 }
+
+func TestServiceGetArchivedProjects(t *testing.T) {
+	ctx := context.TODO()
+	mockAdmin := mocks.AdminServiceClient{}
+	mockAdmin.OnListProjectsMatch(ctx, mock.MatchedBy(func(req *admin.ProjectListRequest) bool {
+		return req.Filters == "eq(state,1)" && len(req.Token) == 0
+	})).Return(&admin.Projects{
+		Projects: []*admin.Project{
+			{
+				Id: "flytesnacks",
+			},
+		},
+		Token: "next",
+	}, nil)
+	mockAdmin.OnListProjectsMatch(ctx, mock.MatchedBy(func(req *admin.ProjectListRequest) bool {
+		return req.Filters == "eq(state,1)" && req.Token == "next"
+	})).Return(&admin.Projects{
+		Projects: []*admin.Project{
+			{
+				Id: "flyteexamples",
+			},
+		},
+	}, nil)
+	provider := serviceAdminProvider{
+		adminClient: &mockAdmin,
+	}
+	projects, err := provider.GetArchivedProjects(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, projects.Projects, 2)
+}
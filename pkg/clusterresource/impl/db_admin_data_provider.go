@@ -47,7 +47,16 @@ func (p dbAdminProvider) getDomains() []*admin.Domain {
 }
 
 func (p dbAdminProvider) GetProjects(ctx context.Context) (*admin.Projects, error) {
-	filter, err := common.NewSingleValueFilter(common.Project, common.NotEqual, "state", int32(admin.Project_ARCHIVED))
+	return p.listProjects(ctx, common.NotEqual)
+}
+
+func (p dbAdminProvider) GetArchivedProjects(ctx context.Context) (*admin.Projects, error) {
+	return p.listProjects(ctx, common.Equal)
+}
+
+// Lists the projects whose state compares to archived with the given expression.
+func (p dbAdminProvider) listProjects(ctx context.Context, archivedExpression common.FilterExpression) (*admin.Projects, error) {
+	filter, err := common.NewSingleValueFilter(common.Project, archivedExpression, "state", int32(admin.Project_ARCHIVED))
 	if err != nil {
 		return nil, err
 	}
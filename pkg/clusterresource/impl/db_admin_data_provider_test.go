@@ -141,3 +141,39 @@ func TestGetProjects(t *testing.T) {
 		assert.EqualError(t, err, errFoo.Error())
 	})
 }
+
+func TestGetArchivedProjects(t *testing.T) {
+	mockApplicationConfig := configMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetDomainsConfig([]runtimeInterfaces.Domain{
+		{
+			Name: "development",
+		},
+	})
+	mockConfig := configMocks.NewMockConfigurationProvider(&mockApplicationConfig, nil, nil, nil, nil, nil)
+	mockRepo := repoMocks.NewMockRepository()
+	archivedProjectState := int32(admin.Project_ARCHIVED)
+	mockRepo.(*repoMocks.MockRepository).ProjectRepoIface = &repoMocks.MockProjectRepo{
+		ListProjectsFunction: func(ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+			assert.Len(t, input.InlineFilters, 1)
+			query, err := input.InlineFilters[0].GetGormQueryExpr()
+			assert.NoError(t, err)
+			assert.Equal(t, "state = ?", query.Query)
+			assert.Equal(t, int32(admin.Project_ARCHIVED), query.Args)
+			return []models.Project{
+				{
+					Identifier: "flytesnacks",
+					State:      &archivedProjectState,
+				},
+			}, nil
+		},
+	}
+
+	provider := dbAdminProvider{
+		db:     mockRepo,
+		config: mockConfig,
+	}
+	projects, err := provider.GetArchivedProjects(context.TODO())
+	assert.NoError(t, err)
+	assert.Len(t, projects.Projects, 1)
+	assert.Len(t, projects.Projects[0].Domains, 1)
+}
@@ -7,6 +7,8 @@ type CloudProvider = string
 const (
 	AWS     CloudProvider = "aws"
 	GCP     CloudProvider = "gcp"
+	Kafka   CloudProvider = "kafka"
+	NATS    CloudProvider = "nats"
 	Sandbox CloudProvider = "sandbox"
 	Local   CloudProvider = "local"
 	None    CloudProvider = "none"
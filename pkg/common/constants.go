@@ -9,6 +9,11 @@ const (
 	AuditFieldsContextKey contextutils.Key = "audit_fields"
 	PrincipalContextKey   contextutils.Key = "principal"
 	ErrorKindKey          contextutils.Key = "error_kind"
+	// Identifies events published by the outbox relay, see models.OutboxEvent.
+	DeduplicationKey contextutils.Key = "deduplication_key"
+	// Routes the repository reads of a context to the primary database rather than its read replicas, for reads which
+	// must observe preceding writes.
+	PrimaryReadsKey contextutils.Key = "primary_reads"
 )
 
 const MaxResponseStatusBytes = 32000
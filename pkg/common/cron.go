@@ -0,0 +1,41 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Embeds the IANA time zone database, which the images admin runs in may lack.
+	_ "time/tzdata"
+)
+
+// Cron expressions may be prefixed by the IANA time zone they're evaluated in, e.g. "CRON_TZ=Europe/Berlin 0 9 * * *",
+// following daylight saving time. Expressions without one are evaluated in the time zone the scheduler is configured
+// with.
+var cronTimeZonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// SplitCronTimeZone returns the time zone the cron expression is prefixed by, or nil if it isn't, along with the
+// expression without it.
+func SplitCronTimeZone(expression string) (*time.Location, string, error) {
+	expression = strings.TrimSpace(expression)
+	for _, prefix := range cronTimeZonePrefixes {
+		if !strings.HasPrefix(expression, prefix) {
+			continue
+		}
+		fields := strings.SplitN(strings.TrimPrefix(expression, prefix), " ", 2)
+		if len(fields) < 2 || len(strings.TrimSpace(fields[1])) == 0 {
+			return nil, "", fmt.Errorf("missing cron expression after time zone in [%s]", expression)
+		}
+		name := fields[0]
+		// Empty and Local names are valid locations, but not IANA time zones.
+		if len(name) == 0 || name == "Local" {
+			return nil, "", fmt.Errorf("invalid time zone [%s] in cron expression [%s]", name, expression)
+		}
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid time zone [%s] in cron expression [%s]: %v", name, expression, err)
+		}
+		return location, strings.TrimSpace(fields[1]), nil
+	}
+	return nil, expression, nil
+}
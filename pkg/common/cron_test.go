@@ -0,0 +1,36 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitCronTimeZone(t *testing.T) {
+	t.Run("no time zone", func(t *testing.T) {
+		location, expression, err := SplitCronTimeZone("0 9 * * *")
+		assert.NoError(t, err)
+		assert.Nil(t, location)
+		assert.Equal(t, "0 9 * * *", expression)
+	})
+	t.Run("time zone", func(t *testing.T) {
+		for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+			location, expression, err := SplitCronTimeZone(prefix + "Europe/Berlin 0 9 * * *")
+			assert.NoError(t, err)
+			assert.Equal(t, "Europe/Berlin", location.String())
+			assert.Equal(t, "0 9 * * *", expression)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, expression := range []string{
+			"CRON_TZ=Europe/Berlin",
+			"CRON_TZ=Europe/Berlin ",
+			"CRON_TZ= 0 9 * * *",
+			"CRON_TZ=Local 0 9 * * *",
+			"CRON_TZ=Europe/Nowhere 0 9 * * *",
+		} {
+			_, _, err := SplitCronTimeZone(expression)
+			assert.Error(t, err, expression)
+		}
+	})
+}
@@ -8,6 +8,7 @@ type Entity = string
 
 const (
 	Execution           = "e"
+	ExecutionEvent      = "ee"
 	LaunchPlan          = "l"
 	NodeExecution       = "ne"
 	NodeExecutionEvent  = "nee"
@@ -4,6 +4,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -16,6 +17,17 @@ type FilterExpression int
 type GormQueryExpr struct {
 	Query string
 	Args  interface{}
+	// Set in place of Args by queries without exactly one placeholder, e.g. null checks and filter groups, to the
+	// arguments of their placeholders in order.
+	ArgList []interface{}
+}
+
+// Returns the arguments of the query placeholders in order.
+func (e GormQueryExpr) GetArgs() []interface{} {
+	if e.ArgList != nil {
+		return e.ArgList
+	}
+	return []interface{}{e.Args}
 }
 
 // Complete set of filters available for database queries.
@@ -28,6 +40,10 @@ const (
 	Equal
 	NotEqual
 	ValueIn
+	IsNull
+	NotNull
+	ContainsCaseInsensitive
+	StartsWith
 )
 
 // String formats for various filter expression queries
@@ -42,17 +58,39 @@ const (
 	equalQuery              = "%s = ?"
 	notEqualQuery           = "%s <> ?"
 	valueInQuery            = "%s in (?)"
+	isNullQuery             = "%s IS NULL"
+	notNullQuery            = "%s IS NOT NULL"
+	// LIKE patterns escape the wildcards of the matched value with backslashes, which isn't the default in sqlite.
+	containsCaseInsensitiveQuery = `LOWER(%s) LIKE ? ESCAPE '\'`
+	startsWithQuery              = `%s LIKE ? ESCAPE '\'`
+	startsWithArgs               = "%s%%"
 )
 
+var likeWildcardEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // Set of available filters which exclusively accept a single argument value.
 var singleValueFilters = map[FilterExpression]bool{
-	Contains:           true,
-	GreaterThan:        true,
-	GreaterThanOrEqual: true,
-	LessThan:           true,
-	LessThanOrEqual:    true,
-	Equal:              true,
-	NotEqual:           true,
+	Contains:                true,
+	GreaterThan:             true,
+	GreaterThanOrEqual:      true,
+	LessThan:                true,
+	LessThanOrEqual:         true,
+	Equal:                   true,
+	NotEqual:                true,
+	ContainsCaseInsensitive: true,
+	StartsWith:              true,
+}
+
+// Set of available filters which match a string value against patterns.
+var patternFilters = map[FilterExpression]bool{
+	ContainsCaseInsensitive: true,
+	StartsWith:              true,
+}
+
+// Set of available filters which accept no argument values.
+var nullFilters = map[FilterExpression]bool{
+	IsNull:  true,
+	NotNull: true,
 }
 
 // Set of available filters which exclusively accept repeated argument values.
@@ -61,16 +99,22 @@ var repeatedValueFilters = map[FilterExpression]bool{
 }
 
 const EqualExpression = "eq"
+const IsNullExpression = "is_null"
+const NotNullExpression = "not_null"
 
 var filterNameMappings = map[string]FilterExpression{
-	"contains":      Contains,
-	"gt":            GreaterThan,
-	"gte":           GreaterThanOrEqual,
-	"lt":            LessThan,
-	"lte":           LessThanOrEqual,
-	EqualExpression: Equal,
-	"ne":            NotEqual,
-	"value_in":      ValueIn,
+	"contains":        Contains,
+	"gt":              GreaterThan,
+	"gte":             GreaterThanOrEqual,
+	"lt":              LessThan,
+	"lte":             LessThanOrEqual,
+	EqualExpression:   Equal,
+	"ne":              NotEqual,
+	"value_in":        ValueIn,
+	IsNullExpression:  IsNull,
+	NotNullExpression: NotNull,
+	"icontains":       ContainsCaseInsensitive,
+	"starts_with":     StartsWith,
 }
 
 var executionIdentifierFields = map[string]bool{
@@ -88,6 +132,8 @@ const unrecognizedFilterFunction = "unrecognized filter function: %s"
 const unsupportedFilterExpression = "unsupported filter expression: %s"
 const invalidSingleValueFilter = "invalid single value filter expression: %s"
 const invalidRepeatedValueFilter = "invalid repeated value filter expression: %s"
+const invalidNullFilter = "invalid null filter expression: %s"
+const invalidPatternFilterValue = "%s filter expression only accepts a single string value"
 
 func getFilterExpressionName(expression FilterExpression) string {
 	switch expression {
@@ -107,6 +153,14 @@ func getFilterExpressionName(expression FilterExpression) string {
 		return "not equal"
 	case ValueIn:
 		return "value in"
+	case IsNull:
+		return "is null"
+	case NotNull:
+		return "not null"
+	case ContainsCaseInsensitive:
+		return "case insensitive contains"
+	case StartsWith:
+		return "starts with"
 	default:
 		return ""
 	}
@@ -132,6 +186,11 @@ func GetInvalidRepeatedValueFilterErr(expression FilterExpression) error {
 		getFilterExpressionName(expression))
 }
 
+func GetInvalidNullFilterErr(expression FilterExpression) error {
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidNullFilter,
+		getFilterExpressionName(expression))
+}
+
 // Interface for a single filter expression.
 type InlineFilter interface {
 	// Returns the entity for which this filter should be applied.
@@ -218,6 +277,30 @@ func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExp
 			Query: fmt.Sprintf(notEqualQuery, formattedField),
 			Args:  f.value,
 		}, nil
+	case IsNull:
+		return GormQueryExpr{
+			// WHERE field IS NULL
+			Query:   fmt.Sprintf(isNullQuery, formattedField),
+			ArgList: []interface{}{},
+		}, nil
+	case NotNull:
+		return GormQueryExpr{
+			// WHERE field IS NOT NULL
+			Query:   fmt.Sprintf(notNullQuery, formattedField),
+			ArgList: []interface{}{},
+		}, nil
+	case ContainsCaseInsensitive:
+		return GormQueryExpr{
+			// WHERE LOWER(field) LIKE %value% ESCAPE '\'
+			Query: fmt.Sprintf(containsCaseInsensitiveQuery, formattedField),
+			Args:  fmt.Sprintf(containsArgs, likeWildcardEscaper.Replace(strings.ToLower(f.value.(string)))),
+		}, nil
+	case StartsWith:
+		return GormQueryExpr{
+			// WHERE field LIKE value% ESCAPE '\'
+			Query: fmt.Sprintf(startsWithQuery, formattedField),
+			Args:  fmt.Sprintf(startsWithArgs, likeWildcardEscaper.Replace(f.value.(string))),
+		}, nil
 	}
 	logger.Debugf(context.Background(), "can't create gorm query expr for %s", getFilterExpressionName(f.function))
 	return GormQueryExpr{}, GetUnsupportedFilterExpressionErr(f.function)
@@ -254,6 +337,10 @@ func NewSingleValueFilter(entity Entity, function FilterExpression, field string
 	if _, ok := singleValueFilters[function]; !ok {
 		return nil, GetInvalidSingleValueFilterErr(function)
 	}
+	if _, ok := value.(string); patternFilters[function] && !ok {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidPatternFilterValue,
+			getFilterExpressionName(function))
+	}
 	customizedField := customizeField(field, entity)
 	customizedEntity := customizeEntity(field, entity)
 	return &inlineFilterImpl{
@@ -278,12 +365,28 @@ func NewRepeatedValueFilter(entity Entity, function FilterExpression, field stri
 	}, nil
 }
 
+// Returns a filter which checks whether a field is null and accepts no argument values.
+func NewNullFilter(entity Entity, function FilterExpression, field string) (InlineFilter, error) {
+	if _, ok := nullFilters[function]; !ok {
+		return nil, GetInvalidNullFilterErr(function)
+	}
+	return &inlineFilterImpl{
+		entity:   customizeEntity(field, entity),
+		function: function,
+		field:    customizeField(field, entity),
+	}, nil
+}
+
+// Returns the filter for the named function, where value is ignored for null filters.
 func NewInlineFilter(entity Entity, function string, field string, value interface{}) (InlineFilter, error) {
 	expression, ok := filterNameMappings[function]
 	if !ok {
 		logger.Debugf(context.Background(), "can't create filter for unrecognized function: %s", function)
 		return nil, GetUnrecognizedFilterFunctionErr(function)
 	}
+	if nullFilters[expression] {
+		return NewNullFilter(entity, expression, field)
+	}
 	if isSingleValueFilter := singleValueFilters[expression]; isSingleValueFilter {
 		return NewSingleValueFilter(entity, expression, field, value)
 	}
@@ -338,3 +441,120 @@ func NewWithDefaultValueFilter(defaultValue interface{}, filter InlineFilter) (I
 		defaultValue:     defaultValue,
 	}, nil
 }
+
+// Operators combining the filters of a group.
+const (
+	filterGroupAnd = " AND "
+	filterGroupOr  = " OR "
+)
+
+// FilterGroup is a filter combining other filters, which may be groups themselves. Its entity is that of its first
+// filter and it has no field.
+type FilterGroup interface {
+	InlineFilter
+	// Returns the grouped filters.
+	GetFilters() []InlineFilter
+	// Combines the query expressions of the grouped filters, given in the same order, into that of the group.
+	GetGormGroupQueryExpr(filterExprs []GormQueryExpr) GormQueryExpr
+}
+
+type filterGroupImpl struct {
+	operator string
+	negated  bool
+	filters  []InlineFilter
+}
+
+func (g *filterGroupImpl) GetEntity() Entity {
+	return g.filters[0].GetEntity()
+}
+
+func (g *filterGroupImpl) GetField() string {
+	return ""
+}
+
+func (g *filterGroupImpl) GetFilters() []InlineFilter {
+	return g.filters
+}
+
+func (g *filterGroupImpl) GetGormGroupQueryExpr(filterExprs []GormQueryExpr) GormQueryExpr {
+	queries := make([]string, len(filterExprs))
+	args := make([]interface{}, 0, len(filterExprs))
+	for idx, filterExpr := range filterExprs {
+		queries[idx] = filterExpr.Query
+		args = append(args, filterExpr.GetArgs()...)
+	}
+	query := fmt.Sprintf("(%s)", strings.Join(queries, g.operator))
+	if g.negated {
+		query = "NOT " + query
+	}
+	return GormQueryExpr{
+		Query:   query,
+		ArgList: args,
+	}
+}
+
+func (g *filterGroupImpl) getGormQueryExpr(getFilterExpr func(filter InlineFilter) (GormQueryExpr, error)) (
+	GormQueryExpr, error) {
+	filterExprs := make([]GormQueryExpr, len(g.filters))
+	for idx, filter := range g.filters {
+		filterExpr, err := getFilterExpr(filter)
+		if err != nil {
+			return GormQueryExpr{}, err
+		}
+		filterExprs[idx] = filterExpr
+	}
+	return g.GetGormGroupQueryExpr(filterExprs), nil
+}
+
+func (g *filterGroupImpl) GetGormQueryExpr() (GormQueryExpr, error) {
+	return g.getGormQueryExpr(func(filter InlineFilter) (GormQueryExpr, error) {
+		return filter.GetGormQueryExpr()
+	})
+}
+
+// Scopes every grouped filter to the given table. Groups of filters on different entities are scoped by repositories
+// one filter at a time using GetGormGroupQueryExpr instead.
+func (g *filterGroupImpl) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
+	return g.getGormQueryExpr(func(filter InlineFilter) (GormQueryExpr, error) {
+		return filter.GetGormJoinTableQueryExpr(tableName)
+	})
+}
+
+func newFilterGroup(operator string, negated bool, filters []InlineFilter) (InlineFilter, error) {
+	if len(filters) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "filter groups require at least one filter")
+	}
+	return &filterGroupImpl{
+		operator: operator,
+		negated:  negated,
+		filters:  filters,
+	}, nil
+}
+
+// Returns a filter matching rows matched by all the given filters.
+func NewAndFilterGroup(filters ...InlineFilter) (InlineFilter, error) {
+	return newFilterGroup(filterGroupAnd, false, filters)
+}
+
+// Returns a filter matching rows matched by any of the given filters.
+func NewOrFilterGroup(filters ...InlineFilter) (InlineFilter, error) {
+	return newFilterGroup(filterGroupOr, false, filters)
+}
+
+// Returns a filter matching rows not matched by all the given filters.
+func NewNotFilterGroup(filters ...InlineFilter) (InlineFilter, error) {
+	return newFilterGroup(filterGroupAnd, true, filters)
+}
+
+// GetFilterEntities returns the entities the filter applies to, which are those of all grouped filters for groups.
+func GetFilterEntities(filter InlineFilter) []Entity {
+	group, ok := filter.(FilterGroup)
+	if !ok {
+		return []Entity{filter.GetEntity()}
+	}
+	var entities []Entity
+	for _, groupedFilter := range group.GetFilters() {
+		entities = append(entities, GetFilterEntities(groupedFilter)...)
+	}
+	return entities
+}
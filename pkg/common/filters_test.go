@@ -166,3 +166,71 @@ func TestWithDefaultValueFilter(t *testing.T) {
 	assert.Equal(t, "COALESCE(named_entity_metadata.state, 0) = ?", queryExpression.Query)
 	assert.Equal(t, 1, queryExpression.Args)
 }
+
+func TestNullFilters(t *testing.T) {
+	filter, err := NewInlineFilter(Execution, "is_null", "error_code", nil)
+	assert.NoError(t, err)
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "error_code IS NULL", gormQueryExpr.Query)
+	assert.Empty(t, gormQueryExpr.GetArgs())
+
+	filter, err = NewNullFilter(Execution, NotNull, "error_code")
+	assert.NoError(t, err)
+	gormQueryExpr, err = filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "executions.error_code IS NOT NULL", gormQueryExpr.Query)
+
+	_, err = NewNullFilter(Execution, Equal, "error_code")
+	assert.EqualError(t, err, "invalid null filter expression: equal")
+}
+
+func TestPatternFilters(t *testing.T) {
+	filter, err := NewSingleValueFilter(Workflow, ContainsCaseInsensitive, "name", "My_Work%flow")
+	assert.NoError(t, err)
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, `LOWER(name) LIKE ? ESCAPE '\'`, gormQueryExpr.Query)
+	assert.Equal(t, `%my\_work\%flow%`, gormQueryExpr.Args)
+
+	filter, err = NewInlineFilter(Workflow, "starts_with", "name", `core\flow`)
+	assert.NoError(t, err)
+	gormQueryExpr, err = filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, `name LIKE ? ESCAPE '\'`, gormQueryExpr.Query)
+	assert.Equal(t, `core\\flow%`, gormQueryExpr.Args)
+
+	_, err = NewSingleValueFilter(Workflow, StartsWith, "name", 1)
+	assert.EqualError(t, err, "starts with filter expression only accepts a single string value")
+}
+
+func TestFilterGroups(t *testing.T) {
+	phaseFilter, err := NewSingleValueFilter(Execution, Equal, "phase", "FAILED")
+	assert.NoError(t, err)
+	modeFilter, err := NewSingleValueFilter(Execution, NotEqual, "mode", 0)
+	assert.NoError(t, err)
+	nameFilter, err := NewSingleValueFilter(LaunchPlan, Equal, "name", "lp")
+	assert.NoError(t, err)
+
+	andGroup, err := NewAndFilterGroup(modeFilter, nameFilter)
+	assert.NoError(t, err)
+	orGroup, err := NewOrFilterGroup(phaseFilter, andGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, Execution, orGroup.GetEntity())
+	assert.Equal(t, []Entity{Execution, Execution, LaunchPlan}, GetFilterEntities(orGroup))
+
+	gormQueryExpr, err := orGroup.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "(phase = ? OR (mode <> ? AND name = ?))", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"FAILED", 0, "lp"}, gormQueryExpr.GetArgs())
+
+	notGroup, err := NewNotFilterGroup(phaseFilter)
+	assert.NoError(t, err)
+	gormQueryExpr, err = notGroup.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "NOT (executions.phase = ?)", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"FAILED"}, gormQueryExpr.GetArgs())
+
+	_, err = NewOrFilterGroup()
+	assert.EqualError(t, err, "filter groups require at least one filter")
+}
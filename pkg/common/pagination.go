@@ -0,0 +1,109 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// PageCursor marks the last row of a page of list results. The next page holds the rows strictly after it in the
+// order of the sort key, with ties broken by the unique row id, which lets the database seek to it through an index
+// rather than scan and discard every row before it as with offsets.
+type PageCursor struct {
+	SortKey   string
+	Direction admin.Sort_Direction
+	// The last row's sort key value, one of string, int64, float64 or time.Time.
+	SortValue interface{}
+	ID        uint
+}
+
+// Page tokens are the base64 encoded json of the cursor, with the sort value stored in the field matching its type.
+type pageToken struct {
+	SortKey   string               `json:"k"`
+	Direction admin.Sort_Direction `json:"d,omitempty"`
+	String    *string              `json:"s,omitempty"`
+	Int       *int64               `json:"n,omitempty"`
+	Float     *float64             `json:"f,omitempty"`
+	Time      *time.Time           `json:"t,omitempty"`
+	ID        uint                 `json:"i"`
+}
+
+// NewPageCursorValue converts a sort key value read from a model to the type stored in page cursors, returning false
+// for values of types cursors can't store, e.g. pointers to nullable columns.
+func NewPageCursorValue(value interface{}) (interface{}, bool) {
+	if t, ok := value.(time.Time); ok {
+		return t, true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return nil, false
+}
+
+// EncodePageCursor returns the opaque token clients pass back to list the page following the cursor.
+func EncodePageCursor(cursor PageCursor) (string, error) {
+	token := pageToken{
+		SortKey:   cursor.SortKey,
+		Direction: cursor.Direction,
+		ID:        cursor.ID,
+	}
+	switch value := cursor.SortValue.(type) {
+	case string:
+		token.String = &value
+	case int64:
+		token.Int = &value
+	case float64:
+		token.Float = &value
+	case time.Time:
+		token.Time = &value
+	default:
+		return "", fmt.Errorf("unsupported page cursor value [%v] of type %T", value, value)
+	}
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodePageCursor returns the cursor encoded in a token returned by EncodePageCursor.
+func DecodePageCursor(encoded string) (PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return PageCursor{}, err
+	}
+	var token pageToken
+	if err = json.Unmarshal(raw, &token); err != nil {
+		return PageCursor{}, err
+	}
+	cursor := PageCursor{
+		SortKey:   token.SortKey,
+		Direction: token.Direction,
+		ID:        token.ID,
+	}
+	switch {
+	case token.String != nil:
+		cursor.SortValue = *token.String
+	case token.Int != nil:
+		cursor.SortValue = *token.Int
+	case token.Float != nil:
+		cursor.SortValue = *token.Float
+	case token.Time != nil:
+		cursor.SortValue = *token.Time
+	default:
+		return PageCursor{}, fmt.Errorf("page cursor is missing a sort value")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPageCursorValue(t *testing.T) {
+	createdAt := time.Date(2023, 6, 1, 12, 30, 0, 123456000, time.UTC)
+	for _, tc := range []struct {
+		value    interface{}
+		expected interface{}
+	}{
+		{"name", "name"},
+		{createdAt, createdAt},
+		{uint32(3), int64(3)},
+		{core.ResourceType_WORKFLOW, int64(2)},
+		{time.Duration(5), int64(5)},
+		{1.5, 1.5},
+	} {
+		value, ok := NewPageCursorValue(tc.value)
+		assert.True(t, ok)
+		assert.Equal(t, tc.expected, value)
+	}
+
+	_, ok := NewPageCursorValue(&createdAt)
+	assert.False(t, ok)
+	_, ok = NewPageCursorValue([]byte("closure"))
+	assert.False(t, ok)
+}
+
+func TestPageCursor_RoundTrip(t *testing.T) {
+	for _, value := range []interface{}{
+		"name",
+		int64(-7),
+		0.25,
+		time.Date(2023, 6, 1, 12, 30, 0, 123456000, time.UTC),
+	} {
+		cursor := PageCursor{
+			SortKey:   "created_at",
+			Direction: admin.Sort_DESCENDING,
+			SortValue: value,
+			ID:        42,
+		}
+		token, err := EncodePageCursor(cursor)
+		assert.NoError(t, err)
+		decoded, err := DecodePageCursor(token)
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	}
+}
+
+func TestEncodePageCursor_UnsupportedValue(t *testing.T) {
+	_, err := EncodePageCursor(PageCursor{SortKey: "started_at", SortValue: &time.Time{}})
+	assert.Error(t, err)
+}
+
+func TestDecodePageCursor_Invalid(t *testing.T) {
+	_, err := DecodePageCursor("not a token")
+	assert.Error(t, err)
+
+	// Valid base64 encoded json lacking a sort value.
+	_, err = DecodePageCursor("eyJrIjoibmFtZSIsImkiOjF9")
+	assert.EqualError(t, err, "page cursor is missing a sort value")
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
@@ -13,38 +14,78 @@ import (
 const gormDescending = "%s desc"
 const gormAscending = "%s asc"
 
+// Sort keys are separated by commas, e.g. "phase,created_at desc".
+const sortKeySeparator = ","
+
+var sortDirectionSuffixes = map[string]admin.Sort_Direction{
+	"asc":  admin.Sort_ASCENDING,
+	"desc": admin.Sort_DESCENDING,
+}
+
+// SortKey is a column results are sorted by.
+type SortKey struct {
+	Key       string
+	Direction admin.Sort_Direction
+}
+
 type SortParameter interface {
 	GetGormOrderExpr() string
+	// Returns the columns results are sorted by, in order of precedence.
+	GetSortKeys() []SortKey
 }
 
 type sortParamImpl struct {
 	gormOrderExpression string
+	sortKeys            []SortKey
 }
 
 func (s *sortParamImpl) GetGormOrderExpr() string {
 	return s.gormOrderExpression
 }
 
+func (s *sortParamImpl) GetSortKeys() []SortKey {
+	return s.sortKeys
+}
+
+// NewSortParameter returns the sort parameter for the given sort, whose key may list several columns separated by
+// commas. Each column is sorted in the direction of the sort unless followed by its own, e.g. "phase asc,created_at".
 func NewSortParameter(sort *admin.Sort, allowed sets.String) (SortParameter, error) {
 	if sort == nil {
 		return nil, nil
 	}
 
-	key := sort.Key
-	if !allowed.Has(key) {
-		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid sort key '%s'", key)
-	}
-
-	var gormOrderExpression string
 	switch sort.Direction {
-	case admin.Sort_DESCENDING:
-		gormOrderExpression = fmt.Sprintf(gormDescending, key)
-	case admin.Sort_ASCENDING:
-		gormOrderExpression = fmt.Sprintf(gormAscending, key)
+	case admin.Sort_DESCENDING, admin.Sort_ASCENDING:
 	default:
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid sort order specified: %v", sort)
 	}
+	keys := strings.Split(sort.Key, sortKeySeparator)
+	sortKeys := make([]SortKey, 0, len(keys))
+	orderExpressions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		sortKey := SortKey{
+			Key:       strings.TrimSpace(key),
+			Direction: sort.Direction,
+		}
+		if fields := strings.Fields(key); len(fields) == 2 {
+			direction, ok := sortDirectionSuffixes[strings.ToLower(fields[1])]
+			if !ok {
+				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid sort order specified: %v", sort)
+			}
+			sortKey = SortKey{Key: fields[0], Direction: direction}
+		}
+		if !allowed.Has(sortKey.Key) {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid sort key '%s'", sortKey.Key)
+		}
+		sortKeys = append(sortKeys, sortKey)
+		if sortKey.Direction == admin.Sort_DESCENDING {
+			orderExpressions = append(orderExpressions, fmt.Sprintf(gormDescending, sortKey.Key))
+		} else {
+			orderExpressions = append(orderExpressions, fmt.Sprintf(gormAscending, sortKey.Key))
+		}
+	}
 	return &sortParamImpl{
-		gormOrderExpression: gormOrderExpression,
+		gormOrderExpression: strings.Join(orderExpressions, ", "),
+		sortKeys:            sortKeys,
 	}, nil
 }
@@ -52,4 +52,42 @@ func TestSortParameter_Descending(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, "project desc", sortParameter.GetGormOrderExpr())
+	assert.Equal(t, []SortKey{{Key: "project", Direction: admin.Sort_DESCENDING}}, sortParameter.GetSortKeys())
+}
+
+func TestSortParameter_MultipleKeys(t *testing.T) {
+	sortParameter, err := NewSortParameter(&admin.Sort{
+		Direction: admin.Sort_DESCENDING,
+		Key:       "phase asc, created_at,name DESC",
+	}, sets.NewString("phase", "created_at", "name"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "phase asc, created_at desc, name desc", sortParameter.GetGormOrderExpr())
+	assert.Equal(t, []SortKey{
+		{Key: "phase", Direction: admin.Sort_ASCENDING},
+		{Key: "created_at", Direction: admin.Sort_DESCENDING},
+		{Key: "name", Direction: admin.Sort_DESCENDING},
+	}, sortParameter.GetSortKeys())
+}
+
+func TestSortParameter_MultipleKeysInvalid(t *testing.T) {
+	allowed := sets.NewString("phase", "created_at")
+	_, err := NewSortParameter(&admin.Sort{
+		Direction: admin.Sort_ASCENDING,
+		Key:       "phase,wrong desc",
+	}, allowed)
+	assert.EqualError(t, err, "invalid sort key 'wrong'")
+
+	_, err = NewSortParameter(&admin.Sort{
+		Direction: admin.Sort_ASCENDING,
+		Key:       "phase,created_at sideways",
+	}, allowed)
+	assert.Error(t, err)
+
+	// Sort keys can't be injected into the order expression.
+	_, err = NewSortParameter(&admin.Sort{
+		Direction: admin.Sort_ASCENDING,
+		Key:       "phase; drop table executions",
+	}, allowed)
+	assert.Error(t, err)
 }
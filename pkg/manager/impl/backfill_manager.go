@@ -0,0 +1,470 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerExecutor "github.com/flyteorg/flyteadmin/scheduler/executor"
+	schedulerIdentifier "github.com/flyteorg/flyteadmin/scheduler/identifier"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+// The maximum number of running backfills whose executions are launched at a time, oldest first.
+const maxLaunchedBackfills = 100
+
+// Backfills fail rather than retry launching an execution once admin rejected it with these codes.
+var permanentBackfillErrorCodes = map[codes.Code]bool{
+	codes.InvalidArgument:    true,
+	codes.NotFound:           true,
+	codes.FailedPrecondition: true,
+	codes.PermissionDenied:   true,
+}
+
+// The phases of the executions which aren't terminal yet.
+var runningExecutionPhases = func() []string {
+	var phases []string
+	for value, name := range core.WorkflowExecution_Phase_name {
+		if !common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+			phases = append(phases, name)
+		}
+	}
+	sort.Strings(phases)
+	return phases
+}()
+
+type backfillMetrics struct {
+	Scope              promutils.Scope
+	ExecutionsLaunched prometheus.Counter
+	FailedLaunches     prometheus.Counter
+	BackfillsSucceeded prometheus.Counter
+	BackfillsFailed    prometheus.Counter
+}
+
+type BackfillManager struct {
+	db                repoInterfaces.Repository
+	config            runtimeInterfaces.Configuration
+	launchPlanManager interfaces.LaunchPlanInterface
+	executionManager  interfaces.ExecutionInterface
+	metrics           backfillMetrics
+}
+
+// Returns the schedule of the launch plan version a backfill launches executions of.
+func getBackfillSchedule(backfill models.Backfill) schedulerModels.SchedulableEntity {
+	active := true
+	return schedulerModels.SchedulableEntity{
+		SchedulableEntityKey: schedulerModels.SchedulableEntityKey{
+			Project: backfill.Project,
+			Domain:  backfill.Domain,
+			Name:    backfill.LaunchPlanName,
+			Version: backfill.LaunchPlanVersion,
+		},
+		CronExpression:      backfill.CronExpression,
+		FixedRateValue:      backfill.FixedRateValue,
+		Unit:                backfill.Unit,
+		KickoffTimeInputArg: backfill.KickoffTimeInputArg,
+		Active:              &active,
+	}
+}
+
+// Returns the times the schedule of a backfill is scheduled at over its range, of which there may be at most maxCount.
+func (m *BackfillManager) getScheduledTimes(backfill models.Backfill, maxCount int) ([]time.Time, error) {
+	// As in the native scheduler, cron schedules without a time zone are evaluated in the time zone of the scheduler.
+	startTime, endTime := backfill.StartTime.Local(), backfill.EndTime.Local()
+	workflowExecutorConfig := m.config.ApplicationConfiguration().GetSchedulerConfig().GetWorkflowExecutorConfig()
+	if executorConfig := workflowExecutorConfig.GetFlyteWorkflowExecutorConfig(); executorConfig != nil &&
+		executorConfig.GetUseUTCTz() {
+		startTime, endTime = startTime.UTC(), endTime.UTC()
+	}
+	return schedulerCore.GetScheduledTimesInRange(getBackfillSchedule(backfill), startTime, endTime, maxCount)
+}
+
+// Returns the times the schedule of a backfill is scheduled at along with the names of the executions it launches for
+// them, which are deterministic so that concurrent admin instances launching the same time don't duplicate them.
+func (m *BackfillManager) getBackfillExecutionNames(ctx context.Context, backfill models.Backfill) (
+	[]time.Time, []string, error) {
+	scheduledTimes, err := m.getScheduledTimes(backfill, backfill.ScheduledTimes)
+	if err != nil {
+		return nil, nil, err
+	}
+	identifier := core.Identifier{
+		Project: backfill.Project,
+		Domain:  backfill.Domain,
+		Name:    backfill.LaunchPlanName,
+		Version: backfill.LaunchPlanVersion,
+	}
+	names := make([]string, len(scheduledTimes))
+	for idx, scheduledTime := range scheduledTimes {
+		if names[idx], err = schedulerIdentifier.GetBackfillExecutionName(
+			ctx, identifier, backfill.Name, scheduledTime); err != nil {
+			return nil, nil, err
+		}
+	}
+	return scheduledTimes, names, nil
+}
+
+// Returns the number of executions of a backfill which were launched, amongst those of its scheduled times.
+func getLaunchedCount(backfill models.Backfill, names []string) int {
+	if backfill.Launched > len(names) {
+		return len(names)
+	}
+	return backfill.Launched
+}
+
+func (m *BackfillManager) getLaunchPlan(ctx context.Context, request interfaces.BackfillCreateRequest) (
+	*admin.LaunchPlan, error) {
+	if len(request.LaunchPlanVersion) == 0 {
+		return m.launchPlanManager.GetActiveLaunchPlan(ctx, admin.ActiveLaunchPlanRequest{
+			Id: &admin.NamedEntityIdentifier{
+				Project: request.Project,
+				Domain:  request.Domain,
+				Name:    request.LaunchPlan,
+			},
+		})
+	}
+	return m.launchPlanManager.GetLaunchPlan(ctx, admin.ObjectGetRequest{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      request.Project,
+			Domain:       request.Domain,
+			Name:         request.LaunchPlan,
+			Version:      request.LaunchPlanVersion,
+		},
+	})
+}
+
+func (m *BackfillManager) CreateBackfill(ctx context.Context, request interfaces.BackfillCreateRequest) (
+	*interfaces.Backfill, error) {
+	if err := validation.ValidateBackfillCreateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid backfill create request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	launchPlan, err := m.getLaunchPlan(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	backfillConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetBackfillConfig()
+	schedule := launchPlan.GetSpec().GetEntityMetadata().GetSchedule()
+	backfill := models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: request.Project,
+			Domain:  request.Domain,
+			Name:    request.Name,
+		},
+		LaunchPlanName:      launchPlan.GetId().GetName(),
+		LaunchPlanVersion:   launchPlan.GetId().GetVersion(),
+		KickoffTimeInputArg: schedule.GetKickoffTimeInputArg(),
+		StartTime:           request.StartTime,
+		EndTime:             request.EndTime,
+		MaxConcurrency:      request.MaxConcurrency,
+		State:               models.BackfillStateRunning,
+		Principal:           getUser(ctx),
+	}
+	switch {
+	case schedule.GetCronSchedule() != nil:
+		backfill.CronExpression = schedule.GetCronSchedule().GetSchedule()
+	case schedule.GetRate() != nil:
+		backfill.FixedRateValue = schedule.GetRate().GetValue()
+		backfill.Unit = schedule.GetRate().GetUnit()
+	default:
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] has no cron schedule or fixed rate to backfill", launchPlan.GetId())
+	}
+	if len(backfill.Name) == 0 {
+		backfill.Name = common.GetExecutionName(time.Now().UnixNano())
+	}
+	if backfill.MaxConcurrency == 0 {
+		backfill.MaxConcurrency = backfillConfig.DefaultMaxConcurrency
+	}
+	scheduledTimes, err := m.getScheduledTimes(backfill, backfillConfig.MaxScheduledTimes)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "can't backfill launch plan [%+v]: %v",
+			launchPlan.GetId(), err)
+	}
+	if len(scheduledTimes) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] isn't scheduled at any time from %v through %v", launchPlan.GetId(),
+			request.StartTime, request.EndTime)
+	}
+	backfill.ScheduledTimes = len(scheduledTimes)
+	if err := m.db.BackfillRepo().Create(ctx, backfill); err != nil {
+		logger.Debugf(ctx, "Failed to create backfill [%+v] with err: %v", backfill.BackfillKey, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "[%s] created backfill [%+v] of launch plan [%+v] over %d scheduled times", backfill.Principal,
+		backfill.BackfillKey, launchPlan.GetId(), backfill.ScheduledTimes)
+	return m.GetBackfill(ctx, interfaces.BackfillIdentifier{
+		Project: backfill.Project,
+		Domain:  backfill.Domain,
+		Name:    backfill.Name,
+	})
+}
+
+func (m *BackfillManager) GetBackfill(ctx context.Context, id interfaces.BackfillIdentifier) (
+	*interfaces.Backfill, error) {
+	if err := validation.ValidateBackfillIdentifier(id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	backfill, err := m.db.BackfillRepo().Get(ctx, models.BackfillKey{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	scheduledTimes, names, err := m.getBackfillExecutionNames(ctx, backfill)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to compute the scheduled times: %v", err)
+	}
+	launched := getLaunchedCount(backfill, names)
+	executions, err := util.ListExecutionsByName(ctx, m.db, backfill.Project, backfill.Domain, names[:launched], nil)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the executions of backfill [%+v] with err: %v", id, err)
+		return nil, err
+	}
+	phases := make(map[string]string, len(executions))
+	for _, execution := range executions {
+		phases[execution.Name] = execution.Phase
+	}
+	response := &interfaces.Backfill{
+		Project: backfill.Project,
+		Domain:  backfill.Domain,
+		Name:    backfill.Name,
+		LaunchPlanID: core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      backfill.Project,
+			Domain:       backfill.Domain,
+			Name:         backfill.LaunchPlanName,
+			Version:      backfill.LaunchPlanVersion,
+		},
+		CronExpression: backfill.CronExpression,
+		StartTime:      backfill.StartTime,
+		EndTime:        backfill.EndTime,
+		MaxConcurrency: backfill.MaxConcurrency,
+		State:          backfill.State,
+		Message:        backfill.Message,
+		Principal:      backfill.Principal,
+		ScheduledTimes: backfill.ScheduledTimes,
+		Launched:       backfill.Launched,
+		Executions:     make([]interfaces.ScheduledExecution, launched),
+		CreatedAt:      backfill.CreatedAt,
+		UpdatedAt:      backfill.UpdatedAt,
+	}
+	if len(backfill.CronExpression) == 0 {
+		response.FixedRateValue = backfill.FixedRateValue
+		response.FixedRateUnit = backfill.Unit.String()
+	}
+	for idx := range response.Executions {
+		response.Executions[idx] = interfaces.ScheduledExecution{
+			ScheduledTime: scheduledTimes[idx],
+			ExecutionID: core.WorkflowExecutionIdentifier{
+				Project: backfill.Project,
+				Domain:  backfill.Domain,
+				Name:    names[idx],
+			},
+			Phase: phases[names[idx]],
+		}
+	}
+	return response, nil
+}
+
+// Terminates the executions of a backfill amongst those named which are still running.
+func (m *BackfillManager) terminateRunningExecutions(ctx context.Context, backfill models.Backfill,
+	names []string) error {
+	executions, err := util.ListExecutionsByName(ctx, m.db, backfill.Project, backfill.Domain, names,
+		runningExecutionPhases)
+	if err != nil {
+		return err
+	}
+	var terminateErr error
+	for _, execution := range executions {
+		_, err := m.executionManager.TerminateExecution(ctx, admin.ExecutionTerminateRequest{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: execution.Project,
+				Domain:  execution.Domain,
+				Name:    execution.Name,
+			},
+			Cause: fmt.Sprintf("backfill %s was cancelled", backfill.Name),
+		})
+		if err != nil {
+			logger.Warnf(ctx, "Failed to terminate execution [%s] of backfill [%+v] with err: %v", execution.Name,
+				backfill.BackfillKey, err)
+			terminateErr = err
+		}
+	}
+	return terminateErr
+}
+
+func (m *BackfillManager) CancelBackfill(ctx context.Context, id interfaces.BackfillIdentifier) (
+	*interfaces.Backfill, error) {
+	if err := validation.ValidateBackfillIdentifier(id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	backfill, err := m.db.BackfillRepo().Get(ctx, models.BackfillKey{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if backfill.State == models.BackfillStateRunning {
+		cancelled := backfill
+		cancelled.State = models.BackfillStateCancelled
+		cancelled.Message = fmt.Sprintf("cancelled by [%s]", getUser(ctx))
+		if _, err := m.db.BackfillRepo().Update(ctx, cancelled); err != nil {
+			return nil, err
+		}
+		logger.Infof(ctx, "[%s] cancelled backfill [%+v]", getUser(ctx), id)
+	}
+	// Cancelling is idempotent, so that the executions which failed to be terminated may be terminated again.
+	_, names, err := m.getBackfillExecutionNames(ctx, backfill)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to compute the scheduled times: %v", err)
+	}
+	// All of them, since another admin instance may be launching more of them.
+	if err := m.terminateRunningExecutions(ctx, backfill, names); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to terminate the executions of backfill [%+v]: %v", id, err)
+	}
+	return m.GetBackfill(ctx, id)
+}
+
+// Marks a running backfill as failed.
+func (m *BackfillManager) failBackfill(ctx context.Context, backfill models.Backfill, err error) error {
+	m.metrics.BackfillsFailed.Inc()
+	failed := backfill
+	failed.State = models.BackfillStateFailed
+	failed.Message = err.Error()
+	if _, updateErr := m.db.BackfillRepo().Update(ctx, failed); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+// Launches the next executions of a running backfill, for as long as fewer than its maximum concurrency are running.
+func (m *BackfillManager) launchBackfill(ctx context.Context, backfill models.Backfill) error {
+	ctx = contextutils.WithProjectDomain(ctx, backfill.Project, backfill.Domain)
+	scheduledTimes, names, err := m.getBackfillExecutionNames(ctx, backfill)
+	if err != nil {
+		return m.failBackfill(ctx, backfill, err)
+	}
+	launched := getLaunchedCount(backfill, names)
+	running, err := util.ListExecutionsByName(ctx, m.db, backfill.Project, backfill.Domain, names[:launched],
+		runningExecutionPhases)
+	if err != nil {
+		return err
+	}
+	if launched == len(names) && len(running) == 0 {
+		succeeded := backfill
+		succeeded.State = models.BackfillStateSucceeded
+		if _, err := m.db.BackfillRepo().Update(ctx, succeeded); err != nil {
+			return err
+		}
+		m.metrics.BackfillsSucceeded.Inc()
+		logger.Infof(ctx, "backfill [%+v] succeeded", backfill.BackfillKey)
+		return nil
+	}
+
+	schedule := getBackfillSchedule(backfill)
+	progress := backfill
+	var launchErr error
+	launchCode := codes.OK
+	for active := len(running); launched < len(names) && active < int(backfill.MaxConcurrency); active++ {
+		request := schedulerExecutor.NewExecutionCreateRequest(schedule, names[launched], scheduledTimes[launched])
+		_, err := m.executionManager.CreateExecution(ctx, *request, time.Now())
+		// The execution may have been launched by another admin instance already.
+		if err != nil && status.Code(err) != codes.AlreadyExists {
+			m.metrics.FailedLaunches.Inc()
+			launchCode = status.Code(err)
+			launchErr = fmt.Errorf("failed to launch the execution scheduled at %v: %w", scheduledTimes[launched], err)
+			break
+		}
+		m.metrics.ExecutionsLaunched.Inc()
+		launched++
+	}
+	if launched > backfill.Launched {
+		progress.Launched = launched
+		updated, err := m.db.BackfillRepo().Update(ctx, progress)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			// Cancelling the backfill terminated its executions launched by then, but not those launched since.
+			current, err := m.db.BackfillRepo().Get(ctx, backfill.BackfillKey)
+			if err != nil {
+				return err
+			}
+			if current.State == models.BackfillStateCancelled {
+				return m.terminateRunningExecutions(ctx, current, names[backfill.Launched:launched])
+			}
+		}
+	}
+	if launchErr != nil && permanentBackfillErrorCodes[launchCode] {
+		progress.Launched = launched
+		return m.failBackfill(ctx, progress, launchErr)
+	}
+	return launchErr
+}
+
+func (m *BackfillManager) LaunchBackfills(ctx context.Context) error {
+	backfills, err := m.db.BackfillRepo().ListRunning(ctx, maxLaunchedBackfills)
+	if err != nil {
+		return err
+	}
+	for _, backfill := range backfills {
+		if err := m.launchBackfill(ctx, backfill); err != nil {
+			logger.Warnf(ctx, "Failed to launch the executions of backfill [%+v] with err: %v",
+				backfill.BackfillKey, err)
+		}
+	}
+	return nil
+}
+
+func NewBackfillManager(
+	db repoInterfaces.Repository,
+	config runtimeInterfaces.Configuration,
+	launchPlanManager interfaces.LaunchPlanInterface,
+	executionManager interfaces.ExecutionInterface,
+	scope promutils.Scope) interfaces.BackfillInterface {
+	return &BackfillManager{
+		db:                db,
+		config:            config,
+		launchPlanManager: launchPlanManager,
+		executionManager:  executionManager,
+		metrics: backfillMetrics{
+			Scope: scope,
+			ExecutionsLaunched: scope.MustNewCounter("executions_launched",
+				"count of executions launched by backfills"),
+			FailedLaunches: scope.MustNewCounter("failed_launches",
+				"count of failed attempts to launch the executions of backfills"),
+			BackfillsSucceeded: scope.MustNewCounter("backfills_succeeded",
+				"count of backfills which launched all their executions"),
+			BackfillsFailed: scope.MustNewCounter("backfills_failed",
+				"count of backfills which failed to launch their executions"),
+		},
+	}
+}
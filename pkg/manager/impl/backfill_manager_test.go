@@ -0,0 +1,320 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	schedulerIdentifier "github.com/flyteorg/flyteadmin/scheduler/identifier"
+)
+
+const backfillName = "backfill"
+
+var backfillStartTime = time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)
+
+func getMockConfigForBackfillTest(maxScheduledTimes int) runtimeInterfaces.Configuration {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		Backfill: runtimeInterfaces.BackfillConfig{
+			MaxScheduledTimes:     maxScheduledTimes,
+			DefaultMaxConcurrency: 5,
+		},
+	})
+	applicationConfig.SetSchedulerConfig(runtimeInterfaces.SchedulerConfig{
+		WorkflowExecutorConfig: runtimeInterfaces.WorkflowExecutorConfig{
+			FlyteWorkflowExecutorConfig: &runtimeInterfaces.FlyteWorkflowExecutorConfig{UseUTCTz: true},
+		},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(&applicationConfig, nil, nil, nil, nil, nil)
+}
+
+// Returns a running backfill of an hourly schedule, scheduled at three times.
+func getMockBackfill(launched int, maxConcurrency uint32) models.Backfill {
+	return models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: project,
+			Domain:  domain,
+			Name:    backfillName,
+		},
+		LaunchPlanName:    name,
+		LaunchPlanVersion: version,
+		CronExpression:    "0 * * * *",
+		StartTime:         backfillStartTime,
+		EndTime:           backfillStartTime.Add(2 * time.Hour),
+		MaxConcurrency:    maxConcurrency,
+		ScheduledTimes:    3,
+		Launched:          launched,
+		State:             models.BackfillStateRunning,
+	}
+}
+
+func getBackfillExecutionName(t *testing.T, scheduledTime time.Time) string {
+	executionName, err := schedulerIdentifier.GetBackfillExecutionName(context.Background(), core.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	}, backfillName, scheduledTime)
+	assert.NoError(t, err)
+	return executionName
+}
+
+// Lists the executions named in the inline filters as running when listing those in running phases.
+func setRunningExecutionsForBackfillTest(repository interfaces.Repository, running ...string) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			var executions []models.Execution
+			for _, executionName := range running {
+				executions = append(executions, models.Execution{
+					ExecutionKey: models.ExecutionKey{
+						Project: project,
+						Domain:  domain,
+						Name:    executionName,
+					},
+					Phase: core.WorkflowExecution_RUNNING.String(),
+				})
+			}
+			return interfaces.ExecutionCollectionOutput{Executions: executions}, nil
+		})
+}
+
+func TestCreateBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	launchPlanManager := managerMocks.NewMockLaunchPlanManager()
+	launchPlanManager.(*managerMocks.MockLaunchPlanManager).SetGetActiveLaunchPlanCallback(
+		func(ctx context.Context, request admin.ActiveLaunchPlanRequest) (*admin.LaunchPlan, error) {
+			assert.Equal(t, name, request.Id.Name)
+			return &admin.LaunchPlan{
+				Id: &launchPlanIdentifier,
+				Spec: &admin.LaunchPlanSpec{
+					EntityMetadata: &admin.LaunchPlanMetadata{
+						Schedule: &admin.Schedule{
+							ScheduleExpression: &admin.Schedule_CronSchedule{
+								CronSchedule: &admin.CronSchedule{Schedule: "0 * * * *"},
+							},
+							KickoffTimeInputArg: "kickoff_time",
+						},
+					},
+				},
+			}, nil
+		})
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	var created models.Backfill
+	backfillRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.Backfill)
+	}).Return(nil)
+	backfillRepo.OnGetMatch(mock.Anything, models.BackfillKey{Project: project, Domain: domain, Name: backfillName}).
+		Return(getMockBackfill(0, 5), nil)
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10), launchPlanManager,
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	backfill, err := backfillManager.CreateBackfill(context.Background(), managerInterfaces.BackfillCreateRequest{
+		Project:    project,
+		Domain:     domain,
+		Name:       backfillName,
+		LaunchPlan: name,
+		StartTime:  backfillStartTime.Add(-time.Minute),
+		EndTime:    backfillStartTime.Add(2 * time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, version, created.LaunchPlanVersion)
+	assert.Equal(t, "0 * * * *", created.CronExpression)
+	assert.Equal(t, "kickoff_time", created.KickoffTimeInputArg)
+	assert.Equal(t, 3, created.ScheduledTimes)
+	assert.Equal(t, uint32(5), created.MaxConcurrency)
+	assert.Equal(t, models.BackfillStateRunning, created.State)
+	assert.Equal(t, backfillName, backfill.Name)
+	assert.Equal(t, version, backfill.LaunchPlanID.Version)
+	assert.Equal(t, 3, backfill.ScheduledTimes)
+	assert.Empty(t, backfill.Executions)
+}
+
+func TestCreateBackfill_TooManyScheduledTimes(t *testing.T) {
+	launchPlanManager := managerMocks.NewMockLaunchPlanManager()
+	launchPlanManager.(*managerMocks.MockLaunchPlanManager).SetGetActiveLaunchPlanCallback(
+		func(ctx context.Context, request admin.ActiveLaunchPlanRequest) (*admin.LaunchPlan, error) {
+			return &admin.LaunchPlan{
+				Id: &launchPlanIdentifier,
+				Spec: &admin.LaunchPlanSpec{
+					EntityMetadata: &admin.LaunchPlanMetadata{
+						Schedule: &admin.Schedule{
+							ScheduleExpression: &admin.Schedule_Rate{
+								Rate: &admin.FixedRate{Value: 1, Unit: admin.FixedRateUnit_MINUTE},
+							},
+						},
+					},
+				},
+			}, nil
+		})
+	backfillManager := NewBackfillManager(repositoryMocks.NewMockRepository(), getMockConfigForBackfillTest(10),
+		launchPlanManager, &managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	_, err := backfillManager.CreateBackfill(context.Background(), managerInterfaces.BackfillCreateRequest{
+		Project:    project,
+		Domain:     domain,
+		LaunchPlan: name,
+		StartTime:  backfillStartTime,
+		EndTime:    backfillStartTime.Add(time.Hour),
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateBackfill_NotScheduled(t *testing.T) {
+	launchPlanManager := managerMocks.NewMockLaunchPlanManager()
+	launchPlanManager.(*managerMocks.MockLaunchPlanManager).SetGetActiveLaunchPlanCallback(
+		func(ctx context.Context, request admin.ActiveLaunchPlanRequest) (*admin.LaunchPlan, error) {
+			return &admin.LaunchPlan{Id: &launchPlanIdentifier, Spec: &admin.LaunchPlanSpec{}}, nil
+		})
+	backfillManager := NewBackfillManager(repositoryMocks.NewMockRepository(), getMockConfigForBackfillTest(10),
+		launchPlanManager, &managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	_, err := backfillManager.CreateBackfill(context.Background(), managerInterfaces.BackfillCreateRequest{
+		Project:    project,
+		Domain:     domain,
+		LaunchPlan: name,
+		StartTime:  backfillStartTime,
+		EndTime:    backfillStartTime.Add(time.Hour),
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestLaunchBackfills(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	backfillRepo.OnListRunningMatch(mock.Anything, maxLaunchedBackfills).Return(
+		[]models.Backfill{getMockBackfill(1, 3)}, nil)
+	var updated models.Backfill
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	}).Return(true, nil)
+	setRunningExecutionsForBackfillTest(repository, getBackfillExecutionName(t, backfillStartTime))
+	executionManager := &managerMocks.MockExecutionManager{}
+	var launchedNames []string
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		launchedNames = append(launchedNames, request.Name)
+		assert.Equal(t, admin.ExecutionMetadata_SCHEDULED, request.Spec.Metadata.Mode)
+		// Already launched by another admin instance.
+		if len(launchedNames) == 2 {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.AlreadyExists, "already exists")
+		}
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10),
+		managerMocks.NewMockLaunchPlanManager(), executionManager, mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.LaunchBackfills(context.Background()))
+	assert.Equal(t, []string{
+		getBackfillExecutionName(t, backfillStartTime.Add(time.Hour)),
+		getBackfillExecutionName(t, backfillStartTime.Add(2*time.Hour)),
+	}, launchedNames)
+	assert.Equal(t, 3, updated.Launched)
+	assert.Equal(t, models.BackfillStateRunning, updated.State)
+}
+
+func TestLaunchBackfills_MaxConcurrency(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	backfillRepo.OnListRunningMatch(mock.Anything, mock.Anything).Return(
+		[]models.Backfill{getMockBackfill(1, 1)}, nil)
+	setRunningExecutionsForBackfillTest(repository, getBackfillExecutionName(t, backfillStartTime))
+	executionManager := &managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		assert.Fail(t, "no more executions may run")
+		return nil, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10),
+		managerMocks.NewMockLaunchPlanManager(), executionManager, mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.LaunchBackfills(context.Background()))
+	backfillRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestLaunchBackfills_Succeeded(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	backfillRepo.OnListRunningMatch(mock.Anything, mock.Anything).Return(
+		[]models.Backfill{getMockBackfill(3, 1)}, nil)
+	var updated models.Backfill
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	}).Return(true, nil)
+	setRunningExecutionsForBackfillTest(repository)
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10),
+		managerMocks.NewMockLaunchPlanManager(), &managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.LaunchBackfills(context.Background()))
+	assert.Equal(t, models.BackfillStateSucceeded, updated.State)
+}
+
+func TestLaunchBackfills_Failed(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	backfillRepo.OnListRunningMatch(mock.Anything, mock.Anything).Return(
+		[]models.Backfill{getMockBackfill(0, 2)}, nil)
+	var updated models.Backfill
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	}).Return(true, nil)
+	setRunningExecutionsForBackfillTest(repository)
+	executionManager := &managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid inputs")
+	})
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10),
+		managerMocks.NewMockLaunchPlanManager(), executionManager, mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.LaunchBackfills(context.Background()))
+	assert.Equal(t, models.BackfillStateFailed, updated.State)
+	assert.Contains(t, updated.Message, "invalid inputs")
+}
+
+func TestCancelBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillRepo := repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface)
+	backfillRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getMockBackfill(2, 2), nil)
+	var updated models.Backfill
+	backfillRepo.OnUpdateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	}).Return(true, nil)
+	runningName := getBackfillExecutionName(t, backfillStartTime.Add(time.Hour))
+	setRunningExecutionsForBackfillTest(repository, runningName)
+	executionManager := &managerMocks.MockExecutionManager{}
+	var terminated []string
+	executionManager.SetTerminateExecutionCallback(func(ctx context.Context,
+		request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
+		terminated = append(terminated, request.Id.Name)
+		assert.Equal(t, "backfill backfill was cancelled", request.Cause)
+		return &admin.ExecutionTerminateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockConfigForBackfillTest(10),
+		managerMocks.NewMockLaunchPlanManager(), executionManager, mockScope.NewTestScope())
+
+	backfill, err := backfillManager.CancelBackfill(context.Background(), managerInterfaces.BackfillIdentifier{
+		Project: project,
+		Domain:  domain,
+		Name:    backfillName,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.BackfillStateCancelled, updated.State)
+	assert.Equal(t, []string{runningName}, terminated)
+	assert.Len(t, backfill.Executions, 2)
+	assert.Equal(t, core.WorkflowExecution_RUNNING.String(), backfill.Executions[1].Phase)
+}
@@ -2,7 +2,6 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -71,7 +70,7 @@ func (d *DescriptionEntityManager) ListDescriptionEntity(ctx context.Context, re
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListWorkflows", request.Token)
@@ -79,6 +78,7 @@ func (d *DescriptionEntityManager) ListDescriptionEntity(ctx context.Context, re
 	listDescriptionEntitiesInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -95,7 +95,7 @@ func (d *DescriptionEntityManager) ListDescriptionEntity(ctx context.Context, re
 	}
 	var token string
 	if len(output.Entities) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(output.Entities))
+		token = util.GetPageToken(listDescriptionEntitiesInput, len(output.Entities), output.Entities[len(output.Entities)-1])
 	}
 	return &admin.DescriptionEntityList{
 		DescriptionEntities: descriptionEntityList,
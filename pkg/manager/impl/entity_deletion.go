@@ -0,0 +1,29 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Returns the principal deleting an entity version, provided entity deletion is enabled and they're one of the
+// configured admins. Callers without an identity, e.g. when auth is off, are never admins.
+func authorizeEntityDeletion(ctx context.Context, config runtimeInterfaces.EntityDeletionConfig) (string, error) {
+	if !config.Enabled {
+		return "", errors.NewFlyteAdminError(codes.PermissionDenied, "entity version deletion is disabled")
+	}
+	identityContext := auth.IdentityContextFromContext(ctx)
+	principal := identityContext.UserID()
+	if len(principal) == 0 {
+		principal = identityContext.AppID()
+	}
+	if len(principal) == 0 || !sets.NewString(config.Admins...).Has(principal) {
+		return "", errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"[%s] is not allowed to delete entity versions", principal)
+	}
+	return principal, nil
+}
@@ -0,0 +1,68 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const entityDeletionAdmin = "admin"
+
+func enableEntityDeletion(config runtimeInterfaces.Configuration, admins ...string) {
+	config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			EntityDeletion: runtimeInterfaces.EntityDeletionConfig{
+				Enabled: true,
+				Admins:  admins,
+			},
+		})
+}
+
+func getEntityDeletionContext(t *testing.T, userID, appID string) context.Context {
+	identity, err := auth.NewIdentityContext("", userID, appID, time.Now(), sets.NewString(), nil, nil)
+	assert.NoError(t, err)
+	return identity.WithContext(context.Background())
+}
+
+func TestAuthorizeEntityDeletion(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		_, err := authorizeEntityDeletion(getEntityDeletionContext(t, entityDeletionAdmin, ""),
+			runtimeInterfaces.EntityDeletionConfig{Admins: []string{entityDeletionAdmin}})
+		assert.Equal(t, codes.PermissionDenied, err.(adminErrors.FlyteAdminError).Code())
+	})
+	t.Run("no admins", func(t *testing.T) {
+		_, err := authorizeEntityDeletion(getEntityDeletionContext(t, "user", ""),
+			runtimeInterfaces.EntityDeletionConfig{Enabled: true})
+		assert.Equal(t, codes.PermissionDenied, err.(adminErrors.FlyteAdminError).Code())
+	})
+	t.Run("anonymous", func(t *testing.T) {
+		_, err := authorizeEntityDeletion(context.Background(),
+			runtimeInterfaces.EntityDeletionConfig{Enabled: true, Admins: []string{""}})
+		assert.Equal(t, codes.PermissionDenied, err.(adminErrors.FlyteAdminError).Code())
+	})
+	t.Run("admin user", func(t *testing.T) {
+		principal, err := authorizeEntityDeletion(getEntityDeletionContext(t, entityDeletionAdmin, "app"),
+			runtimeInterfaces.EntityDeletionConfig{Enabled: true, Admins: []string{entityDeletionAdmin}})
+		assert.NoError(t, err)
+		assert.Equal(t, entityDeletionAdmin, principal)
+	})
+	t.Run("admin app", func(t *testing.T) {
+		principal, err := authorizeEntityDeletion(getEntityDeletionContext(t, "", entityDeletionAdmin),
+			runtimeInterfaces.EntityDeletionConfig{Enabled: true, Admins: []string{entityDeletionAdmin}})
+		assert.NoError(t, err)
+		assert.Equal(t, entityDeletionAdmin, principal)
+	})
+	t.Run("not an admin", func(t *testing.T) {
+		_, err := authorizeEntityDeletion(getEntityDeletionContext(t, "user", ""),
+			runtimeInterfaces.EntityDeletionConfig{Enabled: true, Admins: []string{entityDeletionAdmin}})
+		assert.Equal(t, codes.PermissionDenied, err.(adminErrors.FlyteAdminError).Code())
+	})
+}
@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/benbjohnson/clock"
@@ -56,6 +55,7 @@ type executionSystemMetrics struct {
 	ExecutionEventsCreated     prometheus.Counter
 	PropellerFailures          prometheus.Counter
 	PublishNotificationError   prometheus.Counter
+	RenderNotificationError    prometheus.Counter
 	TransformerError           prometheus.Counter
 	UnexpectedDataError        prometheus.Counter
 	SpecSizeBytes              prometheus.Summary
@@ -1199,6 +1199,8 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 		return nil, err
 	}
 	ctx = getExecutionContext(ctx, request.Event.ExecutionId)
+	// Events are applied to the execution state written by preceding events, which replicas may lag behind.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 	logger.Debugf(ctx, "Received workflow execution event for [%+v] transitioning to phase [%v]",
 		request.Event.ExecutionId, request.Event.Phase)
 
@@ -1245,6 +1247,10 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 			request.Event.ExecutionId, err)
 		return nil, err
 	}
+	executionModel.OutboxEvents, err = util.GetOutboxEvents(m.config.ApplicationConfiguration(), &request)
+	if err != nil {
+		return nil, err
+	}
 	err = m.db.ExecutionRepo().Update(ctx, *executionModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update execution with CreateWorkflowEvent [%+v] with err %v",
@@ -1286,6 +1292,12 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 			return nil, err
 		}
 	}
+	m.publishWebhookNotifications(ctx, request, *executionModel)
+
+	// Events recorded in the outbox are published by the outbox relay.
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventsOutboxConfig().Enabled {
+		return &admin.WorkflowExecutionEventResponse{}, nil
+	}
 
 	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.systemMetrics.PublishEventError.Inc()
@@ -1432,14 +1444,16 @@ func (m *ExecutionManager) ListExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s for ListExecutions",
 			request.Token)
 	}
 	joinTableEntities := make(map[common.Entity]bool)
 	for _, filter := range filters {
-		joinTableEntities[filter.GetEntity()] = true
+		for _, entity := range common.GetFilterEntities(filter) {
+			joinTableEntities[entity] = true
+		}
 	}
 
 	// Check if state filter exists and if not then add filter to fetch only ACTIVE executions
@@ -1450,6 +1464,7 @@ func (m *ExecutionManager) ListExecutions(
 	listExecutionsInput := repositoryInterfaces.ListResourceInput{
 		Limit:             int(request.Limit),
 		Offset:            offset,
+		Cursor:            cursor,
 		InlineFilters:     filters,
 		SortParameter:     sortParameter,
 		JoinTableEntities: joinTableEntities,
@@ -1476,7 +1491,7 @@ func (m *ExecutionManager) ListExecutions(
 	// END TO BE DELETED
 	var token string
 	if len(executionList) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(executionList))
+		token = util.GetPageToken(listExecutionsInput, len(executionList), output.Executions[len(executionList)-1])
 	}
 	return &admin.ExecutionList{
 		Executions: executionList,
@@ -1497,6 +1512,8 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 		return errors.NewFlyteAdminErrorf(codes.Internal, "Failed to transform execution [%+v] with err: %v", request.Event.ExecutionId, err)
 	}
 	var notificationsList = adminExecution.Closure.Notifications
+	// Fetched once, the first time a notification is sent.
+	var overrides map[string]string
 	logger.Debugf(ctx, "publishing notifications for execution [%+v] in state [%+v] for notifications [%+v]",
 		request.Event.ExecutionId, request.Event.Phase, notificationsList)
 	for _, notification := range notificationsList {
@@ -1513,38 +1530,113 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 			continue
 		}
 
-		// Currently all three supported notifications use email underneath to send the notification.
-		// Convert Slack and PagerDuty into an EmailNotification type.
-		var emailNotification admin.EmailNotification
-		if notification.GetEmail() != nil {
-			emailNotification.RecipientsEmail = notification.GetEmail().GetRecipientsEmail()
-		} else if notification.GetPagerDuty() != nil {
-			emailNotification.RecipientsEmail = notification.GetPagerDuty().GetRecipientsEmail()
-		} else if notification.GetSlack() != nil {
-			emailNotification.RecipientsEmail = notification.GetSlack().GetRecipientsEmail()
+		// Slack and PagerDuty notifications are published under their own type when they're delivered natively.
+		notificationsConfig := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+		if overrides == nil {
+			overrides = m.getNotificationTemplateOverrides(ctx, adminExecution)
+		}
+		var notificationType string
+		var message *admin.EmailMessage
+		if notification.GetSlack() != nil && notificationsConfig.NotificationsSlackConfig.Enabled {
+			notificationType = proto.MessageName(notification.GetSlack())
+			message, err = notifications.ToSlackMessageFromWorkflowExecutionEvent(
+				notificationsConfig, *notification.GetSlack(), request, adminExecution, overrides)
+		} else if notification.GetPagerDuty() != nil && notificationsConfig.NotificationsPagerDutyConfig.Enabled {
+			notificationType = proto.MessageName(notification.GetPagerDuty())
+			message, err = notifications.ToPagerDutyMessageFromWorkflowExecutionEvent(
+				notificationsConfig, *notification.GetPagerDuty(), request, adminExecution, overrides)
 		} else {
-			logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
-			m.systemMetrics.UnexpectedDataError.Inc()
-			// Unsupported notification types should have been caught when the launch plan was being created.
-			return errors.NewFlyteAdminErrorf(codes.Internal, "Unsupported notification type [%v] for execution [%+v]",
-				notification.Type, request.Event.ExecutionId)
+			// Otherwise all three supported notifications use email underneath to send the notification.
+			// Convert Slack and PagerDuty into an EmailNotification type.
+			var emailNotification admin.EmailNotification
+			if notification.GetEmail() != nil {
+				emailNotification.RecipientsEmail = notification.GetEmail().GetRecipientsEmail()
+			} else if notification.GetPagerDuty() != nil {
+				emailNotification.RecipientsEmail = notification.GetPagerDuty().GetRecipientsEmail()
+			} else if notification.GetSlack() != nil {
+				emailNotification.RecipientsEmail = notification.GetSlack().GetRecipientsEmail()
+			} else {
+				logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
+				m.systemMetrics.UnexpectedDataError.Inc()
+				// Unsupported notification types should have been caught when the launch plan was being created.
+				return errors.NewFlyteAdminErrorf(codes.Internal, "Unsupported notification type [%v] for execution [%+v]",
+					notification.Type, request.Event.ExecutionId)
+			}
+			notificationType = proto.MessageName(&emailNotification)
+			message, err = notifications.ToEmailMessageFromWorkflowExecutionEvent(
+				notificationsConfig, emailNotification, request, adminExecution, overrides)
 		}
-
-		// Convert the email Notification into an email message to be published.
-		// Currently there are no possible errors while creating an email message.
-		// Once customizable content is specified, errors are possible.
-		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(
-			*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, adminExecution)
-		// Errors seen while publishing a message are considered non-fatal to the method and will not result
-		// in the method returning an error.
-		if err = m.notificationClient.Publish(ctx, proto.MessageName(&emailNotification), email); err != nil {
-			m.systemMetrics.PublishNotificationError.Inc()
-			logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
+		if err != nil {
+			// Templates can be overridden per project, a broken one shouldn't fail the event.
+			m.systemMetrics.RenderNotificationError.Inc()
+			logger.Errorf(ctx, "failed to render %s notification for execution [%+v] with err: %v",
+				notificationType, request.Event.ExecutionId, err)
+			continue
 		}
+		m.publishNotification(ctx, notificationType, message)
 	}
 	return nil
 }
 
+// getNotificationTemplateOverrides returns the cluster resource attributes matching the execution, which can hold
+// overrides of the configured notification templates.
+func (m *ExecutionManager) getNotificationTemplateOverrides(ctx context.Context, execution *admin.Execution) map[string]string {
+	resource, err := m.resourceManager.GetResource(ctx, interfaces.ResourceRequest{
+		Project:      execution.GetId().GetProject(),
+		Domain:       execution.GetId().GetDomain(),
+		Workflow:     execution.GetClosure().GetWorkflowId().GetName(),
+		LaunchPlan:   execution.GetSpec().GetLaunchPlan().GetName(),
+		ResourceType: admin.MatchableResource_CLUSTER_RESOURCE,
+	})
+	if err != nil {
+		if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
+			logger.Warningf(ctx, "failed to get notification template overrides for execution [%+v] with err: %v",
+				execution.GetId(), err)
+		}
+		return map[string]string{}
+	}
+	if resource == nil || resource.Attributes.GetClusterResourceAttributes().GetAttributes() == nil {
+		return map[string]string{}
+	}
+	return resource.Attributes.GetClusterResourceAttributes().GetAttributes()
+}
+
+// publishWebhookNotifications posts the execution phase change to every configured webhook subscribed to the phase.
+// Webhooks are configured by operators rather than in the execution spec, so failures here never fail the event.
+func (m *ExecutionManager) publishWebhookNotifications(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution models.Execution) {
+	webhooks := notifications.GetWebhooksForPhase(
+		*m.config.ApplicationConfiguration().GetNotificationsConfig(), request.Event.Phase)
+	if len(webhooks) == 0 {
+		return
+	}
+	adminExecution, err := transformers.FromExecutionModel(ctx, execution, transformers.DefaultExecutionTransformerOptions)
+	if err != nil {
+		m.systemMetrics.TransformerError.Inc()
+		logger.Errorf(ctx, "failed to transform execution [%+v] for webhooks with err: %v", request.Event.ExecutionId, err)
+		return
+	}
+	for _, webhook := range webhooks {
+		message, err := notifications.ToWebhookMessageFromWorkflowExecutionEvent(webhook, request, adminExecution)
+		if err != nil {
+			m.systemMetrics.TransformerError.Inc()
+			logger.Errorf(ctx, "failed to render webhook [%s] payload for execution [%+v] with err: %v",
+				webhook, request.Event.ExecutionId, err)
+			continue
+		}
+		m.publishNotification(ctx, notifications.WebhookNotificationType, message)
+	}
+}
+
+// Errors seen while publishing a message are considered non-fatal and are only logged and counted.
+func (m *ExecutionManager) publishNotification(ctx context.Context, notificationType string, message *admin.EmailMessage) {
+	if err := m.notificationClient.Publish(ctx, notificationType, message); err != nil {
+		m.systemMetrics.PublishNotificationError.Inc()
+		logger.Infof(ctx, "error publishing %s notification to %v with err: [%v]", notificationType,
+			message.RecipientsEmail, err)
+	}
+}
+
 func (m *ExecutionManager) TerminateExecution(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
@@ -1613,6 +1705,8 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of unexpected data for previously validated objects"),
 		PublishNotificationError: scope.MustNewCounter("publish_error",
 			"overall count of publish notification errors when invoking publish()"),
+		RenderNotificationError: scope.MustNewCounter("render_notification_error",
+			"overall count of errors when rendering notification templates"),
 		SpecSizeBytes:    scope.MustNewSummary("spec_size_bytes", "size in bytes of serialized execution spec"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes", "size in bytes of serialized execution closure"),
 		AcceptanceDelay: scope.MustNewSummary("acceptance_delay",
@@ -1624,6 +1718,144 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 	}
 }
 
+func (m *ExecutionManager) ListExecutionEvents(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if err := validation.ValidateExecutionEventListRequest(request); err != nil {
+		logger.Debugf(ctx, "ListExecutionEvents request [%+v] failed validation with err: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ExecutionID)
+	listInput, err := util.GetExecutionEventListInput(request, common.ExecutionEvent, models.ExecutionEventColumns)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.ExecutionEventRepo().List(ctx, listInput)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list execution events using input [%+v] with err %v", listInput, err)
+		return nil, err
+	}
+	events := make([]interfaces.ExecutionEvent, len(output.ExecutionEvents))
+	for idx, event := range output.ExecutionEvents {
+		events[idx] = interfaces.ExecutionEvent{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: event.Project,
+				Domain:  event.Domain,
+				Name:    event.Name,
+			},
+			RequestID:  event.RequestID,
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+			RecordedAt: event.CreatedAt,
+		}
+	}
+	var token string
+	if len(events) == listInput.Limit {
+		token = util.GetPageToken(listInput, len(events), output.ExecutionEvents[len(events)-1])
+	}
+	return &interfaces.ExecutionEventList{
+		Events: events,
+		Token:  token,
+	}, nil
+}
+
+// Durations of executions are stored in nanoseconds.
+func getDurationPercentiles(aggregate repositoryInterfaces.ExecutionAggregate) *interfaces.ExecutionDurationPercentiles {
+	if aggregate.DurationP50 == nil || aggregate.DurationP90 == nil || aggregate.DurationP99 == nil {
+		return nil
+	}
+	return &interfaces.ExecutionDurationPercentiles{
+		P50: *aggregate.DurationP50 / float64(time.Second),
+		P90: *aggregate.DurationP90 / float64(time.Second),
+		P99: *aggregate.DurationP99 / float64(time.Second),
+	}
+}
+
+func getAggregateGroup(aggregate repositoryInterfaces.ExecutionAggregate,
+	groupBys []repositoryInterfaces.ExecutionGroupBy) map[string]string {
+	if len(groupBys) == 0 {
+		return nil
+	}
+	group := make(map[string]string, len(groupBys))
+	for _, groupBy := range groupBys {
+		switch groupBy {
+		case repositoryInterfaces.ExecutionGroupByPhase:
+			group[string(groupBy)] = aggregate.GroupPhase
+		case repositoryInterfaces.ExecutionGroupByLaunchPlan:
+			group[string(groupBy)] = aggregate.GroupLaunchPlan
+		case repositoryInterfaces.ExecutionGroupByWorkflow:
+			group[string(groupBy)] = aggregate.GroupWorkflow
+		case repositoryInterfaces.ExecutionGroupByUser:
+			group[string(groupBy)] = aggregate.GroupUser
+		case repositoryInterfaces.ExecutionGroupByCluster:
+			group[string(groupBy)] = aggregate.GroupCluster
+		case repositoryInterfaces.ExecutionGroupByErrorKind:
+			group[string(groupBy)] = aggregate.GroupErrorKind
+		case repositoryInterfaces.ExecutionGroupByTime:
+			group[string(groupBy)] = aggregate.GroupTime
+		}
+	}
+	return group
+}
+
+func (m *ExecutionManager) AggregateExecutions(
+	ctx context.Context, request interfaces.ExecutionAggregationRequest) (*interfaces.ExecutionAggregationList, error) {
+	if err := validation.ValidateExecutionAggregationRequest(request); err != nil {
+		logger.Debugf(ctx, "AggregateExecutions request [%+v] failed validation with err: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project:        request.Project,
+		Domain:         request.Domain,
+		RequestFilters: request.Filters,
+	}, common.Execution)
+	if err != nil {
+		return nil, err
+	}
+	joinTableEntities := make(map[common.Entity]bool)
+	for _, filter := range filters {
+		for _, entity := range common.GetFilterEntities(filter) {
+			joinTableEntities[entity] = true
+		}
+	}
+	// As when listing, only ACTIVE executions are aggregated unless filtered by state.
+	if filters, err = addStateFilter(filters); err != nil {
+		return nil, err
+	}
+
+	groupBys := make([]repositoryInterfaces.ExecutionGroupBy, len(request.GroupBy))
+	for idx, groupBy := range request.GroupBy {
+		groupBys[idx] = repositoryInterfaces.ExecutionGroupBy(groupBy)
+	}
+	timeBucket := repositoryInterfaces.ExecutionTimeBucketDay
+	if len(request.TimeBucket) > 0 {
+		timeBucket = repositoryInterfaces.ExecutionTimeBucket(request.TimeBucket)
+	}
+	aggregationInput := repositoryInterfaces.ExecutionAggregationInput{
+		InlineFilters:     filters,
+		JoinTableEntities: joinTableEntities,
+		GroupBy:           groupBys,
+		TimeBucket:        timeBucket,
+		Limit:             int(request.Limit),
+	}
+	output, err := m.db.ExecutionRepo().Aggregate(ctx, aggregationInput)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to aggregate executions using input [%+v] with err %v", aggregationInput, err)
+		return nil, err
+	}
+	aggregates := make([]interfaces.ExecutionAggregate, len(output.Aggregates))
+	for idx, aggregate := range output.Aggregates {
+		aggregates[idx] = interfaces.ExecutionAggregate{
+			Group:     getAggregateGroup(aggregate, groupBys),
+			Count:     aggregate.Count,
+			Durations: getDurationPercentiles(aggregate),
+		}
+	}
+	return &interfaces.ExecutionAggregationList{
+		Aggregates: aggregates,
+	}, nil
+}
+
 func NewExecutionManager(db repositoryInterfaces.Repository, pluginRegistry *plugins.Registry, config runtimeInterfaces.Configuration,
 	storageClient *storage.DataStore, systemScope promutils.Scope, userScope promutils.Scope,
 	publisher notificationInterfaces.Publisher, urlData dataInterfaces.RemoteURLInterface,
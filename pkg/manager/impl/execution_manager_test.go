@@ -2427,6 +2427,56 @@ func TestCreateWorkflowEvent_StartedRunning(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestCreateWorkflowEvent_EventsOutbox(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, closureBytes, nil))
+	occurredAt, _ := ptypes.TimestampProto(time.Now())
+	request := admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			// Marshalling the outbox events mustn't touch the identifier shared with other tests.
+			ExecutionId: proto.Clone(&executionIdentifier).(*core.WorkflowExecutionIdentifier),
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_RUNNING,
+			ProducerId:  testCluster,
+		},
+	}
+	expectedOutboxEvents, err := transformers.CreateOutboxEventModels(
+		[]string{models.OutboxSinkExternalEvents, models.OutboxSinkCloudEvents}, &request)
+	assert.NoError(t, err)
+	var updated bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(context context.Context, execution models.Execution) error {
+			updated = true
+			assert.Equal(t, expectedOutboxEvents, execution.OutboxEvents)
+			return nil
+		})
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+	config := getMockExecutionsConfigProvider()
+	applicationConfig := config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider)
+	applicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		EventsOutbox: runtimeInterfaces.EventsOutboxConfig{Enabled: true},
+	})
+	applicationConfig.SetExternalEventsConfig(runtimeInterfaces.ExternalEventsConfig{Enable: true})
+	applicationConfig.SetCloudEventsConfig(runtimeInterfaces.CloudEventsConfig{Enable: true})
+	var eventPublisher notificationMocks.MockPublisher
+	eventPublisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Fail(t, "events recorded in the outbox shouldn't be published directly")
+		return nil
+	})
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, config, getMockStorageForExecTest(context.Background()),
+		mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil,
+		&eventPublisher, &eventPublisher, mockDbEventWriter)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, updated)
+}
+
 func TestCreateWorkflowEvent_DuplicateRunning(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	occurredAt := time.Now().UTC()
@@ -3037,6 +3087,73 @@ func TestListExecutions(t *testing.T) {
 	assert.Empty(t, executionList.Token)
 }
 
+func TestListExecutions_PageCursor(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	cursor := &common.PageCursor{
+		SortKey:   "created_at",
+		Direction: admin.Sort_DESCENDING,
+		SortValue: testutils.MockCreatedAtValue,
+		ID:        7,
+	}
+	token, err := common.EncodePageCursor(*cursor)
+	assert.NoError(t, err)
+	executionListFunc := func(
+		ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+		assert.Equal(t, cursor, input.Cursor)
+		assert.Equal(t, 0, input.Offset)
+		return interfaces.ExecutionCollectionOutput{
+			Executions: []models.Execution{
+				{
+					BaseModel: models.BaseModel{
+						ID:        6,
+						CreatedAt: testutils.MockCreatedAtValue.Add(-time.Minute),
+					},
+					ExecutionKey: models.ExecutionKey{
+						Project: projectValue,
+						Domain:  domainValue,
+						Name:    "my awesome execution",
+					},
+					Spec:    getExpectedSpecBytes(),
+					Closure: closureBytes,
+				},
+			},
+		}, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	request := admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: projectValue,
+			Domain:  domainValue,
+		},
+		Limit: 1,
+		SortBy: &admin.Sort{
+			Direction: admin.Sort_DESCENDING,
+			Key:       "created_at",
+		},
+		Token: token,
+	}
+	executionList, err := execManager.ListExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, executionList.Executions, 1)
+	nextCursor, err := common.DecodePageCursor(executionList.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, common.PageCursor{
+		SortKey:   "created_at",
+		Direction: admin.Sort_DESCENDING,
+		SortValue: testutils.MockCreatedAtValue.Add(-time.Minute),
+		ID:        6,
+	}, nextCursor)
+
+	// Cursors can't be used to page through results in a different order.
+	request.SortBy.Key = "execution_name"
+	_, err = execManager.ListExecutions(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestListExecutions_MissingParameters(t *testing.T) {
 	r := plugins.NewRegistry()
 	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
@@ -3149,6 +3266,7 @@ func TestExecutionManager_PublishNotifications(t *testing.T) {
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
 		notificationClient: &mockPublisher,
+		resourceManager:    &managerMocks.MockResourceManager{},
 	}
 	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
 	// This sets up the skeleton for it and appeases the go lint overlords.
@@ -3231,6 +3349,7 @@ func TestExecutionManager_PublishNotificationsTransformError(t *testing.T) {
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
 		notificationClient: &mockPublisher,
+		resourceManager:    &managerMocks.MockResourceManager{},
 	}
 
 	workflowRequest := admin.WorkflowExecutionEventRequest{
@@ -3288,6 +3407,7 @@ func TestExecutionManager_TestExecutionManager_PublishNotificationsTransformErro
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
 		notificationClient: &mockPublisher,
+		resourceManager:    &managerMocks.MockResourceManager{},
 	}
 	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
 	// This sets up the skeleton for it and appeases the go lint overlords.
@@ -3342,6 +3462,7 @@ func TestExecutionManager_PublishNotificationsNoPhaseMatch(t *testing.T) {
 		_clock:             clock.New(),
 		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
 		notificationClient: &mockPublisher,
+		resourceManager:    &managerMocks.MockResourceManager{},
 	}
 	// Currently this doesn't do anything special as the code to invoke pushing to SNS isn't enabled yet.
 	// This sets up the skeleton for it and appeases the go lint overlords.
@@ -3372,6 +3493,330 @@ func TestExecutionManager_PublishNotificationsNoPhaseMatch(t *testing.T) {
 	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
 }
 
+func TestExecutionManager_PublishNotificationsNativeSenders(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	queue := executions.NewQueueAllocator(getMockExecutionsConfigProvider(), repository)
+	published := make(map[string]*admin.EmailMessage)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published[key] = msg.(*admin.EmailMessage)
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject: "{{ name }} has {{ phase }}",
+			Body:    "email body",
+		},
+		NotificationsSlackConfig: runtimeInterfaces.NotificationsSlackConfig{
+			Enabled: true,
+			Body:    "slack body",
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	var myExecManager = &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		storageClient:      getMockStorageForExecTest(context.Background()),
+		queueAllocator:     queue,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+		resourceManager:    &managerMocks.MockResourceManager{},
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	failed := []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED}
+	execClosure := admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "wf_project",
+			Domain:       "wf_domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+		Notifications: []*admin.Notification{
+			{
+				Phases: failed,
+				Type: &admin.Notification_Slack{
+					Slack: &admin.SlackNotification{RecipientsEmail: []string{"#alerts"}},
+				},
+			},
+			{
+				Phases: failed,
+				Type: &admin.Notification_PagerDuty{
+					PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: []string{"oncall@example.com"}},
+				},
+			},
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    getExpectedSpecBytes(),
+	}
+	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+	assert.Len(t, published, 2)
+	// Slack notifications are delivered natively.
+	slackMessage := published["flyteidl.admin.SlackNotification"]
+	assert.Equal(t, []string{"#alerts"}, slackMessage.RecipientsEmail)
+	assert.Equal(t, "name has failed", slackMessage.SubjectLine)
+	assert.Equal(t, "slack body", slackMessage.Body)
+	// PagerDuty notifications still fall back to email.
+	pagerDutyMessage := published["flyteidl.admin.EmailNotification"]
+	assert.Equal(t, []string{"oncall@example.com"}, pagerDutyMessage.RecipientsEmail)
+	assert.Equal(t, "email body", pagerDutyMessage.Body)
+}
+
+func TestExecutionManager_PublishNotificationsTemplateOverrides(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	published := make(map[string]*admin.EmailMessage)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published[key] = msg.(*admin.EmailMessage)
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		TemplateEngine: "gotemplate",
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject: "{{ .Name }} has {{ .Phase }}",
+			Body:    "email body",
+		},
+		NotificationsSlackConfig: runtimeInterfaces.NotificationsSlackConfig{
+			Enabled: true,
+			Body:    "slack body",
+		},
+		TemplateOverrides: []runtimeInterfaces.NotificationTemplateOverrideConfig{
+			{
+				Project: "project",
+				Slack:   runtimeInterfaces.NotificationTemplatesConfig{Body: "unused"},
+			},
+			{
+				Project:  "project",
+				Domain:   "domain",
+				Workflow: "wf_name",
+				Slack:    runtimeInterfaces.NotificationTemplatesConfig{Body: "{{ .Project }} slack body"},
+				Email:    runtimeInterfaces.NotificationTemplatesConfig{Subject: "{{ .Missing }}"},
+			},
+			{
+				Project: "other",
+				Slack:   runtimeInterfaces.NotificationTemplatesConfig{Body: "unused"},
+			},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	getResourceCalls := 0
+	resourceManager := managerMocks.MockResourceManager{}
+	resourceManager.GetResourceFunc = func(ctx context.Context,
+		request managerInterfaces.ResourceRequest) (*managerInterfaces.ResourceResponse, error) {
+		getResourceCalls++
+		assert.EqualValues(t, managerInterfaces.ResourceRequest{
+			Project:      "project",
+			Domain:       "domain",
+			Workflow:     "wf_name",
+			LaunchPlan:   "name",
+			ResourceType: admin.MatchableResource_CLUSTER_RESOURCE,
+		}, request)
+		return &managerInterfaces.ResourceResponse{
+			Attributes: &admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_ClusterResourceAttributes{
+					ClusterResourceAttributes: &admin.ClusterResourceAttributes{
+						Attributes: map[string]string{
+							"notifications.slack.subject": "{{ .Project }} has {{ .Phase }}",
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	var myExecManager = &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		_clock:             clock.New(),
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+		resourceManager:    &resourceManager,
+	}
+	workflowRequest := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_FAILED,
+			ExecutionId: &executionIdentifier,
+		},
+	}
+	failed := []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED}
+	execClosure := admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "wf_project",
+			Domain:       "wf_domain",
+			Name:         "wf_name",
+			Version:      "wf_version",
+		},
+		Notifications: []*admin.Notification{
+			{
+				Phases: failed,
+				Type: &admin.Notification_Slack{
+					Slack: &admin.SlackNotification{RecipientsEmail: []string{"#alerts"}},
+				},
+			},
+			{
+				Phases: failed,
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"a@example.com"}},
+				},
+			},
+		},
+	}
+	execClosureBytes, _ := proto.Marshal(&execClosure)
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_FAILED.String(),
+		Closure: execClosureBytes,
+		Spec:    getExpectedSpecBytes(),
+	}
+	assert.Nil(t, myExecManager.publishNotifications(context.Background(), workflowRequest, executionModel))
+	assert.Equal(t, 1, getResourceCalls)
+	// The email subject override fails to render, so only the slack notification is published.
+	assert.Len(t, published, 1)
+	slackMessage := published["flyteidl.admin.SlackNotification"]
+	// The subject is overridden by the matching attributes, and the body by the configured overrides.
+	assert.Equal(t, "project has failed", slackMessage.SubjectLine)
+	assert.Equal(t, "project slack body", slackMessage.Body)
+}
+
+func TestExecutionManager_GetNotificationTemplateOverrides(t *testing.T) {
+	execution := &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+	}
+	for _, tc := range []struct {
+		name     string
+		response *managerInterfaces.ResourceResponse
+		err      error
+		expected map[string]string
+	}{
+		{
+			name: "attributes",
+			response: &managerInterfaces.ResourceResponse{
+				Attributes: &admin.MatchingAttributes{
+					Target: &admin.MatchingAttributes_ClusterResourceAttributes{
+						ClusterResourceAttributes: &admin.ClusterResourceAttributes{
+							Attributes: map[string]string{"notifications.email.body": "body"},
+						},
+					},
+				},
+			},
+			expected: map[string]string{"notifications.email.body": "body"},
+		},
+		{
+			name:     "not found",
+			err:      flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found"),
+			expected: map[string]string{},
+		},
+		{
+			name:     "error",
+			err:      flyteAdminErrors.NewFlyteAdminErrorf(codes.Internal, "error"),
+			expected: map[string]string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceManager := managerMocks.MockResourceManager{}
+			resourceManager.GetResourceFunc = func(ctx context.Context,
+				request managerInterfaces.ResourceRequest) (*managerInterfaces.ResourceResponse, error) {
+				assert.Equal(t, "project", request.Project)
+				assert.Equal(t, "domain", request.Domain)
+				return tc.response, tc.err
+			}
+			execManager := &ExecutionManager{resourceManager: &resourceManager}
+			assert.Equal(t, tc.expected,
+				execManager.getNotificationTemplateOverrides(context.Background(), execution))
+		})
+	}
+}
+
+func TestExecutionManager_PublishWebhookNotifications(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var published []*admin.EmailMessage
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "webhook", key)
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+	mockApplicationConfig := runtimeMocks.MockApplicationProvider{}
+	mockApplicationConfig.SetNotificationsConfig(runtimeInterfaces.NotificationsConfig{
+		NotificationsWebhookConfig: runtimeInterfaces.NotificationsWebhookConfig{
+			Webhooks: []runtimeInterfaces.WebhookConfig{
+				{Name: "terminal", URL: "http://localhost/terminal"},
+				{Name: "running", URL: "http://localhost/running", Phases: []string{"RUNNING"}},
+			},
+		},
+	})
+	mockRuntime := runtimeMocks.NewMockConfigurationProvider(
+		&mockApplicationConfig,
+		runtimeMocks.NewMockQueueConfigurationProvider(
+			[]runtimeInterfaces.ExecutionQueue{}, []runtimeInterfaces.WorkflowConfig{}),
+		nil, nil, nil, nil)
+	var myExecManager = &ExecutionManager{
+		db:                 repository,
+		config:             mockRuntime,
+		systemMetrics:      newExecutionSystemMetrics(mockScope.NewTestScope()),
+		notificationClient: &publisher,
+	}
+	execClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{Phase: core.WorkflowExecution_RUNNING})
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:   core.WorkflowExecution_RUNNING.String(),
+		Closure: execClosureBytes,
+		Spec:    getExpectedSpecBytes(),
+	}
+	myExecManager.publishWebhookNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_RUNNING,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel)
+	assert.Len(t, published, 1)
+	assert.Equal(t, []string{"running"}, published[0].RecipientsEmail)
+	assert.Contains(t, published[0].Body, `"phase":"RUNNING"`)
+
+	// Execution models which can't be transformed don't fail the event.
+	executionModel.Spec = []byte("I am invalid")
+	myExecManager.publishWebhookNotifications(context.Background(), admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+			ExecutionId: &executionIdentifier,
+		},
+	}, executionModel)
+	assert.Len(t, published, 1)
+}
+
 func TestTerminateExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
@@ -5687,3 +6132,138 @@ func TestAddStateFilter(t *testing.T) {
 	})
 
 }
+
+func TestListExecutionEvents(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	executionEventRepo := repositoryMocks.ExecutionEventRepoInterface{}
+	repository.(*repositoryMocks.MockRepository).ExecutionEventRepoIface = &executionEventRepo
+	occurredAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	executionEventRepo.OnListMatch(mock.Anything, mock.MatchedBy(func(input interfaces.ListResourceInput) bool {
+		var queries []string
+		for _, filter := range input.InlineFilters {
+			expr, _ := filter.GetGormQueryExpr()
+			queries = append(queries, expr.Query)
+		}
+		return input.Limit == 2 && input.Offset == 4 &&
+			assert.ObjectsAreEqual([]string{"execution_project = ?", "execution_domain = ?", "execution_name = ?",
+				"phase = ?"}, queries) &&
+			input.SortParameter.GetGormOrderExpr() == "occurred_at asc"
+	})).Return(interfaces.ExecutionEventCollectionOutput{
+		ExecutionEvents: []models.ExecutionEvent{
+			{
+				ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+				RequestID:    "request id",
+				OccurredAt:   occurredAt,
+				Phase:        core.WorkflowExecution_RUNNING.String(),
+			},
+			{
+				ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+				OccurredAt:   occurredAt.Add(time.Minute),
+				Phase:        core.WorkflowExecution_RUNNING.String(),
+			},
+		},
+	}, nil)
+
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	events, err := execManager.ListExecutionEvents(context.Background(), managerInterfaces.ExecutionEventListRequest{
+		ExecutionID: &executionIdentifier,
+		Limit:       2,
+		Token:       "4",
+		Filters:     "eq(phase,RUNNING)",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 2)
+	// The next page is listed from the last event rather than from the legacy offset token.
+	expectedToken, err := common.EncodePageCursor(common.PageCursor{
+		SortKey:   "occurred_at",
+		Direction: admin.Sort_ASCENDING,
+		SortValue: occurredAt.Add(time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedToken, events.Token)
+	assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		events.Events[0].ExecutionID))
+	assert.Equal(t, "request id", events.Events[0].RequestID)
+	assert.Equal(t, occurredAt, events.Events[0].OccurredAt)
+
+	_, err = execManager.ListExecutionEvents(context.Background(), managerInterfaces.ExecutionEventListRequest{
+		ExecutionID: &executionIdentifier,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestAggregateExecutions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	p50, p90, p99 := float64(30*time.Second), float64(90*time.Second), float64(2*time.Minute)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetAggregateCallback(
+		func(ctx context.Context, input interfaces.ExecutionAggregationInput) (
+			interfaces.ExecutionAggregationOutput, error) {
+			var queries []string
+			for _, filter := range input.InlineFilters {
+				expr, _ := filter.GetGormQueryExpr()
+				queries = append(queries, expr.Query)
+			}
+			assert.Equal(t, []string{"execution_project = ?", "execution_domain = ?", "name = ?", "state = ?"},
+				queries)
+			assert.True(t, input.JoinTableEntities[common.LaunchPlan])
+			assert.Equal(t, []interfaces.ExecutionGroupBy{
+				interfaces.ExecutionGroupByPhase, interfaces.ExecutionGroupByTime}, input.GroupBy)
+			assert.Equal(t, interfaces.ExecutionTimeBucketDay, input.TimeBucket)
+			assert.Equal(t, 10, input.Limit)
+			return interfaces.ExecutionAggregationOutput{
+				Aggregates: []interfaces.ExecutionAggregate{
+					{
+						GroupPhase:  core.WorkflowExecution_SUCCEEDED.String(),
+						GroupTime:   "2023-01-02T00:00:00Z",
+						Count:       3,
+						DurationP50: &p50,
+						DurationP90: &p90,
+						DurationP99: &p99,
+					},
+					{
+						GroupPhase: core.WorkflowExecution_RUNNING.String(),
+						GroupTime:  "2023-01-02T00:00:00Z",
+						Count:      1,
+					},
+				},
+			}, nil
+		})
+
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	aggregates, err := execManager.AggregateExecutions(context.Background(),
+		managerInterfaces.ExecutionAggregationRequest{
+			Project: "project",
+			Domain:  "domain",
+			Filters: "eq(launch_plan.name,lp)",
+			GroupBy: []string{"phase", "time"},
+			Limit:   10,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []managerInterfaces.ExecutionAggregate{
+		{
+			Group: map[string]string{"phase": "SUCCEEDED", "time": "2023-01-02T00:00:00Z"},
+			Count: 3,
+			Durations: &managerInterfaces.ExecutionDurationPercentiles{
+				P50: 30,
+				P90: 90,
+				P99: 120,
+			},
+		},
+		{
+			Group: map[string]string{"phase": "RUNNING", "time": "2023-01-02T00:00:00Z"},
+			Count: 1,
+		},
+	}, aggregates.Aggregates)
+
+	_, err = execManager.AggregateExecutions(context.Background(), managerInterfaces.ExecutionAggregationRequest{
+		Project: "project",
+		Domain:  "domain",
+		GroupBy: []string{"node"},
+		Limit:   10,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
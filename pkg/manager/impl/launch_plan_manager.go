@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -24,8 +25,15 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerIdentifier "github.com/flyteorg/flyteadmin/scheduler/identifier"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteadmin/scheduler/snapshoter"
 )
 
+// The most recent executions of a schedule described, which are looked up at once.
+const maxScheduleHistory = 1000
+
 type launchPlanMetrics struct {
 	Scope                 promutils.Scope
 	FailedScheduleUpdates prometheus.Counter
@@ -104,6 +112,7 @@ func (m *LaunchPlanManager) CreateLaunchPlan(
 			request, workflowInterface.Outputs, err)
 		return nil, err
 	}
+	launchPlanModel.SearchDocument = transformers.CreateLaunchPlanSearchDocument(launchPlan)
 	err = m.db.LaunchPlanRepo().Create(ctx, launchPlanModel)
 	if err != nil {
 		logger.Errorf(ctx, "Failed to save launch plan model %+v with err: %v", request.Id, err)
@@ -264,6 +273,11 @@ func (m *LaunchPlanManager) disableLaunchPlan(ctx context.Context, request admin
 
 func (m *LaunchPlanManager) enableLaunchPlan(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
 	*admin.LaunchPlanUpdateResponse, error) {
+	// The schedules of archived projects remain disabled until they're unarchived.
+	if err := validation.ValidateProjectForUpdate(ctx, m.db, request.Id.Project); err != nil {
+		logger.Debugf(ctx, "can't enable launch plan [%+v] of inactive project with err: %v", request.Id, err)
+		return nil, err
+	}
 	newlyActiveLaunchPlanModel, err := m.db.LaunchPlanRepo().Get(ctx, repoInterfaces.Identifier{
 		Project: request.Id.Project,
 		Domain:  request.Id.Domain,
@@ -410,7 +424,7 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request admin.R
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListLaunchPlans", request.Token)
@@ -418,6 +432,7 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request admin.R
 	listLaunchPlansInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -435,7 +450,7 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request admin.R
 	}
 	var token string
 	if len(output.LaunchPlans) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(output.LaunchPlans))
+		token = util.GetPageToken(listLaunchPlansInput, len(output.LaunchPlans), output.LaunchPlans[len(output.LaunchPlans)-1])
 	}
 	return &admin.LaunchPlanList{
 		LaunchPlans: launchPlanList,
@@ -463,7 +478,7 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request a
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListActiveLaunchPlans", request.Token)
@@ -471,6 +486,7 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request a
 	listLaunchPlansInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -488,7 +504,7 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request a
 	}
 	var token string
 	if len(output.LaunchPlans) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(output.LaunchPlans))
+		token = util.GetPageToken(listLaunchPlansInput, len(output.LaunchPlans), output.LaunchPlans[len(output.LaunchPlans)-1])
 	}
 	return &admin.LaunchPlanList{
 		LaunchPlans: launchPlanList,
@@ -540,6 +556,197 @@ func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request admin
 	}, nil
 }
 
+// Hard deletes a launch plan version, provided no running executions were launched from it. The schedule of an active
+// version is removed first.
+func (m *LaunchPlanManager) DeleteLaunchPlan(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	principal, err := authorizeEntityDeletion(
+		ctx, m.config.ApplicationConfiguration().GetTopLevelConfig().GetEntityDeletionConfig())
+	if err != nil {
+		return err
+	}
+	if err := validation.ValidateIdentifier(&request.ID, common.LaunchPlan); err != nil {
+		return err
+	}
+	ctx = getLaunchPlanContext(ctx, &request.ID)
+	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, request.ID)
+	if err != nil {
+		return err
+	}
+	if err := util.ValidateNoRunningExecutions(ctx, m.db, request.ID, "launch_plan_id", launchPlanModel.ID); err != nil {
+		return err
+	}
+	if launchPlanModel.State != nil && *launchPlanModel.State == int32(admin.LaunchPlanState_ACTIVE) {
+		var launchPlanSpec admin.LaunchPlanSpec
+		if err = proto.Unmarshal(launchPlanModel.Spec, &launchPlanSpec); err != nil {
+			logger.Errorf(ctx, "failed to unmarshal launch plan spec when deleting %+v", request.ID)
+			return errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to unmarshal launch plan spec when deleting %+v", request.ID)
+		}
+		if !isScheduleEmpty(launchPlanSpec) {
+			err = m.disableSchedule(ctx, core.Identifier{
+				Project: launchPlanModel.Project,
+				Domain:  launchPlanModel.Domain,
+				Name:    launchPlanModel.Name,
+				Version: launchPlanModel.Version,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = m.db.LaunchPlanRepo().Delete(ctx, repoInterfaces.Identifier{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+		Version: request.ID.Version,
+	}, util.NewDeletionAuditEntry(request.ID, principal, request.Reason))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to delete launch plan [%+v] with err: %v", request.ID, err)
+		return err
+	}
+	logger.Infof(ctx, "[%s] deleted launch plan [%+v]: %s", principal, request.ID, request.Reason)
+	return nil
+}
+
+// Returns the last time the native scheduler recorded executing the schedule at in its snapshot, if any.
+func (m *LaunchPlanManager) getScheduleLastExecutionTime(ctx context.Context,
+	schedule schedulerModels.SchedulableEntity) (*time.Time, error) {
+	snapshotModel, err := m.db.ScheduleEntitiesSnapshotRepo().Read(ctx)
+	if err != nil {
+		if flyteAdminError, ok := err.(errors.FlyteAdminError); ok && flyteAdminError.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	snapshot, err := (&snapshoter.VersionedSnapshot{}).ReadSnapshot(bytes.NewReader(snapshotModel.Snapshot))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to read the scheduler snapshot with err: %v", err)
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the scheduler snapshot: %v", err)
+	}
+	return snapshot.GetLastExecutionTime(schedulerIdentifier.GetScheduleName(ctx, schedule)), nil
+}
+
+// Returns the executions the native scheduler launched, or would have, for the launch plan at the scheduled times.
+func (m *LaunchPlanManager) getScheduledExecutions(ctx context.Context, identifier core.Identifier,
+	scheduledTimes []time.Time) ([]interfaces.ScheduledExecution, error) {
+	scheduledExecutions := make([]interfaces.ScheduledExecution, len(scheduledTimes))
+	names := make([]string, len(scheduledTimes))
+	for idx, scheduledTime := range scheduledTimes {
+		name, err := schedulerIdentifier.GetExecutionName(ctx, identifier, scheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		names[idx] = name
+		scheduledExecutions[idx] = interfaces.ScheduledExecution{
+			ScheduledTime: scheduledTime,
+			ExecutionID: core.WorkflowExecutionIdentifier{
+				Project: identifier.Project,
+				Domain:  identifier.Domain,
+				Name:    name,
+			},
+		}
+	}
+	executions, err := util.ListExecutionsByName(ctx, m.db, identifier.Project, identifier.Domain, names, nil)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the scheduled executions of [%+v] with err: %v", identifier, err)
+		return nil, err
+	}
+	phases := make(map[string]string, len(executions))
+	for _, execution := range executions {
+		phases[execution.Name] = execution.Phase
+	}
+	for idx := range scheduledExecutions {
+		scheduledExecutions[idx].Phase = phases[scheduledExecutions[idx].ExecutionID.Name]
+	}
+	return scheduledExecutions, nil
+}
+
+// GetLaunchPlanSchedule describes the native scheduler schedule of the launch plan, with its upcoming scheduled times
+// and recent executions.
+func (m *LaunchPlanManager) GetLaunchPlanSchedule(ctx context.Context, request interfaces.LaunchPlanScheduleRequest) (
+	*interfaces.LaunchPlanSchedule, error) {
+	if err := validation.ValidateLaunchPlanScheduleRequest(request); err != nil {
+		logger.Debugf(ctx, "can't get the schedule of launch plan [%+v] with invalid request: %v", request.ID, err)
+		return nil, err
+	}
+	identifier := request.ID
+	if len(identifier.Version) == 0 {
+		activeLaunchPlan, err := m.GetActiveLaunchPlan(ctx, admin.ActiveLaunchPlanRequest{
+			Id: &admin.NamedEntityIdentifier{
+				Project: identifier.Project,
+				Domain:  identifier.Domain,
+				Name:    identifier.Name,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		identifier.Version = activeLaunchPlan.Id.Version
+	}
+	identifier.ResourceType = core.ResourceType_LAUNCH_PLAN
+	ctx = getLaunchPlanContext(ctx, &identifier)
+
+	schedule, err := m.db.SchedulableEntityRepo().Get(ctx, schedulerModels.SchedulableEntityKey{
+		Project: identifier.Project,
+		Domain:  identifier.Domain,
+		Name:    identifier.Name,
+		Version: identifier.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lastExecTime, err := m.getScheduleLastExecutionTime(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cron schedules without a time zone are evaluated in the time zone of the scheduler.
+	now := time.Now()
+	workflowExecutorConfig := m.config.ApplicationConfiguration().GetSchedulerConfig().GetWorkflowExecutorConfig()
+	if executorConfig := workflowExecutorConfig.GetFlyteWorkflowExecutorConfig(); executorConfig != nil &&
+		executorConfig.GetUseUTCTz() {
+		now = now.UTC()
+	}
+	launchPlanSchedule := &interfaces.LaunchPlanSchedule{
+		ID:                 identifier,
+		Active:             schedule.Active != nil && *schedule.Active,
+		CronExpression:     schedule.CronExpression,
+		LastExecutionTime:  lastExecTime,
+		NextExecutionTimes: []time.Time{},
+		History:            []interfaces.ScheduledExecution{},
+	}
+	if len(schedule.CronExpression) == 0 {
+		launchPlanSchedule.FixedRateValue = schedule.FixedRateValue
+		launchPlanSchedule.FixedRateUnit = schedule.Unit.String()
+	}
+	if launchPlanSchedule.Active && request.NextCount > 0 {
+		// As when the scheduler starts, fixed rate schedules are executed at intervals from their last execution or
+		// otherwise their activation.
+		reference := lastExecTime
+		if reference == nil || reference.Before(schedule.UpdatedAt) {
+			reference = &schedule.UpdatedAt
+		}
+		nextTimes, err := schedulerCore.GetNextScheduledTimes(schedule, reference, now, request.NextCount)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to compute the scheduled times: %v", err)
+		}
+		launchPlanSchedule.NextExecutionTimes = nextTimes
+	}
+	if lastExecTime != nil && request.HistoryWindow > 0 {
+		executedTimes, err := schedulerCore.GetExecutedTimes(schedule, now.Add(-request.HistoryWindow), *lastExecTime)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to compute the executed times: %v", err)
+		}
+		if len(executedTimes) > maxScheduleHistory {
+			executedTimes = executedTimes[len(executedTimes)-maxScheduleHistory:]
+		}
+		if launchPlanSchedule.History, err = m.getScheduledExecutions(ctx, identifier, executedTimes); err != nil {
+			return nil, err
+		}
+	}
+	return launchPlanSchedule, nil
+}
+
 func NewLaunchPlanManager(
 	db repoInterfaces.Repository,
 	config runtimeInterfaces.Configuration,
@@ -1,6 +1,7 @@
 package impl
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -25,11 +27,16 @@ import (
 
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	schedulerIdentifier "github.com/flyteorg/flyteadmin/scheduler/identifier"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteadmin/scheduler/snapshoter"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 )
 
@@ -979,6 +986,26 @@ func TestEnableLaunchPlan_NoCurrentlyActiveVersion(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEnableLaunchPlan_ArchivedProject(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	archived := int32(admin.Project_ARCHIVED)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: projectID, State: &archived}, nil
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(
+		func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+			assert.Fail(t, "No launch plans were expected to be enabled")
+			return nil
+		})
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
+		Id:    &launchPlanIdentifier,
+		State: admin.LaunchPlanState_ACTIVE,
+	})
+	assert.EqualError(t, err, fmt.Sprintf("project [%s] is not active", project))
+}
+
 func TestEnableLaunchPlan_DatabaseError(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 	expectedError := errors.New("expected error")
@@ -1410,3 +1437,187 @@ func TestIsScheduleEmpty(t *testing.T) {
 		assert.True(t, isScheduleEmpty(sp))
 	})
 }
+
+func TestDeleteLaunchPlan(t *testing.T) {
+	launchPlanSpec := admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_Rate{
+					Rate: &admin.FixedRate{
+						Value: 2,
+						Unit:  admin.FixedRateUnit_HOUR,
+					},
+				},
+			},
+		},
+	}
+	launchPlanSpecBytes, _ := proto.Marshal(&launchPlanSpec)
+	repository := getMockRepositoryForLpTest()
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				BaseModel: models.BaseModel{ID: 5},
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:  launchPlanSpecBytes,
+				State: &active,
+			}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+			expr, _ := input.InlineFilters[0].GetGormQueryExpr()
+			assert.Equal(t, "launch_plan_id = ?", expr.Query)
+			assert.Equal(t, uint(5), expr.Args)
+			return 0, nil
+		})
+	var deleted bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			deleted = true
+			assert.Equal(t, version, input.Version)
+			assert.Equal(t, core.ResourceType_LAUNCH_PLAN, auditEntry.ResourceType)
+			assert.Equal(t, "superseded", auditEntry.Reason)
+			return nil
+		})
+	mockScheduler := mocks.NewMockEventScheduler()
+	var removeCalled bool
+	mockScheduler.(*mocks.MockEventScheduler).SetRemoveScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.RemoveScheduleInput) error {
+			assert.True(t, proto.Equal(&launchPlanNamedIdentifier, &input.Identifier))
+			removeCalled = true
+			return nil
+		})
+	config := getMockConfigForLpTest()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	lpManager := NewLaunchPlanManager(repository, config, mockScheduler, mockScope.NewTestScope())
+	ctx := getEntityDeletionContext(t, entityDeletionAdmin, "")
+	err := lpManager.DeleteLaunchPlan(ctx, managerInterfaces.EntityDeleteRequest{
+		ID:     launchPlanIdentifier,
+		Reason: "superseded",
+	})
+	assert.NoError(t, err)
+	assert.True(t, removeCalled)
+	assert.True(t, deleted)
+}
+
+func TestDeleteLaunchPlan_RunningExecutions(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+			return 1, nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			assert.Fail(t, "launch plans with running executions shouldn't be deleted")
+			return nil
+		})
+	config := getMockConfigForLpTest()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	lpManager := NewLaunchPlanManager(repository, config, mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+	ctx := getEntityDeletionContext(t, entityDeletionAdmin, "")
+	err := lpManager.DeleteLaunchPlan(ctx, managerInterfaces.EntityDeleteRequest{
+		ID: launchPlanIdentifier,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteLaunchPlan_Disabled(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpManager := NewLaunchPlanManager(
+		repository, getMockConfigForLpTest(), mocks.NewMockEventScheduler(), mockScope.NewTestScope())
+	err := lpManager.DeleteLaunchPlan(context.Background(), managerInterfaces.EntityDeleteRequest{
+		ID: launchPlanIdentifier,
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestLaunchPlanManager_GetLaunchPlanSchedule(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	now := time.Now().Truncate(time.Second)
+	lastExecTime := now.Add(-30 * time.Minute)
+	isActive := true
+	schedule := schedulerModels.SchedulableEntity{
+		BaseModel: models.BaseModel{
+			UpdatedAt: now.Add(-48 * time.Hour),
+		},
+		SchedulableEntityKey: schedulerModels.SchedulableEntityKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: version,
+		},
+		FixedRateValue: 1,
+		Unit:           admin.FixedRateUnit_HOUR,
+		Active:         &isActive,
+	}
+	repository.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface).OnGetMatch(
+		mock.Anything, schedule.SchedulableEntityKey).Return(schedule, nil)
+
+	snapshot := &snapshoter.SnapshotV1{LastTimes: map[string]*time.Time{
+		schedulerIdentifier.GetScheduleName(context.Background(), schedule): &lastExecTime,
+	}}
+	var snapshotBytes bytes.Buffer
+	assert.NoError(t, (&snapshoter.VersionedSnapshot{}).WriteSnapshot(&snapshotBytes, snapshot))
+	repository.ScheduleEntitiesSnapshotRepo().(*schedMocks.ScheduleEntitiesSnapShotRepoInterface).OnReadMatch(
+		mock.Anything).Return(schedulerModels.ScheduleEntitiesSnapshot{Snapshot: snapshotBytes.Bytes()}, nil)
+
+	lastExecutionName, err := schedulerIdentifier.GetExecutionName(context.Background(), launchPlanIdentifier,
+		lastExecTime)
+	assert.NoError(t, err)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, 3, len(input.InlineFilters))
+			return interfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{
+					{
+						ExecutionKey: models.ExecutionKey{
+							Project: project,
+							Domain:  domain,
+							Name:    lastExecutionName,
+						},
+						Phase: core.WorkflowExecution_SUCCEEDED.String(),
+					},
+				},
+			}, nil
+		})
+
+	launchPlanSchedule, err := lpManager.GetLaunchPlanSchedule(context.Background(),
+		managerInterfaces.LaunchPlanScheduleRequest{
+			ID:            launchPlanIdentifier,
+			NextCount:     3,
+			HistoryWindow: 2 * time.Hour,
+		})
+	assert.NoError(t, err)
+	assert.True(t, launchPlanSchedule.Active)
+	assert.Equal(t, uint32(1), launchPlanSchedule.FixedRateValue)
+	assert.Equal(t, admin.FixedRateUnit_HOUR.String(), launchPlanSchedule.FixedRateUnit)
+	assert.True(t, lastExecTime.Equal(*launchPlanSchedule.LastExecutionTime))
+	assert.Len(t, launchPlanSchedule.NextExecutionTimes, 3)
+	for idx, nextTime := range launchPlanSchedule.NextExecutionTimes {
+		assert.True(t, lastExecTime.Add(time.Duration(idx+1)*time.Hour).Equal(nextTime))
+	}
+	assert.Len(t, launchPlanSchedule.History, 2)
+	assert.True(t, lastExecTime.Add(-time.Hour).Equal(launchPlanSchedule.History[0].ScheduledTime))
+	assert.Empty(t, launchPlanSchedule.History[0].Phase)
+	assert.True(t, lastExecTime.Equal(launchPlanSchedule.History[1].ScheduledTime))
+	assert.Equal(t, lastExecutionName, launchPlanSchedule.History[1].ExecutionID.Name)
+	assert.Equal(t, core.WorkflowExecution_SUCCEEDED.String(), launchPlanSchedule.History[1].Phase)
+}
+
+func TestLaunchPlanManager_GetLaunchPlanSchedule_NotScheduled(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	repository.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface).OnGetMatch(
+		mock.Anything, mock.Anything).Return(schedulerModels.SchedulableEntity{},
+		flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found"))
+
+	_, err := lpManager.GetLaunchPlanSchedule(context.Background(), managerInterfaces.LaunchPlanScheduleRequest{
+		ID: launchPlanIdentifier,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
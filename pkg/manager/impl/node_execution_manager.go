@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -18,6 +17,7 @@ import (
 
 	cloudeventInterfaces "github.com/flyteorg/flyteadmin/pkg/async/cloudevent/interfaces"
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
@@ -43,6 +43,7 @@ type nodeExecutionMetrics struct {
 	NodeExecutionInputBytes    prometheus.Summary
 	NodeExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
 }
 
 type NodeExecutionManager struct {
@@ -52,6 +53,7 @@ type NodeExecutionManager struct {
 	storageClient       *storage.DataStore
 	metrics             nodeExecutionMetrics
 	urlData             dataInterfaces.RemoteURLInterface
+	notificationClient  notificationInterfaces.Publisher
 	eventPublisher      notificationInterfaces.Publisher
 	cloudEventPublisher cloudeventInterfaces.Publisher
 	dbEventWriter       eventWriter.NodeExecutionEventWriter
@@ -112,6 +114,10 @@ func (m *NodeExecutionManager) createNodeExecutionWithEvent(
 			request.RequestId, err)
 		return err
 	}
+	nodeExecutionModel.OutboxEvents, err = util.GetOutboxEvents(m.config.ApplicationConfiguration(), request)
+	if err != nil {
+		return err
+	}
 	if err := m.db.NodeExecutionRepo().Create(ctx, nodeExecutionModel); err != nil {
 		logger.Debugf(ctx, "Failed to create node execution with id [%+v] and model [%+v] "+
 			"with err %v", request.Event.Id, nodeExecutionModel, err)
@@ -160,6 +166,10 @@ func (m *NodeExecutionManager) updateNodeExecutionWithEvent(
 		logger.Debugf(ctx, "failed to update node execution model: %+v with err: %v", request.Event.Id, err)
 		return updateFailed, err
 	}
+	nodeExecutionModel.OutboxEvents, err = util.GetOutboxEvents(m.config.ApplicationConfiguration(), request)
+	if err != nil {
+		return updateFailed, err
+	}
 	err = m.db.NodeExecutionRepo().Update(ctx, nodeExecutionModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update node execution with id [%+v] with err %v",
@@ -206,6 +216,8 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		logger.Debugf(ctx, "CreateNodeEvent called with invalid identifier [%+v]: %v", request.Event.Id, err)
 	}
 	ctx = getNodeExecutionContext(ctx, request.Event.Id)
+	// Events are applied to the node execution state written by preceding events, which replicas may lag behind.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 	logger.Debugf(ctx, "Received node execution event for Node Exec Id [%+v] transitioning to phase [%v], w/ Metadata [%v]",
 		request.Event.Id, request.Event.Phase, request.Event.ParentTaskMetadata)
 
@@ -281,6 +293,12 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		}
 	}
 	m.metrics.NodeExecutionEventsCreated.Inc()
+	m.publishNotificationTriggers(ctx, request, workflowExecution)
+
+	// Events recorded in the outbox are published by the outbox relay.
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventsOutboxConfig().Enabled {
+		return &admin.NodeExecutionEventResponse{}, nil
+	}
 
 	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.metrics.PublishEventError.Inc()
@@ -296,6 +314,19 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	return &admin.NodeExecutionEventResponse{}, nil
 }
 
+// publishNotificationTriggers publishes the notifications of the configured node triggers the phase change fires.
+func (m *NodeExecutionManager) publishNotificationTriggers(ctx context.Context, request admin.NodeExecutionEventRequest,
+	workflowExecution models.Execution) {
+	config := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	triggers := notifications.GetNodeNotificationTriggers(config, request.Event)
+	if len(triggers) == 0 {
+		return
+	}
+	execution := util.GetExecutionForNotifications(ctx, m.db, &workflowExecution, request.Event.Id.ExecutionId)
+	util.PublishNotificationTriggers(ctx, m.notificationClient, config, triggers,
+		notifications.NewNodeTemplateData(config, request.Event, execution), m.metrics.PublishNotificationError)
+}
+
 // Handles making additional database calls, if necessary, to populate IsParent & IsDynamic data using the historical pattern of
 // preloading child node executions. Otherwise, simply calls transform on the input model.
 func (m *NodeExecutionManager) transformNodeExecutionModel(ctx context.Context, nodeExecutionModel models.NodeExecution,
@@ -375,7 +406,7 @@ func (m *NodeExecutionManager) listNodeExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(requestToken)
+	offset, cursor, err := validation.ValidatePageToken(requestToken, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListNodeExecutions", requestToken)
@@ -383,6 +414,7 @@ func (m *NodeExecutionManager) listNodeExecutions(
 	listInput := repoInterfaces.ListResourceInput{
 		Limit:         int(limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -396,7 +428,7 @@ func (m *NodeExecutionManager) listNodeExecutions(
 
 	var token string
 	if len(output.NodeExecutions) == int(limit) {
-		token = strconv.Itoa(offset + len(output.NodeExecutions))
+		token = util.GetPageToken(listInput, len(output.NodeExecutions), output.NodeExecutions[len(output.NodeExecutions)-1])
 	}
 	nodeExecutionList, err := m.transformNodeExecutionModelList(ctx, output.NodeExecutions)
 	if err != nil {
@@ -545,9 +577,50 @@ func (m *NodeExecutionManager) GetNodeExecutionData(
 	return response, nil
 }
 
+func (m *NodeExecutionManager) ListNodeExecutionEvents(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if err := validation.ValidateExecutionEventListRequest(request); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ExecutionID)
+	listInput, err := util.GetExecutionEventListInput(request, common.NodeExecutionEvent,
+		models.NodeExecutionEventColumns)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.NodeExecutionEventRepo().List(ctx, listInput)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list node execution events using input [%+v] with err %v", listInput, err)
+		return nil, err
+	}
+	events := make([]interfaces.ExecutionEvent, len(output.NodeExecutionEvents))
+	for idx, event := range output.NodeExecutionEvents {
+		events[idx] = interfaces.ExecutionEvent{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: event.Project,
+				Domain:  event.Domain,
+				Name:    event.Name,
+			},
+			NodeID:     event.NodeID,
+			RequestID:  event.RequestID,
+			Phase:      event.Phase,
+			OccurredAt: event.OccurredAt,
+			RecordedAt: event.CreatedAt,
+		}
+	}
+	var token string
+	if len(events) == listInput.Limit {
+		token = util.GetPageToken(listInput, len(events), output.NodeExecutionEvents[len(events)-1])
+	}
+	return &interfaces.ExecutionEventList{
+		Events: events,
+		Token:  token,
+	}, nil
+}
+
 func NewNodeExecutionManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration,
 	storagePrefix []string, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
-	eventPublisher notificationInterfaces.Publisher, cloudEventPublisher cloudeventInterfaces.Publisher,
+	notificationClient notificationInterfaces.Publisher, eventPublisher notificationInterfaces.Publisher, cloudEventPublisher cloudeventInterfaces.Publisher,
 	eventWriter eventWriter.NodeExecutionEventWriter) interfaces.NodeExecutionInterface {
 	metrics := nodeExecutionMetrics{
 		Scope: scope,
@@ -569,6 +642,8 @@ func NewNodeExecutionManager(db repoInterfaces.Repository, config runtimeInterfa
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of errors when rendering or publishing node notification triggers"),
 	}
 	return &NodeExecutionManager{
 		db:                  db,
@@ -577,6 +652,7 @@ func NewNodeExecutionManager(db repoInterfaces.Repository, config runtimeInterfa
 		storageClient:       storageClient,
 		metrics:             metrics,
 		urlData:             urlData,
+		notificationClient:  notificationClient,
 		eventPublisher:      eventPublisher,
 		dbEventWriter:       eventWriter,
 		cloudEventPublisher: cloudEventPublisher,
@@ -2,13 +2,17 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 
+	scheduleInterfaces "github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
@@ -19,10 +23,13 @@ import (
 )
 
 type ProjectManager struct {
-	db     repoInterfaces.Repository
-	config runtimeInterfaces.Configuration
+	db        repoInterfaces.Repository
+	config    runtimeInterfaces.Configuration
+	scheduler scheduleInterfaces.EventScheduler
 }
 
+const scheduledLaunchPlansPageSize = 100
+
 var alphabeticalSortParam, _ = common.NewSortParameter(&admin.Sort{
 	Direction: admin.Sort_ASCENDING,
 	Key:       "identifier",
@@ -71,7 +78,7 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request admin.Project
 		sortParameter = alphabeticalSortParam
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListProjects", request.Token)
@@ -81,6 +88,7 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request admin.Project
 	listProjectsInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -92,7 +100,7 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request admin.Project
 
 	var token string
 	if len(projects) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(projects))
+		token = util.GetPageToken(listProjectsInput, len(projects), projectModels[len(projects)-1])
 	}
 
 	return &admin.Projects{
@@ -106,7 +114,7 @@ func (m *ProjectManager) UpdateProject(ctx context.Context, projectUpdate admin.
 	projectRepo := m.db.ProjectRepo()
 
 	// Fetch the existing project if exists. If not, return err and do not update.
-	_, err := projectRepo.Get(ctx, projectUpdate.Id)
+	existingProject, err := projectRepo.Get(ctx, projectUpdate.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -124,12 +132,111 @@ func (m *ProjectManager) UpdateProject(ctx context.Context, projectUpdate admin.
 		return nil, err
 	}
 
+	// Archiving a project deactivates the schedules of its launch plans and unarchiving it restores them. Should that
+	// fail, the project and the schedules updated so far are restored to their former state, so that the update can
+	// be retried.
+	wasArchived := existingProject.State != nil && *existingProject.State == int32(admin.Project_ARCHIVED)
+	isArchived := projectUpdate.State == admin.Project_ARCHIVED
+	if wasArchived != isArchived {
+		if err := m.updateSchedules(ctx, projectUpdate.Id, !isArchived); err != nil {
+			m.restoreProject(ctx, existingProject, wasArchived)
+			return nil, err
+		}
+	}
+
 	return &response, nil
 }
 
-func NewProjectManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration) interfaces.ProjectInterface {
+// Restores the state of a project, and the schedules of its launch plans, after failing to archive or unarchive it.
+func (m *ProjectManager) restoreProject(ctx context.Context, existingProject models.Project, wasArchived bool) {
+	if err := m.updateSchedules(ctx, existingProject.Identifier, !wasArchived); err != nil {
+		logger.Errorf(ctx, "Failed to restore the schedules of project [%s] with err: %v", existingProject.Identifier,
+			err)
+	}
+	state := int32(admin.Project_ACTIVE)
+	if existingProject.State != nil {
+		state = *existingProject.State
+	}
+	if err := m.db.ProjectRepo().UpdateProject(ctx, models.Project{
+		Identifier: existingProject.Identifier,
+		State:      &state,
+	}); err != nil {
+		logger.Errorf(ctx, "Failed to restore the state of project [%s] with err: %v", existingProject.Identifier, err)
+	}
+}
+
+// Adds, or removes, the schedules of all the active launch plans of a project across its domains. The launch plans
+// themselves remain active so that their schedules are restored when the project is unarchived.
+func (m *ProjectManager) updateSchedules(ctx context.Context, project string, enable bool) error {
+	projectFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, shared.Project, project)
+	if err != nil {
+		return err
+	}
+	activeFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, shared.State, int32(admin.LaunchPlanState_ACTIVE))
+	if err != nil {
+		return err
+	}
+	schedulerConfig := m.config.ApplicationConfiguration().GetSchedulerConfig()
+	for offset := 0; ; offset += scheduledLaunchPlansPageSize {
+		output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         scheduledLaunchPlansPageSize,
+			Offset:        offset,
+			InlineFilters: []common.InlineFilter{projectFilter, activeFilter},
+		})
+		if err != nil {
+			return err
+		}
+		for _, launchPlan := range output.LaunchPlans {
+			var launchPlanSpec admin.LaunchPlanSpec
+			if err := proto.Unmarshal(launchPlan.Spec, &launchPlanSpec); err != nil {
+				return errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to unmarshal spec of launch plan [%+v]: %v", launchPlan.LaunchPlanKey, err)
+			}
+			if isScheduleEmpty(launchPlanSpec) {
+				continue
+			}
+			launchPlanIdentifier := core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      launchPlan.Project,
+				Domain:       launchPlan.Domain,
+				Name:         launchPlan.Name,
+				Version:      launchPlan.Version,
+			}
+			if enable {
+				addScheduleInput, err := m.scheduler.CreateScheduleInput(ctx, schedulerConfig, launchPlanIdentifier,
+					launchPlanSpec.EntityMetadata.Schedule)
+				if err != nil {
+					return err
+				}
+				err = m.scheduler.AddSchedule(ctx, addScheduleInput)
+				if err != nil {
+					return err
+				}
+				logger.Infof(ctx, "Enabled schedule of launch plan [%+v] of unarchived project [%s]",
+					launchPlanIdentifier, project)
+			} else {
+				err = m.scheduler.RemoveSchedule(ctx, scheduleInterfaces.RemoveScheduleInput{
+					Identifier:         launchPlanIdentifier,
+					ScheduleNamePrefix: schedulerConfig.EventSchedulerConfig.ScheduleNamePrefix,
+				})
+				if err != nil {
+					return err
+				}
+				logger.Infof(ctx, "Disabled schedule of launch plan [%+v] of archived project [%s]",
+					launchPlanIdentifier, project)
+			}
+		}
+		if len(output.LaunchPlans) < scheduledLaunchPlansPageSize {
+			return nil
+		}
+	}
+}
+
+func NewProjectManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration,
+	scheduler scheduleInterfaces.EventScheduler) interfaces.ProjectInterface {
 	return &ProjectManager{
-		db:     db,
-		config: config,
+		db:        db,
+		config:    config,
+		scheduler: scheduler,
 	}
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	scheduleInterfaces "github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
+	scheduleMocks "github.com/flyteorg/flyteadmin/pkg/async/schedule/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
@@ -67,7 +69,7 @@ func testListProjects(request admin.ProjectListRequest, token string, orderExpr
 		}, nil
 	}
 
-	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider, scheduleMocks.NewMockEventScheduler())
 	resp, err := projectManager.ListProjects(context.Background(), request)
 	assert.NoError(t, err)
 
@@ -79,11 +81,18 @@ func testListProjects(request admin.ProjectListRequest, token string, orderExpr
 	}
 }
 
+// The page following a listed project is the one after its identifier.
+var projectPageToken, _ = common.EncodePageCursor(common.PageCursor{
+	SortKey:   "identifier",
+	Direction: admin.Sort_ASCENDING,
+	SortValue: "project",
+})
+
 func TestListProjects_NoFilters_LimitOne(t *testing.T) {
 	testListProjects(admin.ProjectListRequest{
 		Token: "1",
 		Limit: 1,
-	}, "2", "identifier asc", nil, t)
+	}, projectPageToken, "identifier asc", nil, t)
 }
 
 func TestListProjects_HighLimit_SortBy_Filter(t *testing.T) {
@@ -118,7 +127,8 @@ func TestProjectManager_CreateProject(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
 		Project: &admin.Project{
 			Id:          "flyte-project-id",
@@ -138,7 +148,8 @@ func TestProjectManager_CreateProjectError(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
 		Project: &admin.Project{
 			Id:          "flyte-project-id",
@@ -171,7 +182,8 @@ func TestProjectManager_CreateProjectErrorDueToBadLabels(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.CreateProject(context.Background(), admin.ProjectRegisterRequest{
 		Project: &admin.Project{
 			Id:          "flyte-project-id",
@@ -226,7 +238,8 @@ func TestProjectManager_UpdateProject(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
 		Id:          "project-id",
 		Name:        "new-project-name",
@@ -250,7 +263,8 @@ func TestProjectManager_UpdateProject_ErrorDueToProjectNotFound(t *testing.T) {
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
 		Id:          "not-found-project-id",
 		Name:        "not-found-project-name",
@@ -272,10 +286,149 @@ func TestProjectManager_UpdateProject_ErrorDueToInvalidProjectName(t *testing.T)
 	}
 	projectManager := NewProjectManager(mockRepository,
 		runtimeMocks.NewMockConfigurationProvider(
-			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil))
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		scheduleMocks.NewMockEventScheduler())
 	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
 		Id:   "project-id",
 		Name: "longnamelongnamelongnamelongnamelongnamelongnamelongnamelongnamel",
 	})
 	assert.EqualError(t, err, "project_name cannot exceed 64 characters")
 }
+
+func getMockScheduledLaunchPlans(t *testing.T) []models.LaunchPlan {
+	scheduledSpec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Schedule: &admin.Schedule{
+				ScheduleExpression: &admin.Schedule_CronExpression{CronExpression: "* * * * *"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	unscheduledSpec, err := proto.Marshal(&admin.LaunchPlanSpec{})
+	assert.NoError(t, err)
+	return []models.LaunchPlan{
+		{
+			LaunchPlanKey: models.LaunchPlanKey{Project: "project-id", Domain: "development", Name: "scheduled", Version: "v1"},
+			Spec:          scheduledSpec,
+		},
+		{
+			LaunchPlanKey: models.LaunchPlanKey{Project: "project-id", Domain: "development", Name: "unscheduled", Version: "v1"},
+			Spec:          unscheduledSpec,
+		},
+	}
+}
+
+func TestProjectManager_UpdateProject_Archive(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	activeState := int32(admin.Project_ACTIVE)
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: "project-id", Name: "project-name", State: &activeState}, nil
+	}
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 2)
+			return interfaces.LaunchPlanCollectionOutput{LaunchPlans: getMockScheduledLaunchPlans(t)}, nil
+		})
+	var updateFuncCalled bool
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		updateFuncCalled = true
+		assert.Equal(t, int32(admin.Project_ARCHIVED), *projectUpdate.State)
+		return nil
+	}
+	mockScheduler := scheduleMocks.NewMockEventScheduler()
+	var removedSchedules []string
+	mockScheduler.(*scheduleMocks.MockEventScheduler).SetRemoveScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.RemoveScheduleInput) error {
+			removedSchedules = append(removedSchedules, input.Identifier.Name)
+			return nil
+		})
+	mockScheduler.(*scheduleMocks.MockEventScheduler).SetAddScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.AddScheduleInput) error {
+			assert.Fail(t, "No schedules were expected to be added")
+			return nil
+		})
+	projectManager := NewProjectManager(mockRepository,
+		runtimeMocks.NewMockConfigurationProvider(
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		mockScheduler)
+	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
+		Id:    "project-id",
+		Name:  "project-name",
+		State: admin.Project_ARCHIVED,
+	})
+	assert.NoError(t, err)
+	assert.True(t, updateFuncCalled)
+	assert.Equal(t, []string{"scheduled"}, removedSchedules)
+}
+
+func TestProjectManager_UpdateProject_Unarchive(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	archivedState := int32(admin.Project_ARCHIVED)
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: "project-id", Name: "project-name", State: &archivedState}, nil
+	}
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{LaunchPlans: getMockScheduledLaunchPlans(t)}, nil
+		})
+	mockScheduler := scheduleMocks.NewMockEventScheduler()
+	var addedSchedules []string
+	mockScheduler.(*scheduleMocks.MockEventScheduler).SetAddScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.AddScheduleInput) error {
+			addedSchedules = append(addedSchedules, input.Identifier.Name)
+			assert.Equal(t, "* * * * *", input.ScheduleExpression.GetCronExpression())
+			return nil
+		})
+	projectManager := NewProjectManager(mockRepository,
+		runtimeMocks.NewMockConfigurationProvider(
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		mockScheduler)
+	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
+		Id:    "project-id",
+		Name:  "project-name",
+		State: admin.Project_ACTIVE,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"scheduled"}, addedSchedules)
+}
+
+func TestProjectManager_UpdateProject_ArchiveScheduleError(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{LaunchPlans: getMockScheduledLaunchPlans(t)}, nil
+		})
+	var updatedStates []int32
+	mockRepository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		updatedStates = append(updatedStates, *projectUpdate.State)
+		return nil
+	}
+	mockScheduler := scheduleMocks.NewMockEventScheduler()
+	mockScheduler.(*scheduleMocks.MockEventScheduler).SetRemoveScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.RemoveScheduleInput) error {
+			return errors.New("uh oh")
+		})
+	var addedSchedules []string
+	mockScheduler.(*scheduleMocks.MockEventScheduler).SetAddScheduleFunc(
+		func(ctx context.Context, input scheduleInterfaces.AddScheduleInput) error {
+			addedSchedules = append(addedSchedules, input.Identifier.Name)
+			return nil
+		})
+	projectManager := NewProjectManager(mockRepository,
+		runtimeMocks.NewMockConfigurationProvider(
+			getMockApplicationConfigForProjectManagerTest(), nil, nil, nil, nil, nil),
+		mockScheduler)
+	_, err := projectManager.UpdateProject(context.Background(), admin.Project{
+		Id:    "project-id",
+		Name:  "project-name",
+		State: admin.Project_ARCHIVED,
+	})
+	assert.EqualError(t, err, "uh oh")
+	// The project is active again, along with its schedules.
+	assert.Equal(t, []int32{int32(admin.Project_ARCHIVED), int32(admin.Project_ACTIVE)}, updatedStates)
+	assert.Equal(t, []string{"scheduled"}, addedSchedules)
+}
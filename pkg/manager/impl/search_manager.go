@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+type SearchManager struct {
+	db repoInterfaces.Repository
+}
+
+func (s *SearchManager) Search(ctx context.Context, request interfaces.SearchRequest) (
+	*interfaces.SearchResultList, error) {
+	if err := validation.ValidateSearchRequest(request); err != nil {
+		logger.Debugf(ctx, "Search request [%+v] failed validation with err: %v", request, err)
+		return nil, err
+	}
+	if len(request.Project) > 0 {
+		ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for Search", request.Token)
+	}
+	searchInput := repoInterfaces.SearchInput{
+		Terms:         validation.GetSearchTerms(request.Query),
+		ResourceTypes: request.ResourceTypes,
+		Project:       request.Project,
+		Domain:        request.Domain,
+		Limit:         int(request.Limit),
+		Offset:        offset,
+	}
+	documents, err := s.db.SearchRepo().Search(ctx, searchInput)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to search using input [%+v] with err %v", searchInput, err)
+		return nil, err
+	}
+	results := make([]interfaces.SearchResult, len(documents))
+	for idx, document := range documents {
+		results[idx] = interfaces.SearchResult{
+			ID: &core.Identifier{
+				ResourceType: document.ResourceType,
+				Project:      document.Project,
+				Domain:       document.Domain,
+				Name:         document.Name,
+				Version:      document.Version,
+			},
+			Score: document.SearchRank,
+		}
+	}
+	var token string
+	if len(results) == searchInput.Limit {
+		token = strconv.Itoa(offset + len(results))
+	}
+	return &interfaces.SearchResultList{
+		Results: results,
+		Token:   token,
+	}, nil
+}
+
+func NewSearchManager(db repoInterfaces.Repository) interfaces.SearchInterface {
+	return &SearchManager{
+		db: db,
+	}
+}
@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+func TestSearch(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	searchRepo := repository.SearchRepo().(*repositoryMocks.SearchRepoInterface)
+	searchRepo.OnSearchMatch(mock.Anything, repoInterfaces.SearchInput{
+		Terms:         []string{"hello", "world"},
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK},
+		Project:       project,
+		Domain:        domain,
+		Limit:         2,
+		Offset:        4,
+	}).Return([]models.SearchDocument{
+		{
+			SearchDocumentKey: models.SearchDocumentKey{
+				ResourceType: core.ResourceType_TASK,
+				Project:      project,
+				Domain:       domain,
+				Name:         "hello_world",
+			},
+			Version:    version,
+			SearchRank: 0.6,
+		},
+		{
+			SearchDocumentKey: models.SearchDocumentKey{
+				ResourceType: core.ResourceType_TASK,
+				Project:      project,
+				Domain:       domain,
+				Name:         "greet",
+			},
+			Version:    version,
+			SearchRank: 0.2,
+		},
+	}, nil)
+
+	searchManager := NewSearchManager(repository)
+	results, err := searchManager.Search(context.Background(), interfaces.SearchRequest{
+		Query:         "Hello, World!",
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK},
+		Project:       project,
+		Domain:        domain,
+		Limit:         2,
+		Token:         "4",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.SearchResultList{
+		Results: []interfaces.SearchResult{
+			{
+				ID: &core.Identifier{
+					ResourceType: core.ResourceType_TASK,
+					Project:      project,
+					Domain:       domain,
+					Name:         "hello_world",
+					Version:      version,
+				},
+				Score: 0.6,
+			},
+			{
+				ID: &core.Identifier{
+					ResourceType: core.ResourceType_TASK,
+					Project:      project,
+					Domain:       domain,
+					Name:         "greet",
+					Version:      version,
+				},
+				Score: 0.2,
+			},
+		},
+		Token: "6",
+	}, results)
+}
+
+func TestSearch_InvalidRequest(t *testing.T) {
+	searchManager := NewSearchManager(repositoryMocks.NewMockRepository())
+	_, err := searchManager.Search(context.Background(), interfaces.SearchRequest{
+		Query: "?",
+		Limit: 10,
+	})
+	assert.EqualError(t, err, "search query must contain at least one word")
+
+	_, err = searchManager.Search(context.Background(), interfaces.SearchRequest{
+		Query: "hello",
+		Limit: 10,
+		Token: "foo",
+	})
+	assert.EqualError(t, err, "invalid pagination token foo for Search")
+}
@@ -32,6 +32,14 @@ const (
 	UserInputs            = "user_inputs"
 	Attributes            = "attributes"
 	MatchingAttributes    = "matching_attributes"
+	GroupBy               = "group_by"
+	TimeBucket            = "time_bucket"
+	Next                  = "next"
+	History               = "history"
+	Phase                 = "phase"
+	LaunchPlan            = "launch_plan"
+	StartTime             = "start_time"
+	EndTime               = "end_time"
 	// Parent of a node execution in the node executions table
 	ParentID        = "parent_id"
 	WorkflowClosure = "workflow_closure"
@@ -2,7 +2,6 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -87,18 +86,20 @@ func (s *SignalManager) ListSignals(ctx context.Context, request admin.SignalLis
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListSignals", request.Token)
 	}
 
-	signalModelList, err := s.db.SignalRepo().List(ctx, repoInterfaces.ListResourceInput{
+	listInput := repoInterfaces.ListResourceInput{
 		InlineFilters: filters,
 		Offset:        offset,
+		Cursor:        cursor,
 		Limit:         int(request.Limit),
 		SortParameter: sortParameter,
-	})
+	}
+	signalModelList, err := s.db.SignalRepo().List(ctx, listInput)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to list signals with request [%+v] with err %v",
 			request, err)
@@ -112,7 +113,7 @@ func (s *SignalManager) ListSignals(ctx context.Context, request admin.SignalLis
 	}
 	var token string
 	if len(signalList) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(signalList))
+		token = util.GetPageToken(listInput, len(signalList), signalModelList[len(signalList)-1])
 	}
 	return &admin.SignalList{
 		Signals: signalList,
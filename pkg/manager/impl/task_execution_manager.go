@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -18,6 +17,7 @@ import (
 	"github.com/flyteorg/flytestdlib/storage"
 
 	cloudeventInterfaces "github.com/flyteorg/flyteadmin/pkg/async/cloudevent/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
@@ -43,15 +43,18 @@ type taskExecutionMetrics struct {
 	TaskExecutionInputBytes    prometheus.Summary
 	TaskExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	PublishNotificationError   prometheus.Counter
 }
 
 type TaskExecutionManager struct {
-	db                   repoInterfaces.Repository
-	config               runtimeInterfaces.Configuration
-	storageClient        *storage.DataStore
-	metrics              taskExecutionMetrics
-	urlData              dataInterfaces.RemoteURLInterface
+	db            repoInterfaces.Repository
+	config        runtimeInterfaces.Configuration
+	storageClient *storage.DataStore
+	metrics       taskExecutionMetrics
+	urlData       dataInterfaces.RemoteURLInterface
+	// Publishes notification triggers, whereas eventPublisher publishes the events themselves.
 	notificationClient   notificationInterfaces.Publisher
+	eventPublisher       notificationInterfaces.Publisher
 	cloudEventsPublisher cloudeventInterfaces.Publisher
 }
 
@@ -114,6 +117,10 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 		logger.Debugf(ctx, "failed to update task execution model [%+v] with err: %v", request.Event.TaskId, err)
 		return models.TaskExecution{}, err
 	}
+	existingTaskExecution.OutboxEvents, err = util.GetOutboxEvents(m.config.ApplicationConfiguration(), request)
+	if err != nil {
+		return models.TaskExecution{}, err
+	}
 
 	err = m.db.TaskExecutionRepo().Update(ctx, *existingTaskExecution)
 	if err != nil {
@@ -130,6 +137,8 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	if err := validation.ValidateTaskExecutionRequest(request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes); err != nil {
 		return nil, err
 	}
+	// Events are applied to the task execution state written by preceding events, which replicas may lag behind.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 
 	if err := validation.ValidateClusterForExecutionID(ctx, m.db, request.Event.ParentNodeExecutionId.ExecutionId, request.Event.ProducerId); err != nil {
 		return nil, err
@@ -162,6 +171,7 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		if err != nil {
 			return nil, err
 		}
+		m.publishNotificationTriggers(ctx, request)
 
 		return &admin.TaskExecutionEventResponse{}, nil
 	}
@@ -183,12 +193,17 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		return nil, errors.NewAlreadyInTerminalStateError(ctx, errorMsg, curPhase)
 	}
 
+	// Phase versions only update the task execution, notifications are sent for actual phase changes.
+	isPhaseChange := taskExecutionModel.Phase != request.Event.Phase.String()
 	taskExecutionModel, err = m.updateTaskExecutionModelState(ctx, &request, &taskExecutionModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update task execution with id [%+v] with err %v",
 			taskExecutionID, err)
 		return nil, err
 	}
+	if isPhaseChange {
+		m.publishNotificationTriggers(ctx, request)
+	}
 
 	if request.Event.Phase == core.TaskExecution_RUNNING && request.Event.PhaseVersion == 0 { // TODO: need to be careful about missing inc/decs
 		m.metrics.ActiveTaskExecutions.Inc()
@@ -200,16 +215,19 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		}
 	}
 
-	if err = m.notificationClient.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-		m.metrics.PublishEventError.Inc()
-		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
-	}
-
-	go func() {
-		if err := m.cloudEventsPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-			logger.Infof(ctx, "error publishing cloud event [%+v] with err: [%v]", request.RequestId, err)
+	// Events recorded in the outbox are published by the outbox relay.
+	if !m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventsOutboxConfig().Enabled {
+		if err = m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+			m.metrics.PublishEventError.Inc()
+			logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
 		}
-	}()
+
+		go func() {
+			if err := m.cloudEventsPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+				logger.Infof(ctx, "error publishing cloud event [%+v] with err: [%v]", request.RequestId, err)
+			}
+		}()
+	}
 
 	m.metrics.TaskExecutionEventsCreated.Inc()
 	logger.Debugf(ctx, "Successfully recorded task execution event [%v]", request.Event)
@@ -217,6 +235,18 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 	return &admin.TaskExecutionEventResponse{}, nil
 }
 
+// publishNotificationTriggers publishes the notifications of the configured task triggers the phase change fires.
+func (m *TaskExecutionManager) publishNotificationTriggers(ctx context.Context, request admin.TaskExecutionEventRequest) {
+	config := *m.config.ApplicationConfiguration().GetNotificationsConfig()
+	triggers := notifications.GetTaskNotificationTriggers(config, request.Event)
+	if len(triggers) == 0 {
+		return
+	}
+	execution := util.GetExecutionForNotifications(ctx, m.db, nil, request.Event.ParentNodeExecutionId.ExecutionId)
+	util.PublishNotificationTriggers(ctx, m.notificationClient, config, triggers,
+		notifications.NewTaskTemplateData(config, request.Event, execution), m.metrics.PublishNotificationError)
+}
+
 func (m *TaskExecutionManager) GetTaskExecution(
 	ctx context.Context, request admin.TaskExecutionGetRequest) (*admin.TaskExecution, error) {
 	err := validation.ValidateTaskExecutionIdentifier(request.Id)
@@ -260,18 +290,20 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListTaskExecutions", request.Token)
 	}
 
-	output, err := m.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+	listInput := repoInterfaces.ListResourceInput{
 		InlineFilters: filters,
 		Offset:        offset,
+		Cursor:        cursor,
 		Limit:         int(request.Limit),
 		SortParameter: sortParameter,
-	})
+	}
+	output, err := m.db.TaskExecutionRepo().List(ctx, listInput)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to list task executions with request [%+v] with err %v",
 			request, err)
@@ -286,7 +318,7 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 	}
 	var token string
 	if len(taskExecutionList) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(taskExecutionList))
+		token = util.GetPageToken(listInput, len(taskExecutionList), output.TaskExecutions[len(taskExecutionList)-1])
 	}
 	return &admin.TaskExecutionList{
 		TaskExecutions: taskExecutionList,
@@ -339,7 +371,7 @@ func (m *TaskExecutionManager) GetTaskExecutionData(
 
 func NewTaskExecutionManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration,
 	storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
-	publisher notificationInterfaces.Publisher, cloudEventsPublisher cloudeventInterfaces.Publisher) interfaces.TaskExecutionInterface {
+	notificationClient notificationInterfaces.Publisher, eventPublisher notificationInterfaces.Publisher, cloudEventsPublisher cloudeventInterfaces.Publisher) interfaces.TaskExecutionInterface {
 
 	metrics := taskExecutionMetrics{
 		Scope: scope,
@@ -363,6 +395,8 @@ func NewTaskExecutionManager(db repoInterfaces.Repository, config runtimeInterfa
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		PublishNotificationError: scope.MustNewCounter("publish_notification_error",
+			"overall count of errors when rendering or publishing task notification triggers"),
 	}
 	return &TaskExecutionManager{
 		db:                   db,
@@ -370,7 +404,8 @@ func NewTaskExecutionManager(db repoInterfaces.Repository, config runtimeInterfa
 		storageClient:        storageClient,
 		metrics:              metrics,
 		urlData:              urlData,
-		notificationClient:   publisher,
+		notificationClient:   notificationClient,
+		eventPublisher:       eventPublisher,
 		cloudEventsPublisher: cloudEventsPublisher,
 	}
 }
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -12,16 +13,21 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	commonMocks "github.com/flyteorg/flyteadmin/pkg/common/mocks"
 	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
 	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
@@ -177,7 +183,7 @@ func TestCreateTaskEvent(t *testing.T) {
 			}, input)
 			return nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, createTaskCalled)
@@ -188,7 +194,7 @@ func TestCreateTaskEvent(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return errors.New("failed to insert record into task table")
 		})
-	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.NotNil(t, err)
 	assert.Nil(t, resp)
@@ -299,7 +305,7 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 		OutputUri: expectedOutputResult.OutputUri,
 	}
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, &mockPublisher, &mockPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -320,7 +326,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 			ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 			return false, expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "Failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ] "+
@@ -332,7 +338,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 			ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 			return false, nil
 		})
-	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ]")
@@ -352,7 +358,7 @@ func TestCreateTaskEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -393,7 +399,7 @@ func TestCreateTaskEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, execution models.TaskExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := nodeExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -427,7 +433,7 @@ func TestCreateTaskEvent_UpdateTerminalEventError(t *testing.T) {
 			}, nil
 		})
 	taskEventRequest.Event.Phase = core.TaskExecution_RUNNING
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 
 	assert.Nil(t, resp)
@@ -494,7 +500,7 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	taskEventRequest.Event.PhaseVersion = uint32(1)
 	taskEventRequest.Event.OccurredAt = taskEventUpdatedAtProto
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, &mockPublisher, &mockPublisher)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -502,6 +508,103 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestCreateTaskEvent_NotificationTriggers(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+				},
+				InputURI:               "input uri",
+				StartedAt:              &taskStartedAt,
+				TaskExecutionCreatedAt: &taskStartedAt,
+				TaskExecutionUpdatedAt: &taskStartedAt,
+				Phase:                  core.TaskExecution_RUNNING.String(),
+			}, nil
+		},
+	)
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			TaskTriggers: []runtimeInterfaces.NotificationTriggerConfig{
+				{
+					TaskName:         "task-id",
+					MinRetryAttempts: 1,
+					Phases:           []string{"RUNNING", "FAILED"},
+					Type:             "webhook",
+					Recipients:       []string{"retries"},
+				},
+				{
+					MinRetryAttempts: 2,
+					Type:             "webhook",
+					Recipients:       []string{"too-many-retries"},
+				},
+			},
+		})
+	var published []*admin.EmailMessage
+	var notificationPublisher notificationMocks.MockPublisher
+	notificationPublisher.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		assert.Equal(t, "webhook", key)
+		published = append(published, msg.(*admin.EmailMessage))
+		return nil
+	})
+	taskExecManager := NewTaskExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()),
+		mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &notificationPublisher, &mockPublisher, &mockPublisher)
+
+	// Phase version updates don't notify.
+	request := admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			ProducerId:            "propeller",
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			OccurredAt:            sampleTaskEventOccurredAt,
+			Phase:                 core.TaskExecution_RUNNING,
+			PhaseVersion:          1,
+			RetryAttempt:          1,
+		},
+	}
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Empty(t, published)
+
+	request.Event.Phase = core.TaskExecution_FAILED
+	request.Event.PhaseVersion = 0
+	request.Event.OutputResult = &event.TaskExecutionEvent_Error{
+		Error: &core.ExecutionError{
+			Message: "oops",
+		},
+	}
+	_, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Len(t, published, 1)
+	assert.Equal(t, []string{"retries"}, published[0].RecipientsEmail)
+	assert.Equal(t, "Task task-id (attempt 1) of node node-id in execution project/domain/name has failed",
+		published[0].SubjectLine)
+	var payload notifications.WebhookPayload
+	assert.NoError(t, json.Unmarshal([]byte(published[0].Body), &payload))
+	assert.Equal(t, "task", payload.Type)
+	var taskEvent event.TaskExecutionEvent
+	assert.NoError(t, jsonpb.UnmarshalString(string(payload.Event), &taskEvent))
+	assert.Equal(t, "oops", taskEvent.GetError().GetMessage())
+}
+
 func TestGetTaskExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	addGetWorkflowExecutionCallback(repository)
@@ -553,7 +656,7 @@ func TestGetTaskExecution(t *testing.T) {
 				Closure:   closureBytes,
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -602,7 +705,7 @@ func TestGetTaskExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm an invalid task closure"),
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -715,7 +818,7 @@ func TestListTaskExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	taskExecutions, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			NodeId: "nodey b",
@@ -786,7 +889,7 @@ func TestListTaskExecutions_NoFilters(t *testing.T) {
 			listTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Token: "1",
 		Limit: 99,
@@ -804,7 +907,7 @@ func TestListTaskExecutions_NoLimit(t *testing.T) {
 			getTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Limit: 0,
 	})
@@ -835,7 +938,7 @@ func TestListTaskExecutions_NothingToReturn(t *testing.T) {
 			listTasksCalled = true
 			return interfaces.TaskCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -937,7 +1040,7 @@ func TestGetTaskExecutionData(t *testing.T) {
 		}
 		return fmt.Errorf("unexpected call to find value in storage [%v]", reference.String())
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	dataResponse, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -117,6 +117,7 @@ func (t *TaskManager) CreateTask(
 	if descriptionModel != nil {
 		taskModel.ShortDescription = descriptionModel.ShortDescription
 	}
+	taskModel.SearchDocument = transformers.CreateTaskSearchDocument(finalizedRequest)
 	err = t.db.TaskRepo().Create(ctx, taskModel, descriptionModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to create task model with id [%+v] with err %v", request.Id, err)
@@ -172,7 +173,7 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListTasks", request.Token)
@@ -181,6 +182,7 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 	listTasksInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -198,7 +200,7 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 
 	var token string
 	if len(taskList) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(taskList))
+		token = util.GetPageToken(listTasksInput, len(taskList), output.Tasks[len(taskList)-1])
 	}
 	return &admin.TaskList{
 		Tasks: taskList,
@@ -258,6 +260,41 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request adm
 	}, nil
 }
 
+// Hard deletes a task version, provided no running executions launched or run it.
+func (t *TaskManager) DeleteTask(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	principal, err := authorizeEntityDeletion(
+		ctx, t.config.ApplicationConfiguration().GetTopLevelConfig().GetEntityDeletionConfig())
+	if err != nil {
+		return err
+	}
+	if err := validation.ValidateIdentifier(&request.ID, common.Task); err != nil {
+		return err
+	}
+	ctx = getTaskContext(ctx, &request.ID)
+	taskModel, err := util.GetTaskModel(ctx, t.db, &request.ID)
+	if err != nil {
+		return err
+	}
+	if err := util.ValidateNoRunningExecutions(ctx, t.db, request.ID, "task_id", taskModel.ID); err != nil {
+		return err
+	}
+	if err := util.ValidateNoRunningTaskExecutions(ctx, t.db, request.ID); err != nil {
+		return err
+	}
+	err = t.db.TaskRepo().Delete(ctx, repoInterfaces.Identifier{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+		Version: request.ID.Version,
+	}, util.NewDeletionAuditEntry(request.ID, principal, request.Reason))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to delete task [%+v] with err: %v", request.ID, err)
+		return err
+	}
+	logger.Infof(ctx, "[%s] deleted task [%+v]: %s", principal, request.ID, request.Reason)
+	return nil
+}
+
 func NewTaskManager(
 	db repoInterfaces.Repository,
 	config runtimeInterfaces.Configuration, compiler workflowengine.Compiler,
@@ -9,6 +9,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -77,6 +78,9 @@ func TestCreateTask(t *testing.T) {
 		assert.Equal(t, []byte{
 			0xbf, 0x79, 0x61, 0x1c, 0xf5, 0xc1, 0xfb, 0x4c, 0xf8, 0xf4, 0xc4, 0x53, 0x5f, 0x8f, 0x73, 0xe2, 0x26, 0x5a,
 			0x18, 0x4a, 0xb7, 0x66, 0x98, 0x3c, 0xab, 0x2, 0x6c, 0x9, 0x9b, 0x90, 0xec, 0x8f}, input.Digest)
+		assert.Equal(t, core.ResourceType_TASK, input.SearchDocument.ResourceType)
+		assert.Equal(t, input.TaskKey.Name, input.SearchDocument.Name)
+		assert.Equal(t, input.TaskKey.Version, input.SearchDocument.Version)
 		createCalled = true
 		return nil
 	})
@@ -298,7 +302,13 @@ func TestListTasks(t *testing.T) {
 			CreatedAt: testutils.MockCreatedAtProto,
 		}, task.Closure))
 	}
-	assert.Equal(t, "2", taskList.Token)
+	expectedToken, err := common.EncodePageCursor(common.PageCursor{
+		SortKey:   "domain",
+		Direction: admin.Sort_ASCENDING,
+		SortValue: domainValue,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedToken, taskList.Token)
 }
 
 func TestListTasks_MissingParameters(t *testing.T) {
@@ -404,3 +414,93 @@ func TestListUniqueTaskIdentifiers(t *testing.T) {
 	assert.Equal(t, 2, len(resp.Entities))
 	assert.Empty(t, resp.Token)
 }
+
+func TestDeleteTask(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			return models.Task{BaseModel: models.BaseModel{ID: 7}}, nil
+		})
+	mockRepository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+			assert.Len(t, input.InlineFilters, 2)
+			expr, _ := input.InlineFilters[0].GetGormQueryExpr()
+			assert.Equal(t, "task_id = ?", expr.Query)
+			assert.Equal(t, uint(7), expr.Args)
+			return 0, nil
+		})
+	mockRepository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+			assert.Len(t, input.InlineFilters, 5)
+			return 0, nil
+		})
+	var deleted bool
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			deleted = true
+			assert.Equal(t, interfaces.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+				Version: "version",
+			}, input)
+			assert.Equal(t, models.AuditEntry{
+				Action:       models.AuditActionDelete,
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "name",
+				Version:      "version",
+				Principal:    entityDeletionAdmin,
+				Reason:       "leaked credentials",
+			}, auditEntry)
+			return nil
+		})
+	config := getMockConfigForTaskTest()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	taskManager := NewTaskManager(mockRepository, config, getMockTaskCompiler(), mockScope.NewTestScope())
+	ctx := getEntityDeletionContext(t, entityDeletionAdmin, "")
+	err := taskManager.DeleteTask(ctx, managerInterfaces.EntityDeleteRequest{
+		ID:     taskIdentifier,
+		Reason: "leaked credentials",
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestDeleteTask_NotAuthorized(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			assert.Fail(t, "unauthorized deletions shouldn't delete the task")
+			return nil
+		})
+	config := getMockConfigForTaskTest()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	taskManager := NewTaskManager(mockRepository, config, getMockTaskCompiler(), mockScope.NewTestScope())
+	err := taskManager.DeleteTask(getEntityDeletionContext(t, "user", ""), managerInterfaces.EntityDeleteRequest{
+		ID: taskIdentifier,
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteTask_RunningTaskExecutions(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	mockRepository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+			return 2, nil
+		})
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			assert.Fail(t, "tasks run by running executions shouldn't be deleted")
+			return nil
+		})
+	config := getMockConfigForTaskTest()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	taskManager := NewTaskManager(mockRepository, config, getMockTaskCompiler(), mockScope.NewTestScope())
+	ctx := getEntityDeletionContext(t, entityDeletionAdmin, "")
+	err := taskManager.DeleteTask(ctx, managerInterfaces.EntityDeleteRequest{
+		ID: taskIdentifier,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"context"
+	"sort"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// NewDeletionAuditEntry returns the audit entry recorded when the identified entity version is deleted.
+func NewDeletionAuditEntry(identifier core.Identifier, principal, reason string) models.AuditEntry {
+	return models.AuditEntry{
+		Action:       models.AuditActionDelete,
+		ResourceType: identifier.ResourceType,
+		Project:      identifier.Project,
+		Domain:       identifier.Domain,
+		Name:         identifier.Name,
+		Version:      identifier.Version,
+		Principal:    principal,
+		Reason:       reason,
+	}
+}
+
+func getNonTerminalExecutionPhases() []string {
+	var phases []string
+	for value, name := range core.WorkflowExecution_Phase_name {
+		if !common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) {
+			phases = append(phases, name)
+		}
+	}
+	sort.Strings(phases)
+	return phases
+}
+
+// ValidateNoRunningExecutions returns a FailedPrecondition error when any execution launched from the entity version,
+// referenced by the executions column idField, has yet to terminate.
+func ValidateNoRunningExecutions(
+	ctx context.Context, repo repoInterfaces.Repository, identifier core.Identifier, idField string, id uint) error {
+	idFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, idField, id)
+	if err != nil {
+		return err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(
+		common.Execution, common.ValueIn, "phase", getNonTerminalExecutionPhases())
+	if err != nil {
+		return err
+	}
+	count, err := repo.ExecutionRepo().Count(ctx, repoInterfaces.CountResourceInput{
+		InlineFilters: []common.InlineFilter{idFilter, phaseFilter},
+	})
+	if err != nil {
+		logger.Errorf(ctx, "Failed to count running executions of [%+v] with err: %v", identifier, err)
+		return err
+	}
+	if count > 0 {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"cannot delete [%+v], it is referenced by %d running executions", identifier, count)
+	}
+	return nil
+}
+
+// ValidateNoRunningTaskExecutions returns a FailedPrecondition error when any execution which has run the task version
+// has yet to terminate.
+func ValidateNoRunningTaskExecutions(ctx context.Context, repo repoInterfaces.Repository, identifier core.Identifier) error {
+	fields := []string{"project", "domain", "name", "version"}
+	values := []string{identifier.Project, identifier.Domain, identifier.Name, identifier.Version}
+	filters := make([]common.InlineFilter, 0, len(fields)+1)
+	for idx, field := range fields {
+		filter, err := common.NewSingleValueFilter(common.TaskExecution, common.Equal, field, values[idx])
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(
+		common.Execution, common.ValueIn, "phase", getNonTerminalExecutionPhases())
+	if err != nil {
+		return err
+	}
+	count, err := repo.TaskExecutionRepo().Count(ctx, repoInterfaces.CountResourceInput{
+		InlineFilters: append(filters, phaseFilter),
+	})
+	if err != nil {
+		logger.Errorf(ctx, "Failed to count running task executions of [%+v] with err: %v", identifier, err)
+		return err
+	}
+	if count > 0 {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"cannot delete [%+v], it is run by %d running executions", identifier, count)
+	}
+	return nil
+}
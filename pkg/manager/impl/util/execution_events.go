@@ -0,0 +1,90 @@
+package util
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Events are listed in the order they occurred unless the request says otherwise.
+var defaultExecutionEventSort = &admin.Sort{
+	Key:       "occurred_at",
+	Direction: admin.Sort_ASCENDING,
+}
+
+// GetExecutionEventListInput returns the repository input for listing the events of the given entity, either
+// common.ExecutionEvent or common.NodeExecutionEvent, selected by a validated request.
+func GetExecutionEventListInput(request interfaces.ExecutionEventListRequest, entity common.Entity,
+	columns sets.String) (repoInterfaces.ListResourceInput, error) {
+	identifiers := [][2]string{
+		{"execution_project", request.ExecutionID.Project},
+		{"execution_domain", request.ExecutionID.Domain},
+		{"execution_name", request.ExecutionID.Name},
+	}
+	if entity == common.NodeExecutionEvent && len(request.NodeID) > 0 {
+		identifiers = append(identifiers, [2]string{shared.NodeID, request.NodeID})
+	}
+	filters := make([]common.InlineFilter, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		filter, err := GetSingleValueEqualityFilter(entity, identifier[0], identifier[1])
+		if err != nil {
+			return repoInterfaces.ListResourceInput{}, err
+		}
+		filters = append(filters, filter)
+	}
+	filters, err := AddRequestFilters(request.Filters, entity, filters)
+	if err != nil {
+		return repoInterfaces.ListResourceInput{}, err
+	}
+
+	sortBy := request.SortBy
+	if sortBy == nil {
+		sortBy = defaultExecutionEventSort
+	}
+	sortParameter, err := common.NewSortParameter(sortBy, columns)
+	if err != nil {
+		return repoInterfaces.ListResourceInput{}, err
+	}
+
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
+	if err != nil {
+		return repoInterfaces.ListResourceInput{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for listing events of execution [%+v]", request.Token, request.ExecutionID)
+	}
+	return repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		Cursor:        cursor,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	}, nil
+}
+
+// GetOutboxEvents returns the outbox events publishing an execution event request to the enabled external and cloud
+// event sinks. No events are returned unless the events outbox is enabled, in which case the request is published by
+// the outbox relay rather than directly.
+func GetOutboxEvents(config runtimeInterfaces.ApplicationConfiguration, request proto.Message) (
+	[]models.OutboxEvent, error) {
+	if !config.GetTopLevelConfig().GetEventsOutboxConfig().Enabled {
+		return nil, nil
+	}
+	var sinks []string
+	if config.GetExternalEventsConfig().Enable {
+		sinks = append(sinks, models.OutboxSinkExternalEvents)
+	}
+	if config.GetCloudEventsConfig().Enable {
+		sinks = append(sinks, models.OutboxSinkCloudEvents)
+	}
+	return transformers.CreateOutboxEventModels(sinks, request)
+}
@@ -28,6 +28,19 @@ const (
 // Matches filters of the form `func(field,value)` or `func(field, value)`
 var filterRegex = regexp.MustCompile(`(.+)\((.+),\s?(.+)\)`)
 
+// Matches value-less filters of the form `func(field)`, e.g. `is_null(error_code)`.
+var nullFilterRegex = regexp.MustCompile(`^(.+)\(([^,]+)\)$`)
+
+var nullFilterFunctions = sets.NewString(common.IsNullExpression, common.NotNullExpression)
+
+// Filter groups combine the filters passed as arguments separated by commas, each of which may itself be several
+// filters joined by filterExpressionSeperator, e.g. `or(eq(phase,FAILED),not(eq(mode,0)+is_null(error_code)))`.
+const (
+	orFilterGroup           = "or"
+	notFilterGroup          = "not"
+	filterGroupArgSeparator = ","
+)
+
 // InlineFilter parsing consts. For example, matching on the filter string "contains(Name, foo)"
 // will return a slice consisting of: ["contains(Name, foo)", "contains", "Name", "foo"]
 const (
@@ -42,6 +55,8 @@ var timestampFields = map[string]bool{
 	"UpdatedAt": true,
 	"DeletedAt": true,
 	"StartedAt": true,
+	// Event timelines are usually filtered by when the events occurred.
+	"occurred_at": true,
 }
 
 var durationFields = map[string]bool{
@@ -122,6 +137,7 @@ func prepareValues(field string, values []string) (interface{}, error) {
 
 var allowedJoinEntities = map[common.Entity]sets.String{
 	common.Execution:           sets.NewString(common.Execution, common.LaunchPlan, common.Workflow, common.Task, common.AdminTag),
+	common.ExecutionEvent:      sets.NewString(common.ExecutionEvent),
 	common.LaunchPlan:          sets.NewString(common.LaunchPlan, common.Workflow),
 	common.NodeExecution:       sets.NewString(common.NodeExecution, common.Execution),
 	common.NodeExecutionEvent:  sets.NewString(common.NodeExecutionEvent),
@@ -137,6 +153,7 @@ var allowedJoinEntities = map[common.Entity]sets.String{
 
 var entityColumns = map[common.Entity]sets.String{
 	common.Execution:           models.ExecutionColumns,
+	common.ExecutionEvent:      models.ExecutionEventColumns,
 	common.LaunchPlan:          models.LaunchPlanColumns,
 	common.NodeExecution:       models.NodeExecutionColumns,
 	common.NodeExecutionEvent:  models.NodeExecutionEventColumns,
@@ -150,40 +167,124 @@ var entityColumns = map[common.Entity]sets.String{
 	common.AdminTag:            models.AdminTagColumns,
 }
 
-func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.InlineFilter, error) {
-	// Multiple filters can be appended as URI-escaped strings joined by filterExpressionSeperator
-	filterExpressions := strings.Split(filterParams, filterExpressionSeperator)
-	parsedFilters := make([]common.InlineFilter, 0)
-	for _, filterExpression := range filterExpressions {
-		// Parse string expression
-		matches := filterRegex.FindStringSubmatch(filterExpression)
-		if len(matches) != expectedMatchGroupLength {
-			// Poorly formatted filter string doesn't match expected regex.
-			return nil, shared.GetInvalidArgumentError(shared.Filters)
+// Splits the expression at the separators outside of parentheses.
+func splitFilterExpressions(expression, separator string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for idx, char := range expression {
+		switch {
+		case char == '(':
+			depth++
+		case char == ')':
+			depth--
+			if depth < 0 {
+				return nil, shared.GetInvalidArgumentError(shared.Filters)
+			}
+		case depth == 0 && strings.HasPrefix(expression[idx:], separator):
+			parts = append(parts, expression[start:idx])
+			start = idx + len(separator)
 		}
-		referencedEntity, field := parseField(matches[fieldMatchIndex], primaryEntity)
+	}
+	if depth != 0 {
+		return nil, shared.GetInvalidArgumentError(shared.Filters)
+	}
+	return append(parts, expression[start:]), nil
+}
 
-		joinEntities, ok := allowedJoinEntities[primaryEntity]
-		if !ok {
-			return nil, fmt.Errorf("unsupported entity '%s'", primaryEntity)
-		}
+// Parses a single filter on a column of the primary entity or of one of those it can be joined with.
+func parseFilter(function, rawField string, rawValues *string, primaryEntity common.Entity) (
+	common.InlineFilter, error) {
+	referencedEntity, field := parseField(rawField, primaryEntity)
 
-		if !joinEntities.Has(referencedEntity) {
-			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s' entity is not allowed in filters", referencedEntity)
-		}
+	joinEntities, ok := allowedJoinEntities[primaryEntity]
+	if !ok {
+		return nil, fmt.Errorf("unsupported entity '%s'", primaryEntity)
+	}
 
-		if !entityColumns[referencedEntity].Has(field) {
-			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s.%s' is invalid filter", referencedEntity, field)
-		}
+	if !joinEntities.Has(referencedEntity) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s' entity is not allowed in filters", referencedEntity)
+	}
 
-		// Parse and transform values
-		parsedValues := parseRepeatedValues(matches[valueMatchIndex])
-		preparedValues, err := prepareValues(field, parsedValues)
-		if err != nil {
-			return nil, err
+	if !entityColumns[referencedEntity].Has(field) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s.%s' is invalid filter", referencedEntity, field)
+	}
+
+	if rawValues == nil {
+		return common.NewInlineFilter(referencedEntity, function, field, nil)
+	}
+	// Parse and transform values
+	parsedValues := parseRepeatedValues(*rawValues)
+	preparedValues, err := prepareValues(field, parsedValues)
+	if err != nil {
+		return nil, err
+	}
+	// Create InlineFilter object.
+	return common.NewInlineFilter(referencedEntity, function, field, preparedValues)
+}
+
+// Parses filters joined by filterExpressionSeperator into a single filter, grouping them when there are several.
+func parseFilterConjunction(expression string, primaryEntity common.Entity) (common.InlineFilter, error) {
+	filters, err := parseFilterExpressions(expression, primaryEntity)
+	if err != nil {
+		return nil, err
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return common.NewAndFilterGroup(filters...)
+}
+
+func parseFilterExpression(filterExpression string, primaryEntity common.Entity) (common.InlineFilter, error) {
+	filterExpression = strings.TrimSpace(filterExpression)
+	if groupStart := strings.Index(filterExpression, "("); groupStart > 0 && strings.HasSuffix(filterExpression, ")") {
+		group := filterExpression[:groupStart]
+		if group == orFilterGroup || group == notFilterGroup {
+			args, err := splitFilterExpressions(
+				filterExpression[groupStart+1:len(filterExpression)-1], filterGroupArgSeparator)
+			if err != nil {
+				return nil, err
+			}
+			if (group == orFilterGroup && len(args) < 2) || (group == notFilterGroup && len(args) != 1) {
+				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"invalid number of arguments to filter group %s", group)
+			}
+			filters := make([]common.InlineFilter, 0, len(args))
+			for _, arg := range args {
+				filter, err := parseFilterConjunction(arg, primaryEntity)
+				if err != nil {
+					return nil, err
+				}
+				filters = append(filters, filter)
+			}
+			if group == orFilterGroup {
+				return common.NewOrFilterGroup(filters...)
+			}
+			return common.NewNotFilterGroup(filters...)
 		}
-		// Create InlineFilter object.
-		filter, err := common.NewInlineFilter(referencedEntity, matches[funcMatchIndex], field, preparedValues)
+	}
+
+	if matches := nullFilterRegex.FindStringSubmatch(filterExpression); len(matches) == 3 &&
+		nullFilterFunctions.Has(matches[funcMatchIndex]) {
+		return parseFilter(matches[funcMatchIndex], strings.TrimSpace(matches[fieldMatchIndex]), nil, primaryEntity)
+	}
+	// Parse string expression
+	matches := filterRegex.FindStringSubmatch(filterExpression)
+	if len(matches) != expectedMatchGroupLength || nullFilterFunctions.Has(matches[funcMatchIndex]) {
+		// Poorly formatted filter string doesn't match expected regex.
+		return nil, shared.GetInvalidArgumentError(shared.Filters)
+	}
+	return parseFilter(matches[funcMatchIndex], matches[fieldMatchIndex], &matches[valueMatchIndex], primaryEntity)
+}
+
+func parseFilterExpressions(filterParams string, primaryEntity common.Entity) ([]common.InlineFilter, error) {
+	// Multiple filters can be appended as URI-escaped strings joined by filterExpressionSeperator
+	filterExpressions, err := splitFilterExpressions(filterParams, filterExpressionSeperator)
+	if err != nil {
+		return nil, err
+	}
+	parsedFilters := make([]common.InlineFilter, 0, len(filterExpressions))
+	for _, filterExpression := range filterExpressions {
+		filter, err := parseFilterExpression(filterExpression, primaryEntity)
 		if err != nil {
 			return nil, err
 		}
@@ -192,6 +293,12 @@ func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.In
 	return parsedFilters, nil
 }
 
+// ParseFilters parses the filters of a request, which are joined by filterExpressionSeperator and may be grouped with
+// or(...) and not(...).
+func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.InlineFilter, error) {
+	return parseFilterExpressions(filterParams, primaryEntity)
+}
+
 func GetSingleValueEqualityFilter(entity common.Entity, field, value string) (common.InlineFilter, error) {
 	return common.NewSingleValueFilter(entity, common.Equal, field, value)
 }
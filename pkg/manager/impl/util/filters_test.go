@@ -118,6 +118,46 @@ func Test_ParseFilters_InvalidFilter(t *testing.T) {
 	assert.EqualError(t, err, "'t.foo' is invalid filter")
 }
 
+func Test_ParseFilters_Groups(t *testing.T) {
+	filterExpression := "eq(execution_project, flytesnacks)+or(eq(phase, FAILED), not(ne(mode, 0)+is_null(error_code)))+" +
+		"icontains(launch_plan.name, core)+not_null(started_at)"
+
+	executionFilters, err := ParseFilters(filterExpression, common.Execution)
+
+	assert.NoError(t, err)
+	require.Len(t, executionFilters, 4)
+
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "execution_project = ?", actualFilterExpression.Query)
+
+	actualFilterExpression, _ = executionFilters[1].GetGormQueryExpr()
+	assert.Equal(t, "(phase = ? OR NOT ((mode <> ? AND error_code IS NULL)))", actualFilterExpression.Query)
+	assert.Equal(t, []interface{}{"FAILED", "0"}, actualFilterExpression.GetArgs())
+
+	assert.Equal(t, common.LaunchPlan, executionFilters[2].GetEntity())
+	actualFilterExpression, _ = executionFilters[2].GetGormQueryExpr()
+	assert.Equal(t, `LOWER(name) LIKE ? ESCAPE '\'`, actualFilterExpression.Query)
+	assert.Equal(t, "%core%", actualFilterExpression.Args)
+
+	actualFilterExpression, _ = executionFilters[3].GetGormQueryExpr()
+	assert.Equal(t, "started_at IS NOT NULL", actualFilterExpression.Query)
+}
+
+func Test_ParseFilters_InvalidGroups(t *testing.T) {
+	for filterExpression, expectedErr := range map[string]string{
+		"or(eq(phase, FAILED)":                        "invalid value for filters",
+		"eq(phase, FAILED))":                          "invalid value for filters",
+		"or(eq(phase, FAILED))":                       "invalid number of arguments to filter group or",
+		"not(eq(phase, FAILED), is_null(error_code))": "invalid number of arguments to filter group not",
+		"or(eq(phase, FAILED), eq(foo, 1))":           "'e.foo' is invalid filter",
+		"eq(phase)":                                   "invalid value for filters",
+		"is_null(error_code, 1)":                      "invalid value for filters",
+	} {
+		_, err := ParseFilters(filterExpression, common.Execution)
+		assert.EqualError(t, err, expectedErr, filterExpression)
+	}
+}
+
 func TestGetEqualityFilter(t *testing.T) {
 	filter, err := GetSingleValueEqualityFilter(common.Task, "field", "value")
 	assert.NoError(t, err)
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// GetExecutionForNotifications returns the execution notification triggers are rendered with. The execution model is
+// fetched when it isn't passed in. Notifications are best effort, so when the execution can't be read only its
+// identifier is returned.
+func GetExecutionForNotifications(ctx context.Context, repo repoInterfaces.Repository,
+	executionModel *models.Execution, identifier *core.WorkflowExecutionIdentifier) *admin.Execution {
+	if executionModel == nil {
+		var err error
+		if executionModel, err = GetExecutionModel(ctx, repo, *identifier); err != nil {
+			logger.Warningf(ctx, "failed to get execution [%+v] for notifications with err: %v", identifier, err)
+			return &admin.Execution{Id: identifier}
+		}
+	}
+	execution, err := transformers.FromExecutionModel(ctx, *executionModel, transformers.DefaultExecutionTransformerOptions)
+	if err != nil {
+		logger.Warningf(ctx, "failed to transform execution [%+v] for notifications with err: %v", identifier, err)
+		return &admin.Execution{Id: identifier}
+	}
+	return execution
+}
+
+// PublishNotificationTriggers publishes the messages of the fired node or task notification triggers. Triggers are
+// configured by operators rather than in the execution spec, so failures are only logged and counted and never fail
+// the event.
+func PublishNotificationTriggers(ctx context.Context, publisher notificationInterfaces.Publisher,
+	config runtimeInterfaces.NotificationsConfig, triggers []runtimeInterfaces.NotificationTriggerConfig,
+	data notifications.TemplateData, publishNotificationError prometheus.Counter) {
+	for _, trigger := range triggers {
+		notificationType, message, err := notifications.ToMessageFromNotificationTrigger(config, trigger, data)
+		if err != nil {
+			publishNotificationError.Inc()
+			logger.Errorf(ctx, "failed to render notification trigger [%+v] with err: %v", trigger, err)
+			continue
+		}
+		if err := publisher.Publish(ctx, notificationType, message); err != nil {
+			publishNotificationError.Inc()
+			logger.Infof(ctx, "error publishing %s notification to %v with err: [%v]", notificationType,
+				message.RecipientsEmail, err)
+		}
+	}
+}
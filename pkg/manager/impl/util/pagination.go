@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+var modelSchemas = &sync.Map{}
+
+// Returns the cursor to the given model, or false when its list isn't sorted by a single key or its sort key column is
+// nullable or of a type cursors can't store, in which case the list can only be paged through by offset.
+func getPageCursor(sortParameter common.SortParameter, lastRow interface{}) (common.PageCursor, bool) {
+	if sortParameter == nil || len(sortParameter.GetSortKeys()) != 1 {
+		return common.PageCursor{}, false
+	}
+	sortKey := sortParameter.GetSortKeys()[0]
+	modelSchema, err := schema.Parse(lastRow, modelSchemas, schema.NamingStrategy{})
+	if err != nil {
+		return common.PageCursor{}, false
+	}
+	sortField, idField := modelSchema.LookUpField(sortKey.Key), modelSchema.LookUpField("id")
+	if sortField == nil || idField == nil || sortField.FieldType.Kind() == reflect.Ptr {
+		return common.PageCursor{}, false
+	}
+	row := reflect.ValueOf(lastRow)
+	sortValue, _ := sortField.ValueOf(context.Background(), row)
+	sortValue, ok := common.NewPageCursorValue(sortValue)
+	if !ok {
+		return common.PageCursor{}, false
+	}
+	id, _ := idField.ValueOf(context.Background(), row)
+	idValue, ok := id.(uint)
+	if !ok {
+		return common.PageCursor{}, false
+	}
+	return common.PageCursor{
+		SortKey:   sortKey.Key,
+		Direction: sortKey.Direction,
+		SortValue: sortValue,
+		ID:        idValue,
+	}, true
+}
+
+// GetPageToken returns the token for the page following a full page of rows listed with the input, given the model
+// of its last row. The token is a cursor to that row whenever the sort order allows, so that the next page is found
+// by seeking past it rather than by skipping over all the preceding rows.
+func GetPageToken(input repoInterfaces.ListResourceInput, rows int, lastRow interface{}) string {
+	if cursor, ok := getPageCursor(input.SortParameter, lastRow); ok {
+		if token, err := common.EncodePageCursor(cursor); err == nil {
+			return token
+		}
+	}
+	return strconv.Itoa(input.Offset + rows)
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+func TestGetPageToken(t *testing.T) {
+	createdAt := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+	startedAt := createdAt.Add(time.Minute)
+	execution := models.Execution{
+		BaseModel: models.BaseModel{
+			ID:        42,
+			CreatedAt: createdAt,
+		},
+		ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+		StartedAt:    &startedAt,
+		Duration:     time.Hour,
+	}
+	newInput := func(key string) repoInterfaces.ListResourceInput {
+		sortParameter, err := common.NewSortParameter(&admin.Sort{
+			Key:       key,
+			Direction: admin.Sort_DESCENDING,
+		}, models.ExecutionColumns)
+		assert.NoError(t, err)
+		return repoInterfaces.ListResourceInput{Limit: 2, Offset: 4, SortParameter: sortParameter}
+	}
+
+	t.Run("cursor", func(t *testing.T) {
+		for key, value := range map[string]interface{}{
+			"created_at":     createdAt,
+			"execution_name": "name",
+			"duration":       int64(time.Hour),
+		} {
+			cursor, err := common.DecodePageCursor(GetPageToken(newInput(key), 2, execution))
+			assert.NoError(t, err)
+			assert.Equal(t, common.PageCursor{
+				SortKey:   key,
+				Direction: admin.Sort_DESCENDING,
+				SortValue: value,
+				ID:        42,
+			}, cursor)
+		}
+	})
+	t.Run("nullable sort key", func(t *testing.T) {
+		assert.Equal(t, "6", GetPageToken(newInput("started_at"), 2, execution))
+	})
+	t.Run("unsorted", func(t *testing.T) {
+		assert.Equal(t, "6", GetPageToken(repoInterfaces.ListResourceInput{Limit: 2, Offset: 4}, 2, execution))
+	})
+}
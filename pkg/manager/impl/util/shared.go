@@ -206,6 +206,42 @@ func ListActiveLaunchPlanVersionsFilters(project, domain string) ([]common.Inlin
 	return []common.InlineFilter{projectFilter, domainFilter, activeFilter}, nil
 }
 
+// ListExecutionsByName returns the executions of the project and domain with the names, only those in the phases unless
+// none are given.
+func ListExecutionsByName(ctx context.Context, repo repoInterfaces.Repository, project, domain string, names,
+	phases []string) ([]models.Execution, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	filters, err := GetDbFilters(FilterSpec{
+		Project: project,
+		Domain:  domain,
+	}, common.Execution)
+	if err != nil {
+		return nil, err
+	}
+	nameFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, shared.Name, names)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, nameFilter)
+	if len(phases) > 0 {
+		phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, shared.Phase, phases)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, phaseFilter)
+	}
+	output, err := repo.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         len(names),
+		InlineFilters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Executions, nil
+}
+
 func GetExecutionModel(
 	ctx context.Context, repo repoInterfaces.Repository, identifier core.WorkflowExecutionIdentifier) (
 	*models.Execution, error) {
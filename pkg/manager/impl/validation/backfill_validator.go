@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+func ValidateBackfillCreateRequest(request interfaces.BackfillCreateRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	// Backfills are named like executions.
+	if len(request.Name) > 0 {
+		if err := CheckValidExecutionID(request.Name, shared.Name); err != nil {
+			return err
+		}
+	}
+	if err := ValidateEmptyStringField(request.LaunchPlan, shared.LaunchPlan); err != nil {
+		return err
+	}
+	if request.StartTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.StartTime)
+	}
+	if request.EndTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.EndTime)
+	}
+	if request.EndTime.Before(request.StartTime) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must not be before %s", shared.EndTime,
+			shared.StartTime)
+	}
+	// Times to come are launched by the schedule itself, backfilling them would launch their executions twice.
+	if request.EndTime.After(time.Now()) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must not be in the future", shared.EndTime)
+	}
+	return nil
+}
+
+func ValidateBackfillIdentifier(id interfaces.BackfillIdentifier) error {
+	if err := ValidateEmptyStringField(id.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(id.Domain, shared.Domain); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(id.Name, shared.Name)
+}
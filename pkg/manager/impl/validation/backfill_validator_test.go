@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getBackfillCreateRequestForTest() interfaces.BackfillCreateRequest {
+	return interfaces.BackfillCreateRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Name:       "rerun-daily",
+		LaunchPlan: "launch_plan",
+		StartTime:  time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2023, time.October, 30, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestValidateBackfillCreateRequest(t *testing.T) {
+	assert.Nil(t, ValidateBackfillCreateRequest(getBackfillCreateRequestForTest()))
+
+	t.Run("generated name", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.Name = ""
+		assert.Nil(t, ValidateBackfillCreateRequest(request))
+	})
+	t.Run("invalid name", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.Name = "Rerun_Daily"
+		err := ValidateBackfillCreateRequest(request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("missing launch plan", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.LaunchPlan = ""
+		err := ValidateBackfillCreateRequest(request)
+		assert.EqualError(t, err, "missing launch_plan")
+	})
+	t.Run("missing end time", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.EndTime = time.Time{}
+		err := ValidateBackfillCreateRequest(request)
+		assert.EqualError(t, err, "missing end_time")
+	})
+	t.Run("end before start", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.EndTime = request.StartTime.Add(-time.Hour)
+		err := ValidateBackfillCreateRequest(request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("end in the future", func(t *testing.T) {
+		request := getBackfillCreateRequestForTest()
+		request.EndTime = time.Now().Add(time.Hour)
+		err := ValidateBackfillCreateRequest(request)
+		assert.EqualError(t, err, "end_time must not be in the future")
+	})
+}
+
+func TestValidateBackfillIdentifier(t *testing.T) {
+	assert.Nil(t, ValidateBackfillIdentifier(interfaces.BackfillIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "rerun-daily",
+	}))
+	err := ValidateBackfillIdentifier(interfaces.BackfillIdentifier{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.EqualError(t, err, "missing name")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -21,6 +22,22 @@ const allowedExecutionNameLength = 63
 
 var executionIDRegex = regexp.MustCompile(`^[a-z][a-z\-0-9]*$`)
 
+var aggregatableExecutionGroupBys = map[repositoryInterfaces.ExecutionGroupBy]bool{
+	repositoryInterfaces.ExecutionGroupByPhase:      true,
+	repositoryInterfaces.ExecutionGroupByLaunchPlan: true,
+	repositoryInterfaces.ExecutionGroupByWorkflow:   true,
+	repositoryInterfaces.ExecutionGroupByUser:       true,
+	repositoryInterfaces.ExecutionGroupByCluster:    true,
+	repositoryInterfaces.ExecutionGroupByErrorKind:  true,
+	repositoryInterfaces.ExecutionGroupByTime:       true,
+}
+
+var aggregatableExecutionTimeBuckets = map[repositoryInterfaces.ExecutionTimeBucket]bool{
+	repositoryInterfaces.ExecutionTimeBucketHour: true,
+	repositoryInterfaces.ExecutionTimeBucketDay:  true,
+	repositoryInterfaces.ExecutionTimeBucketWeek: true,
+}
+
 var acceptedReferenceLaunchTypes = map[core.ResourceType]interface{}{
 	core.ResourceType_LAUNCH_PLAN: nil,
 	core.ResourceType_TASK:        nil,
@@ -166,3 +183,38 @@ func ValidateWorkflowExecutionIdentifier(identifier *core.WorkflowExecutionIdent
 	}
 	return nil
 }
+
+func ValidateExecutionEventListRequest(request interfaces.ExecutionEventListRequest) error {
+	if err := ValidateWorkflowExecutionIdentifier(request.ExecutionID); err != nil {
+		return shared.GetMissingArgumentError(shared.ExecutionID)
+	}
+	if err := ValidateLimit(request.Limit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func ValidateExecutionAggregationRequest(request interfaces.ExecutionAggregationRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(request.GroupBy))
+	for _, groupBy := range request.GroupBy {
+		if !aggregatableExecutionGroupBys[repositoryInterfaces.ExecutionGroupBy(groupBy)] || seen[groupBy] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s value %s", shared.GroupBy, groupBy)
+		}
+		seen[groupBy] = true
+	}
+	if len(request.TimeBucket) > 0 &&
+		!aggregatableExecutionTimeBuckets[repositoryInterfaces.ExecutionTimeBucket(request.TimeBucket)] {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s value %s", shared.TimeBucket,
+			request.TimeBucket)
+	}
+	if err := ValidateLimit(request.Limit); err != nil {
+		return err
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
@@ -223,3 +224,55 @@ func TestValidateWorkflowExecutionIdentifier_Error(t *testing.T) {
 		Name:   "name",
 	}))
 }
+
+func TestValidateExecutionEventListRequest(t *testing.T) {
+	request := interfaces.ExecutionEventListRequest{
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Limit: 10,
+	}
+	assert.NoError(t, ValidateExecutionEventListRequest(request))
+
+	request.Limit = 0
+	assert.EqualError(t, ValidateExecutionEventListRequest(request), "invalid value for limit")
+
+	request.Limit = 10
+	request.ExecutionID = &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain"}
+	assert.EqualError(t, ValidateExecutionEventListRequest(request), "missing execution_id")
+}
+
+func TestValidateExecutionAggregationRequest(t *testing.T) {
+	request := interfaces.ExecutionAggregationRequest{
+		Project:    "project",
+		Domain:     "domain",
+		GroupBy:    []string{"phase", "time"},
+		TimeBucket: "week",
+		Limit:      10,
+	}
+	assert.NoError(t, ValidateExecutionAggregationRequest(request))
+
+	request.GroupBy = nil
+	request.TimeBucket = ""
+	assert.NoError(t, ValidateExecutionAggregationRequest(request))
+
+	request.Domain = ""
+	assert.EqualError(t, ValidateExecutionAggregationRequest(request), "missing domain")
+
+	request.Domain = "domain"
+	request.GroupBy = []string{"phase", "node"}
+	assert.EqualError(t, ValidateExecutionAggregationRequest(request), "invalid group_by value node")
+
+	request.GroupBy = []string{"phase", "phase"}
+	assert.EqualError(t, ValidateExecutionAggregationRequest(request), "invalid group_by value phase")
+
+	request.GroupBy = nil
+	request.TimeBucket = "month"
+	assert.EqualError(t, ValidateExecutionAggregationRequest(request), "invalid time_bucket value month")
+
+	request.TimeBucket = ""
+	request.Limit = 0
+	assert.EqualError(t, ValidateExecutionAggregationRequest(request), "invalid value for limit")
+}
@@ -2,12 +2,14 @@ package validation
 
 import (
 	"context"
+	"time"
 
 	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -63,8 +65,42 @@ func ValidateLaunchPlan(ctx context.Context,
 	return nil
 }
 
+// Bounds of the launch plan schedule descriptions, which are computed in memory.
+const (
+	maxScheduleNextCount     = 100
+	maxScheduleHistoryWindow = 7 * 24 * time.Hour
+)
+
+func ValidateLaunchPlanScheduleRequest(request interfaces.LaunchPlanScheduleRequest) error {
+	if err := ValidateEmptyStringField(request.ID.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.ID.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.ID.Name, shared.Name); err != nil {
+		return err
+	}
+	if request.NextCount < 0 || request.NextCount > maxScheduleNextCount {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be between 0 and %d", shared.Next,
+			maxScheduleNextCount)
+	}
+	if request.HistoryWindow < 0 || request.HistoryWindow > maxScheduleHistoryWindow {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be between 0 and %v", shared.History,
+			maxScheduleHistoryWindow)
+	}
+	return nil
+}
+
 func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
 	schedule := request.GetSpec().GetEntityMetadata().GetSchedule()
+	// Cron schedules may be prefixed by the time zone they're evaluated in, which must be a valid IANA time zone. The
+	// scheduler's cron parser panics on a prefix that isn't followed by an expression, so those are rejected here.
+	for _, cronExpression := range []string{schedule.GetCronExpression(), schedule.GetCronSchedule().GetSchedule()} {
+		if _, _, err := common.SplitCronTimeZone(cronExpression); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Invalid cron schedule: %v", err)
+		}
+	}
 	if schedule.GetCronExpression() != "" || schedule.GetRate() != nil {
 		for key, value := range expectedInputs.Parameters {
 			if value.GetRequired() && key != schedule.GetKickoffTimeInputArg() {
@@ -3,14 +3,18 @@ package validation
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
 	"github.com/flyteorg/flyteidl/clients/go/coreutils"
 
+	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var lpApplicationConfig = testutils.GetApplicationConfigWithDefaultDomains()
@@ -294,6 +298,33 @@ func TestValidateSchedule_NoSchedule(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidateSchedule_TimeZone(t *testing.T) {
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{},
+	}
+	t.Run("valid time zone", func(t *testing.T) {
+		request := testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=Europe/Berlin 0 9 * * ? *")
+		assert.Nil(t, validateSchedule(request, inputMap))
+	})
+	t.Run("invalid time zone", func(t *testing.T) {
+		request := testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=Europe/Nowhere 0 9 * * ? *")
+		err := validateSchedule(request, inputMap)
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("invalid time zone in cron schedule", func(t *testing.T) {
+		request := testutils.GetLaunchPlanRequestWithCronSchedule("")
+		request.Spec.EntityMetadata.Schedule.ScheduleExpression = &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{Schedule: "TZ=Mars/Olympus 0 9 * * *"},
+		}
+		assert.NotNil(t, validateSchedule(request, inputMap))
+	})
+	t.Run("missing expression", func(t *testing.T) {
+		request := testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=Europe/Berlin")
+		assert.NotNil(t, validateSchedule(request, inputMap))
+	})
+}
+
 func TestValidateSchedule_ArgNotFixed(t *testing.T) {
 	request := testutils.GetLaunchPlanRequestWithCronSchedule("* * * * * *")
 	inputMap := &core.ParameterMap{
@@ -382,3 +413,37 @@ func TestValidateSchedule_KickoffTimeBound(t *testing.T) {
 	err := validateSchedule(request, inputMap)
 	assert.Nil(t, err)
 }
+
+func TestValidateLaunchPlanScheduleRequest(t *testing.T) {
+	validRequest := func() interfaces.LaunchPlanScheduleRequest {
+		return interfaces.LaunchPlanScheduleRequest{
+			ID: core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			NextCount:     10,
+			HistoryWindow: time.Hour,
+		}
+	}
+	assert.Nil(t, ValidateLaunchPlanScheduleRequest(validRequest()))
+
+	t.Run("missing name", func(t *testing.T) {
+		request := validRequest()
+		request.ID.Name = ""
+		err := ValidateLaunchPlanScheduleRequest(request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("next count out of range", func(t *testing.T) {
+		request := validRequest()
+		request.NextCount = maxScheduleNextCount + 1
+		err := ValidateLaunchPlanScheduleRequest(request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+	t.Run("negative history window", func(t *testing.T) {
+		request := validRequest()
+		request.HistoryWindow = -time.Hour
+		err := ValidateLaunchPlanScheduleRequest(request)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+// Bounds the cost of matching a query, each word is matched separately.
+const maxSearchTerms = 10
+
+var searchableResourceTypes = map[core.ResourceType]bool{
+	core.ResourceType_TASK:        true,
+	core.ResourceType_WORKFLOW:    true,
+	core.ResourceType_LAUNCH_PLAN: true,
+}
+
+// GetSearchTerms splits the search query into its distinct lowercase words, which are made of letters and digits.
+func GetSearchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func ValidateSearchRequest(request interfaces.SearchRequest) error {
+	terms := GetSearchTerms(request.Query)
+	if len(terms) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "search query must contain at least one word")
+	}
+	if len(terms) > maxSearchTerms {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"search query must contain at most %d distinct words", maxSearchTerms)
+	}
+	for _, resourceType := range request.ResourceTypes {
+		if !searchableResourceTypes[resourceType] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unsupported resource type to search: %s",
+				resourceType)
+		}
+	}
+	return ValidateLimit(request.Limit)
+}
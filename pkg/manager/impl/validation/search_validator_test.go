@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+func TestGetSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"core", "hello", "world", "v2"}, GetSearchTerms("core.Hello_world  hello-world v2"))
+	assert.Empty(t, GetSearchTerms(" ._- "))
+}
+
+func TestValidateSearchRequest(t *testing.T) {
+	assert.NoError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query:         "hello world",
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK, core.ResourceType_LAUNCH_PLAN},
+		Limit:         10,
+	}))
+
+	assert.EqualError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query: "*",
+		Limit: 10,
+	}), "search query must contain at least one word")
+	assert.NoError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query: strings.Repeat("hello ", 20),
+		Limit: 10,
+	}))
+	assert.EqualError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query: "a b c d e f g h i j k",
+		Limit: 10,
+	}), "search query must contain at most 10 distinct words")
+	assert.EqualError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query:         "hello",
+		ResourceTypes: []core.ResourceType{core.ResourceType_DATASET},
+		Limit:         10,
+	}), "unsupported resource type to search: DATASET")
+	assert.EqualError(t, ValidateSearchRequest(interfaces.SearchRequest{
+		Query: "hello",
+	}), "invalid value for limit")
+}
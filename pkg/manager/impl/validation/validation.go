@@ -295,6 +295,26 @@ func ValidateToken(token string) (int, error) {
 	return offset, nil
 }
 
+// ValidatePageToken parses the token of a list page, which is either a cursor to the last row of the previous page
+// sorted by the given sort parameter or, as returned by earlier versions, the offset of the page.
+func ValidatePageToken(token string, sortParameter common.SortParameter) (int, *common.PageCursor, error) {
+	if _, err := strconv.Atoi(token); token == "" || err == nil {
+		offset, err := ValidateToken(token)
+		return offset, nil, err
+	}
+	cursor, err := common.DecodePageCursor(token)
+	if err != nil {
+		return 0, nil, err
+	}
+	// Cursors are only issued for results sorted by a single key.
+	if sortParameter == nil || len(sortParameter.GetSortKeys()) != 1 ||
+		sortParameter.GetSortKeys()[0] != (common.SortKey{Key: cursor.SortKey, Direction: cursor.Direction}) {
+		return 0, nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"Invalid token value: %s doesn't match the requested sort order", token)
+	}
+	return 0, &cursor, nil
+}
+
 func ValidateLimit(limit uint32) error {
 	if limit == 0 {
 		return shared.GetInvalidArgumentError(shared.Limit)
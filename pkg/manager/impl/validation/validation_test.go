@@ -16,6 +16,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func TestGetMissingArgumentError(t *testing.T) {
@@ -338,6 +339,58 @@ func TestValidateToken(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestValidatePageToken(t *testing.T) {
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	}, sets.NewString("created_at", "name"))
+	assert.NoError(t, err)
+
+	offset, cursor, err := ValidatePageToken("", sortParameter)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, offset)
+	assert.Nil(t, cursor)
+
+	// Legacy offset tokens are still accepted.
+	offset, cursor, err = ValidatePageToken("40", sortParameter)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, offset)
+	assert.Nil(t, cursor)
+	_, _, err = ValidatePageToken("-1", sortParameter)
+	assert.Error(t, err)
+
+	createdAt := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+	token, err := common.EncodePageCursor(common.PageCursor{
+		SortKey:   "created_at",
+		Direction: admin.Sort_DESCENDING,
+		SortValue: createdAt,
+		ID:        7,
+	})
+	assert.NoError(t, err)
+	offset, cursor, err = ValidatePageToken(token, sortParameter)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, offset)
+	assert.Equal(t, &common.PageCursor{
+		SortKey:   "created_at",
+		Direction: admin.Sort_DESCENDING,
+		SortValue: createdAt,
+		ID:        7,
+	}, cursor)
+
+	_, _, err = ValidatePageToken("foo", sortParameter)
+	assert.Error(t, err)
+
+	otherSortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "name",
+		Direction: admin.Sort_DESCENDING,
+	}, sets.NewString("created_at", "name"))
+	assert.NoError(t, err)
+	_, _, err = ValidatePageToken(token, otherSortParameter)
+	assert.Error(t, err)
+	_, _, err = ValidatePageToken(token, nil)
+	assert.Error(t, err)
+}
+
 func TestValidateActiveLaunchPlanRequest(t *testing.T) {
 	err := ValidateActiveLaunchPlanRequest(
 		admin.ActiveLaunchPlanRequest{
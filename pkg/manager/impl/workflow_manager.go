@@ -211,6 +211,7 @@ func (w *WorkflowManager) CreateWorkflow(
 	if descriptionModel != nil {
 		workflowModel.ShortDescription = descriptionModel.ShortDescription
 	}
+	workflowModel.SearchDocument = transformers.CreateWorkflowSearchDocument(finalizedRequest)
 	if err = w.db.WorkflowRepo().Create(ctx, workflowModel, descriptionModel); err != nil {
 		logger.Infof(ctx, "Failed to create workflow model [%+v] with err %v", request.Id, err)
 		return nil, err
@@ -258,7 +259,7 @@ func (w *WorkflowManager) ListWorkflows(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
+	offset, cursor, err := validation.ValidatePageToken(request.Token, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListWorkflows", request.Token)
@@ -266,6 +267,7 @@ func (w *WorkflowManager) ListWorkflows(
 	listWorkflowsInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.Limit),
 		Offset:        offset,
+		Cursor:        cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -282,7 +284,7 @@ func (w *WorkflowManager) ListWorkflows(
 	}
 	var token string
 	if len(output.Workflows) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(output.Workflows))
+		token = util.GetPageToken(listWorkflowsInput, len(output.Workflows), output.Workflows[len(output.Workflows)-1])
 	}
 	return &admin.WorkflowList{
 		Workflows: workflowList,
@@ -342,6 +344,63 @@ func (w *WorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request a
 
 }
 
+// Hard deletes a workflow version along with its offloaded closure, provided no launch plans reference it and no
+// running executions were launched from it.
+func (w *WorkflowManager) DeleteWorkflow(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	principal, err := authorizeEntityDeletion(
+		ctx, w.config.ApplicationConfiguration().GetTopLevelConfig().GetEntityDeletionConfig())
+	if err != nil {
+		return err
+	}
+	if err := validation.ValidateIdentifier(&request.ID, common.Workflow); err != nil {
+		return err
+	}
+	ctx = getWorkflowContext(ctx, &request.ID)
+	workflowModel, err := util.GetWorkflowModel(ctx, w.db, request.ID)
+	if err != nil {
+		return err
+	}
+	workflowFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, "workflow_id", workflowModel.ID)
+	if err != nil {
+		return err
+	}
+	output, err := w.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: []common.InlineFilter{workflowFilter},
+	})
+	if err != nil {
+		logger.Errorf(ctx, "Failed to list launch plans of workflow [%+v] with err: %v", request.ID, err)
+		return err
+	}
+	if len(output.LaunchPlans) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"cannot delete [%+v], it is referenced by launch plans which must be deleted first", request.ID)
+	}
+	if err := util.ValidateNoRunningExecutions(ctx, w.db, request.ID, "workflow_id", workflowModel.ID); err != nil {
+		return err
+	}
+	err = w.db.WorkflowRepo().Delete(ctx, repoInterfaces.Identifier{
+		Project: request.ID.Project,
+		Domain:  request.ID.Domain,
+		Name:    request.ID.Name,
+		Version: request.ID.Version,
+	}, util.NewDeletionAuditEntry(request.ID, principal, request.Reason))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to delete workflow [%+v] with err: %v", request.ID, err)
+		return err
+	}
+	logger.Infof(ctx, "[%s] deleted workflow [%+v]: %s", principal, request.ID, request.Reason)
+	// The workflow is gone regardless, so failing here would only have retries fail with NotFound. The orphaned closure
+	// is harmless and left behind.
+	if len(workflowModel.RemoteClosureIdentifier) > 0 {
+		if err := w.storageClient.Delete(ctx, storage.DataReference(workflowModel.RemoteClosureIdentifier)); err != nil {
+			logger.Errorf(ctx, "Failed to delete closure [%s] of deleted workflow [%+v] with err: %v",
+				workflowModel.RemoteClosureIdentifier, request.ID, err)
+		}
+	}
+	return nil
+}
+
 func NewWorkflowManager(
 	db repoInterfaces.Repository,
 	config runtimeInterfaces.Configuration,
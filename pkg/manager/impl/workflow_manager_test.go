@@ -10,6 +10,7 @@ import (
 	commonMocks "github.com/flyteorg/flyteadmin/pkg/common/mocks"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -587,3 +588,103 @@ func TestWorkflowManager_ListWorkflowIdentifiers(t *testing.T) {
 		assert.Equal(t, nameValue, entity.Name)
 	}
 }
+
+func getMockRepositoryForWorkflowDeletion() interfaces.Repository {
+	mockRepository := getMockRepository(returnWorkflowOnGet)
+	mockRepository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Workflow, error) {
+			return models.Workflow{
+				BaseModel:               models.BaseModel{ID: 3},
+				RemoteClosureIdentifier: remoteClosureIdentifier,
+			}, nil
+		})
+	return mockRepository
+}
+
+func TestDeleteWorkflow(t *testing.T) {
+	mockRepository := getMockRepositoryForWorkflowDeletion()
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			assert.Equal(t, 1, input.Limit)
+			expr, _ := input.InlineFilters[0].GetGormQueryExpr()
+			assert.Equal(t, "workflow_id = ?", expr.Query)
+			assert.Equal(t, uint(3), expr.Args)
+			return interfaces.LaunchPlanCollectionOutput{}, nil
+		})
+	var deleted bool
+	mockRepository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			deleted = true
+			assert.Equal(t, "version", input.Version)
+			assert.Equal(t, core.ResourceType_WORKFLOW, auditEntry.ResourceType)
+			assert.Equal(t, entityDeletionAdmin, auditEntry.Principal)
+			return nil
+		})
+	mockStorage := getMockStorage()
+	var closureDeleted bool
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).DeleteCb =
+		func(ctx context.Context, reference storage.DataReference) error {
+			closureDeleted = true
+			assert.Equal(t, remoteClosureIdentifier, reference.String())
+			return nil
+		}
+	config := getMockWorkflowConfigProvider()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	workflowManager := NewWorkflowManager(
+		mockRepository, config, getMockWorkflowCompiler(), mockStorage, storagePrefix, mockScope.NewTestScope())
+	err := workflowManager.DeleteWorkflow(getEntityDeletionContext(t, entityDeletionAdmin, ""),
+		managerInterfaces.EntityDeleteRequest{ID: workflowIdentifier})
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.True(t, closureDeleted)
+}
+
+func TestDeleteWorkflow_ReferencedByLaunchPlans(t *testing.T) {
+	mockRepository := getMockRepositoryForWorkflowDeletion()
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{{}},
+			}, nil
+		})
+	mockRepository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			assert.Fail(t, "workflows referenced by launch plans shouldn't be deleted")
+			return nil
+		})
+	config := getMockWorkflowConfigProvider()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	workflowManager := NewWorkflowManager(
+		mockRepository, config, getMockWorkflowCompiler(), getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	err := workflowManager.DeleteWorkflow(getEntityDeletionContext(t, entityDeletionAdmin, ""),
+		managerInterfaces.EntityDeleteRequest{ID: workflowIdentifier})
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteWorkflow_ClosureDeletionError(t *testing.T) {
+	mockRepository := getMockRepositoryForWorkflowDeletion()
+	mockRepository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{}, nil
+		})
+	var deleted bool
+	mockRepository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetDeleteCallback(
+		func(input interfaces.Identifier, auditEntry models.AuditEntry) error {
+			deleted = true
+			return nil
+		})
+	mockStorage := getMockStorage()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).DeleteCb =
+		func(ctx context.Context, reference storage.DataReference) error {
+			return errors.New("expected error")
+		}
+	config := getMockWorkflowConfigProvider()
+	enableEntityDeletion(config, entityDeletionAdmin)
+	workflowManager := NewWorkflowManager(
+		mockRepository, config, getMockWorkflowCompiler(), mockStorage, storagePrefix, mockScope.NewTestScope())
+	err := workflowManager.DeleteWorkflow(getEntityDeletionContext(t, entityDeletionAdmin, ""),
+		managerInterfaces.EntityDeleteRequest{ID: workflowIdentifier})
+	// The workflow was deleted regardless of its closure.
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,74 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+//go:generate mockery -name=BackfillInterface -output=../mocks -case=underscore
+
+// BackfillCreateRequest launches an execution of a scheduled launch plan for each of the times its schedule is
+// scheduled at over a time range.
+type BackfillCreateRequest struct {
+	// The project and domain of both the backfill and the launch plan.
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Optional, generated when unset. Backfills are unique by name within a project and domain, so a retried request
+	// naming its backfill doesn't launch the executions twice.
+	Name string `json:"name"`
+	// The launch plan whose schedule is backfilled, its active version unless a version is given.
+	LaunchPlan        string `json:"launchPlan"`
+	LaunchPlanVersion string `json:"launchPlanVersion"`
+	// Executions are launched for the times the schedule is scheduled at from the start time through the end time,
+	// which must not be in the future.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Optional, the maximum number of executions of the backfill running at a time.
+	MaxConcurrency uint32 `json:"maxConcurrency"`
+}
+
+type BackfillIdentifier struct {
+	Project string
+	Domain  string
+	Name    string
+}
+
+// Backfill describes the progress of a backfill.
+type Backfill struct {
+	Project        string          `json:"project"`
+	Domain         string          `json:"domain"`
+	Name           string          `json:"name"`
+	LaunchPlanID   core.Identifier `json:"launchPlanId"`
+	CronExpression string          `json:"cronExpression,omitempty"`
+	FixedRateValue uint32          `json:"fixedRateValue,omitempty"`
+	FixedRateUnit  string          `json:"fixedRateUnit,omitempty"`
+	StartTime      time.Time       `json:"startTime"`
+	EndTime        time.Time       `json:"endTime"`
+	MaxConcurrency uint32          `json:"maxConcurrency"`
+	// One of RUNNING, SUCCEEDED, FAILED or CANCELLED.
+	State string `json:"state"`
+	// Why the backfill failed, if it did.
+	Message   string `json:"message,omitempty"`
+	Principal string `json:"principal,omitempty"`
+	// The number of times the schedule is scheduled at over the range, and the number of executions launched so far.
+	ScheduledTimes int `json:"scheduledTimes"`
+	Launched       int `json:"launched"`
+	// The executions launched so far, oldest scheduled time first.
+	Executions []ScheduledExecution `json:"executions"`
+	CreatedAt  time.Time            `json:"createdAt"`
+	UpdatedAt  time.Time            `json:"updatedAt"`
+}
+
+// BackfillInterface for managing backfills of scheduled launch plans.
+type BackfillInterface interface {
+	// Creates a backfill, whose executions are launched in the background by LaunchBackfills.
+	CreateBackfill(ctx context.Context, request BackfillCreateRequest) (*Backfill, error)
+	GetBackfill(ctx context.Context, id BackfillIdentifier) (*Backfill, error)
+	// Stops launching the executions of a running backfill, and terminates those of its executions still running.
+	CancelBackfill(ctx context.Context, id BackfillIdentifier) (*Backfill, error)
+	// Launches the next executions of the running backfills, as long as fewer than their maximum concurrency are
+	// running. Backfills succeed once all their executions were launched and are terminal.
+	LaunchBackfills(ctx context.Context) error
+}
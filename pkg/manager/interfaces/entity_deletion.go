@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// EntityDeleteRequest hard deletes a task, workflow or launch plan version.
+type EntityDeleteRequest struct {
+	ID core.Identifier
+	// Why the version is deleted, recorded in its audit entry.
+	Reason string
+}
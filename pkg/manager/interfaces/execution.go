@@ -28,4 +28,8 @@ type ExecutionInterface interface {
 	ListExecutions(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 	TerminateExecution(
 		ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+	// Lists the recorded phase transitions of a workflow execution.
+	ListExecutionEvents(ctx context.Context, request ExecutionEventListRequest) (*ExecutionEventList, error)
+	// Returns the counts and duration percentiles of workflow executions, grouped as requested.
+	AggregateExecutions(ctx context.Context, request ExecutionAggregationRequest) (*ExecutionAggregationList, error)
 }
@@ -0,0 +1,39 @@
+package interfaces
+
+// ExecutionAggregationRequest selects the workflow executions of a project and domain to aggregate. flyteidl has no
+// messages for aggregating executions, so the statistics are served as JSON.
+type ExecutionAggregationRequest struct {
+	Project string
+	Domain  string
+	Filters string
+	// Dimensions to group by, one of phase, launch_plan, workflow, user, cluster, error_kind or time. All matching
+	// executions are aggregated into a single group when empty.
+	GroupBy []string
+	// Granularity of the time groups, one of hour, day or week. Defaults to day.
+	TimeBucket string
+	// The maximum number of groups to return.
+	Limit uint32
+}
+
+// ExecutionDurationPercentiles are the percentiles, in seconds, of the durations of completed executions.
+type ExecutionDurationPercentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
+// ExecutionAggregate is the aggregation of a group of executions.
+type ExecutionAggregate struct {
+	// The values of the group for each dimension grouped by. Times are the start of the bucket, formatted as RFC3339
+	// in UTC.
+	Group map[string]string `json:"group,omitempty"`
+	Count int64             `json:"count"`
+	// Unset when none of the executions of the group completed, or when the database doesn't support computing
+	// percentiles.
+	Durations *ExecutionDurationPercentiles `json:"durations,omitempty"`
+}
+
+type ExecutionAggregationList struct {
+	// Ordered by the group values.
+	Aggregates []ExecutionAggregate `json:"aggregates"`
+}
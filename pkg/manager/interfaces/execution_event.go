@@ -0,0 +1,39 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// ExecutionEventListRequest selects the recorded events of a workflow execution, or of its node executions. flyteidl
+// has no messages for listing events, so this mirrors admin.NodeExecutionListRequest.
+type ExecutionEventListRequest struct {
+	ExecutionID *core.WorkflowExecutionIdentifier
+	// Only applies to node execution events, restricts them to those of the node when set.
+	NodeID  string
+	Limit   uint32
+	Token   string
+	Filters string
+	// Events are ordered by when they occurred, oldest first, unless set.
+	SortBy *admin.Sort
+}
+
+// ExecutionEvent is a phase transition recorded for a workflow execution or node execution.
+type ExecutionEvent struct {
+	ExecutionID *core.WorkflowExecutionIdentifier `json:"execution_id"`
+	// Only set for node execution events.
+	NodeID     string    `json:"node_id,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Phase      string    `json:"phase"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// When the event was recorded by admin.
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type ExecutionEventList struct {
+	Events []ExecutionEvent `json:"events"`
+	// Set when there may be further events, pass it in the next request to get them.
+	Token string `json:"token,omitempty"`
+}
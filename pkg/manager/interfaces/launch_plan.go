@@ -23,4 +23,6 @@ type LaunchPlanInterface interface {
 		*admin.LaunchPlanList, error)
 	ListLaunchPlanIds(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	DeleteLaunchPlan(ctx context.Context, request EntityDeleteRequest) error
+	GetLaunchPlanSchedule(ctx context.Context, request LaunchPlanScheduleRequest) (*LaunchPlanSchedule, error)
 }
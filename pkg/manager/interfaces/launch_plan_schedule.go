@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// LaunchPlanScheduleRequest selects the launch plan whose native scheduler schedule to describe.
+type LaunchPlanScheduleRequest struct {
+	// The schedule of the active version of the launch plan is described when the version is unset.
+	ID core.Identifier
+	// The number of upcoming scheduled times to return.
+	NextCount int
+	// How long ago the executions of the schedule returned may have been scheduled at.
+	HistoryWindow time.Duration
+}
+
+// ScheduledExecution is an execution of a schedule, which the scheduler or a backfill named deterministically after its
+// scheduled time.
+type ScheduledExecution struct {
+	ScheduledTime time.Time                        `json:"scheduledTime"`
+	ExecutionID   core.WorkflowExecutionIdentifier `json:"executionId"`
+	// The phase of the execution, unset if it no longer exists or was never created, such as when admin failed to.
+	Phase string `json:"phase,omitempty"`
+}
+
+// LaunchPlanSchedule describes the schedule of a launch plan run by the native scheduler.
+type LaunchPlanSchedule struct {
+	ID             core.Identifier `json:"id"`
+	Active         bool            `json:"active"`
+	CronExpression string          `json:"cronExpression,omitempty"`
+	FixedRateValue uint32          `json:"fixedRateValue,omitempty"`
+	FixedRateUnit  string          `json:"fixedRateUnit,omitempty"`
+	// The last time the scheduler recorded executing the schedule at in its snapshot, unset if it hasn't yet.
+	LastExecutionTime *time.Time `json:"lastExecutionTime,omitempty"`
+	// The upcoming times the schedule is scheduled at, empty if it isn't active.
+	NextExecutionTimes []time.Time `json:"nextExecutionTimes"`
+	// The executions of the schedule within the history window through its last execution time, oldest first.
+	History []ScheduledExecution `json:"history"`
+}
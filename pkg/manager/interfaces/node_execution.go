@@ -15,4 +15,6 @@ type NodeExecutionInterface interface {
 	ListNodeExecutionsForTask(ctx context.Context, request admin.NodeExecutionForTaskListRequest) (*admin.NodeExecutionList, error)
 	GetNodeExecutionData(
 		ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
+	// Lists the recorded phase transitions of the node executions of a workflow execution.
+	ListNodeExecutionEvents(ctx context.Context, request ExecutionEventListRequest) (*ExecutionEventList, error)
 }
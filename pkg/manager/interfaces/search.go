@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+//go:generate mockery -name=SearchInterface -output=../mocks -case=underscore
+
+// SearchRequest looks up the tasks, workflows and launch plans matching all the words of a free text query by their
+// names, descriptions, labels and interface variable names.
+type SearchRequest struct {
+	Query string
+	// Optional, restricts the results to entities of these resource types.
+	ResourceTypes []core.ResourceType
+	// Optional, restricts the results to entities of the project and domain.
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+// SearchResult is an entity matching a search, identified by its most recently registered version.
+type SearchResult struct {
+	ID *core.Identifier `json:"id"`
+	// Higher scores are better matches, they're only comparable within the results of a search.
+	Score float64 `json:"score"`
+}
+
+type SearchResultList struct {
+	// Ordered from the best match.
+	Results []SearchResult `json:"results"`
+	// Set when there may be further results, pass it in the next request to get them.
+	Token string `json:"token,omitempty"`
+}
+
+// SearchInterface for searching registered entities.
+type SearchInterface interface {
+	Search(ctx context.Context, request SearchRequest) (*SearchResultList, error)
+}
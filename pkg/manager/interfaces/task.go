@@ -13,4 +13,5 @@ type TaskInterface interface {
 	ListTasks(ctx context.Context, request admin.ResourceListRequest) (*admin.TaskList, error)
 	ListUniqueTaskIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	DeleteTask(ctx context.Context, request EntityDeleteRequest) error
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
@@ -25,6 +26,10 @@ type GetExecutionDataFunc func(ctx context.Context, request admin.WorkflowExecut
 type ListExecutionFunc func(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 type TerminateExecutionFunc func(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+type ListExecutionEventsFunc func(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error)
+type AggregateExecutionsFunc func(
+	ctx context.Context, request interfaces.ExecutionAggregationRequest) (*interfaces.ExecutionAggregationList, error)
 
 type MockExecutionManager struct {
 	createExecutionFunc      CreateExecutionFunc
@@ -36,6 +41,8 @@ type MockExecutionManager struct {
 	getExecutionDataFunc     GetExecutionDataFunc
 	listExecutionFunc        ListExecutionFunc
 	terminateExecutionFunc   TerminateExecutionFunc
+	listExecutionEventsFunc  ListExecutionEventsFunc
+	aggregateExecutionsFunc  AggregateExecutionsFunc
 }
 
 func (m *MockExecutionManager) SetCreateCallback(createFunction CreateExecutionFunc) {
@@ -144,3 +151,27 @@ func (m *MockExecutionManager) TerminateExecution(
 	}
 	return nil, nil
 }
+
+func (m *MockExecutionManager) SetListExecutionEventsCallback(listExecutionEventsFunc ListExecutionEventsFunc) {
+	m.listExecutionEventsFunc = listExecutionEventsFunc
+}
+
+func (m *MockExecutionManager) ListExecutionEvents(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if m.listExecutionEventsFunc != nil {
+		return m.listExecutionEventsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockExecutionManager) SetAggregateExecutionsCallback(aggregateExecutionsFunc AggregateExecutionsFunc) {
+	m.aggregateExecutionsFunc = aggregateExecutionsFunc
+}
+
+func (m *MockExecutionManager) AggregateExecutions(
+	ctx context.Context, request interfaces.ExecutionAggregationRequest) (*interfaces.ExecutionAggregationList, error) {
+	if m.aggregateExecutionsFunc != nil {
+		return m.aggregateExecutionsFunc(ctx, request)
+	}
+	return nil, nil
+}
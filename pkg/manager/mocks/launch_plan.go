@@ -22,6 +22,9 @@ type ListLaunchPlanIdsFunc func(ctx context.Context, request admin.NamedEntityId
 	*admin.NamedEntityIdentifierList, error)
 type ListActiveLaunchPlansFunc func(ctx context.Context, request admin.ActiveLaunchPlanListRequest) (
 	*admin.LaunchPlanList, error)
+type DeleteLaunchPlanFunc func(ctx context.Context, request interfaces.EntityDeleteRequest) error
+type GetLaunchPlanScheduleFunc func(ctx context.Context, request interfaces.LaunchPlanScheduleRequest) (
+	*interfaces.LaunchPlanSchedule, error)
 
 type MockLaunchPlanManager struct {
 	createLaunchPlanFunc      CreateLaunchPlanFunc
@@ -31,6 +34,8 @@ type MockLaunchPlanManager struct {
 	listLaunchPlansFunc       ListLaunchPlansFunc
 	listLaunchPlanIdsFunc     ListLaunchPlanIdsFunc
 	listActiveLaunchPlansFunc ListActiveLaunchPlansFunc
+	deleteLaunchPlanFunc      DeleteLaunchPlanFunc
+	getLaunchPlanScheduleFunc GetLaunchPlanScheduleFunc
 }
 
 func (r *MockLaunchPlanManager) SetCreateCallback(createFunction CreateLaunchPlanFunc) {
@@ -113,3 +118,26 @@ func (r *MockLaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request a
 func NewMockLaunchPlanManager() interfaces.LaunchPlanInterface {
 	return &MockLaunchPlanManager{}
 }
+
+func (r *MockLaunchPlanManager) SetDeleteCallback(deleteFunction DeleteLaunchPlanFunc) {
+	r.deleteLaunchPlanFunc = deleteFunction
+}
+
+func (r *MockLaunchPlanManager) DeleteLaunchPlan(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	if r.deleteLaunchPlanFunc != nil {
+		return r.deleteLaunchPlanFunc(ctx, request)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanManager) SetGetScheduleCallback(getScheduleFunction GetLaunchPlanScheduleFunc) {
+	r.getLaunchPlanScheduleFunc = getScheduleFunction
+}
+
+func (r *MockLaunchPlanManager) GetLaunchPlanSchedule(ctx context.Context,
+	request interfaces.LaunchPlanScheduleRequest) (*interfaces.LaunchPlanSchedule, error) {
+	if r.getLaunchPlanScheduleFunc != nil {
+		return r.getLaunchPlanScheduleFunc(ctx, request)
+	}
+	return nil, nil
+}
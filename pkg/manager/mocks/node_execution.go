@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
@@ -16,12 +17,16 @@ type ListNodeExecutionsForTaskFunc func(ctx context.Context, request admin.NodeE
 type GetNodeExecutionDataFunc func(
 	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
 
+type ListNodeExecutionEventsFunc func(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error)
+
 type MockNodeExecutionManager struct {
 	createNodeEventFunc           CreateNodeEventFunc
 	getNodeExecutionFunc          GetNodeExecutionFunc
 	listNodeExecutionsFunc        ListNodeExecutionsFunc
 	listNodeExecutionsForTaskFunc ListNodeExecutionsForTaskFunc
 	getNodeExecutionDataFunc      GetNodeExecutionDataFunc
+	listNodeExecutionEventsFunc   ListNodeExecutionEventsFunc
 }
 
 func (m *MockNodeExecutionManager) SetCreateNodeEventCallback(createNodeEventFunc CreateNodeEventFunc) {
@@ -84,3 +89,15 @@ func (m *MockNodeExecutionManager) GetNodeExecutionData(
 	}
 	return nil, nil
 }
+
+func (m *MockNodeExecutionManager) SetListNodeExecutionEventsFunc(listNodeExecutionEventsFunc ListNodeExecutionEventsFunc) {
+	m.listNodeExecutionEventsFunc = listNodeExecutionEventsFunc
+}
+
+func (m *MockNodeExecutionManager) ListNodeExecutionEvents(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if m.listNodeExecutionEventsFunc != nil {
+		return m.listNodeExecutionEventsFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -3,15 +3,19 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 type CreateTaskFunc func(ctx context.Context, request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error)
 type ListUniqueIdsFunc func(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error)
+type DeleteTaskFunc func(ctx context.Context, request interfaces.EntityDeleteRequest) error
 
 type MockTaskManager struct {
 	createTaskFunc    CreateTaskFunc
 	listUniqueIdsFunc ListUniqueIdsFunc
+	deleteTaskFunc    DeleteTaskFunc
 }
 
 func (r *MockTaskManager) SetCreateCallback(createFunction CreateTaskFunc) {
@@ -48,3 +52,14 @@ func (r *MockTaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request
 
 	return nil, nil
 }
+
+func (r *MockTaskManager) SetDeleteCallback(deleteFunction DeleteTaskFunc) {
+	r.deleteTaskFunc = deleteFunction
+}
+
+func (r *MockTaskManager) DeleteTask(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	if r.deleteTaskFunc != nil {
+		return r.deleteTaskFunc(ctx, request)
+	}
+	return nil
+}
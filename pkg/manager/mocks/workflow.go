@@ -3,15 +3,19 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 type CreateWorkflowFunc func(ctx context.Context, request admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error)
 type GetWorkflowFunc func(ctx context.Context, request admin.ObjectGetRequest) (*admin.Workflow, error)
+type DeleteWorkflowFunc func(ctx context.Context, request interfaces.EntityDeleteRequest) error
 
 type MockWorkflowManager struct {
 	createWorkflowFunc CreateWorkflowFunc
 	getWorkflowFunc    GetWorkflowFunc
+	deleteWorkflowFunc DeleteWorkflowFunc
 }
 
 func (r *MockWorkflowManager) SetCreateCallback(createFunction CreateWorkflowFunc) {
@@ -48,3 +52,14 @@ func (r *MockWorkflowManager) ListWorkflowIdentifiers(ctx context.Context, reque
 	*admin.NamedEntityIdentifierList, error) {
 	return nil, nil
 }
+
+func (r *MockWorkflowManager) SetDeleteCallback(deleteFunction DeleteWorkflowFunc) {
+	r.deleteWorkflowFunc = deleteFunction
+}
+
+func (r *MockWorkflowManager) DeleteWorkflow(ctx context.Context, request interfaces.EntityDeleteRequest) error {
+	if r.deleteWorkflowFunc != nil {
+		return r.deleteWorkflowFunc(ctx, request)
+	}
+	return nil
+}
@@ -1180,10 +1180,246 @@ var NoopMigrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&Execution{})
 		},
 	},
+
+	{
+		ID: "2023-10-02-outbox-events", // Events recorded alongside execution state changes, pending publishing
+		Migrate: func(tx *gorm.DB) error {
+			type OutboxEvent struct {
+				ID               uint `gorm:"primary_key;autoIncrement"`
+				CreatedAt        time.Time
+				ExecutionProject string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+				ExecutionDomain  string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+				ExecutionName    string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+				Sink             string `gorm:"uniqueIndex:idx_outbox_events_deduplication_key" valid:"length(0|255)"`
+				DeduplicationKey string `gorm:"uniqueIndex:idx_outbox_events_deduplication_key" valid:"length(0|255)"`
+				EventType        string `valid:"length(0|255)"`
+				Payload          []byte
+				Attempts         int
+				LastError        string
+				PublishedAt      *time.Time `gorm:"index"`
+			}
+
+			return tx.AutoMigrate(&OutboxEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("outbox_events")
+		},
+	},
+
+	{
+		ID: "2023-10-09-notification-dead-letters", // Notifications which couldn't be processed
+		Migrate: func(tx *gorm.DB) error {
+			type NotificationDeadLetter struct {
+				ID               uint `gorm:"primary_key;autoIncrement"`
+				CreatedAt        time.Time
+				Processor        string `valid:"length(0|255)"`
+				NotificationType string `valid:"length(0|255)"`
+				Message          []byte
+				RawMessage       []byte
+				Reason           string
+				Attempts         int
+				ReplayedAt       *time.Time `gorm:"index"`
+			}
+
+			return tx.AutoMigrate(&NotificationDeadLetter{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("notification_dead_letters")
+		},
+	},
+
+	{
+		ID: "2023-10-16-search-documents", // Searchable text of tasks, workflows and launch plans
+		Migrate: func(tx *gorm.DB) error {
+			type SearchDocument struct {
+				ID           uint `gorm:"primary_key;autoIncrement"`
+				CreatedAt    time.Time
+				UpdatedAt    time.Time
+				ResourceType core.ResourceType `gorm:"uniqueIndex:search_document_key_idx" valid:"length(0|255)"`
+				Project      string            `gorm:"uniqueIndex:search_document_key_idx;index:search_document_project_domain_idx" valid:"length(0|255)"`
+				Domain       string            `gorm:"uniqueIndex:search_document_key_idx;index:search_document_project_domain_idx" valid:"length(0|255)"`
+				Name         string            `gorm:"uniqueIndex:search_document_key_idx" valid:"length(0|255)"`
+				Version      string            `valid:"length(0|255)"`
+				Description  string
+				Labels       string
+				Variables    string
+			}
+
+			if err := tx.AutoMigrate(&SearchDocument{}); err != nil {
+				return err
+			}
+			// Documents of existing entities are backfilled from their latest version's columns, labels and interface
+			// variables are only serialized in specs and are indexed from the next registered version on.
+			for resourceType, table := range map[core.ResourceType]string{
+				core.ResourceType_TASK:        "tasks",
+				core.ResourceType_WORKFLOW:    "workflows",
+				core.ResourceType_LAUNCH_PLAN: "launch_plans",
+			} {
+				description := "short_description"
+				if resourceType == core.ResourceType_LAUNCH_PLAN {
+					description = "''"
+				}
+				if err := tx.Exec(fmt.Sprintf(`INSERT INTO search_documents
+					(created_at, updated_at, resource_type, project, domain, name, version, description, labels, variables)
+					SELECT created_at, updated_at, ?, project, domain, name, version, %s, '', '' FROM %s
+					WHERE id IN (SELECT MAX(id) FROM %s GROUP BY project, domain, name)`, description, table, table),
+					resourceType).Error; err != nil {
+					return err
+				}
+			}
+			if tx.Dialector.Name() != "postgres" {
+				return nil
+			}
+			// Names and variables are split into words at the separators of their python paths and identifiers.
+			if err := tx.Exec(`ALTER TABLE search_documents ADD COLUMN IF NOT EXISTS search_vector tsvector
+				GENERATED ALWAYS AS (
+					setweight(to_tsvector('simple', translate(name, '._-', '   ')), 'A') ||
+					setweight(to_tsvector('simple', coalesce(description, '')), 'B') ||
+					setweight(to_tsvector('simple',
+						translate(coalesce(labels, '') || ' ' || coalesce(variables, ''), '._-', '   ')), 'C')
+				) STORED`).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE INDEX IF NOT EXISTS search_document_search_vector_idx
+				ON search_documents USING GIN (search_vector)`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("search_documents")
+		},
+	},
+
+	{
+		ID: "2023-10-23-audit-entries", // Administrative actions on registered entity versions
+		Migrate: func(tx *gorm.DB) error {
+			type AuditEntry struct {
+				ID           uint `gorm:"primary_key;autoIncrement"`
+				CreatedAt    time.Time
+				Action       string            `valid:"length(0|255)"`
+				ResourceType core.ResourceType `gorm:"index:audit_entry_entity_idx"`
+				Project      string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+				Domain       string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+				Name         string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+				Version      string            `valid:"length(0|255)"`
+				Principal    string            `valid:"length(0|255)"`
+				Reason       string
+			}
+
+			return tx.AutoMigrate(&AuditEntry{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("audit_entries")
+		},
+	},
+
+	{
+		ID: "2023-11-06-scheduler-leases", // Leader election among the native scheduler replicas
+		Migrate: func(tx *gorm.DB) error {
+			type SchedulerLease struct {
+				Name      string `gorm:"primary_key"`
+				Holder    string
+				ExpiresAt time.Time
+				UpdatedAt time.Time
+			}
+
+			return tx.AutoMigrate(&SchedulerLease{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("scheduler_leases")
+		},
+	},
+
+	{
+		ID: "2023-11-13-schedulable-entities-catch-up-policy", // Which missed runs of a schedule are launched
+		Migrate: func(tx *gorm.DB) error {
+			type SchedulableEntity struct {
+				Project        string `gorm:"primary_key"`
+				Domain         string `gorm:"primary_key"`
+				Name           string `gorm:"primary_key"`
+				Version        string `gorm:"primary_key"`
+				CatchUpPolicy  string
+				CatchUpMaxRuns uint32
+				CatchUpMaxAge  time.Duration
+			}
+
+			return tx.AutoMigrate(&SchedulableEntity{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"catch_up_policy", "catch_up_max_runs", "catch_up_max_age"} {
+				if err := tx.Migrator().DropColumn("schedulable_entities", column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+
+	{
+		ID: "2023-11-20-backfills", // Executions of scheduled launch plans launched over a time range
+		Migrate: func(tx *gorm.DB) error {
+			type Backfill struct {
+				ID                  uint `gorm:"primary_key;autoIncrement"`
+				CreatedAt           time.Time
+				UpdatedAt           time.Time
+				Project             string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+				Domain              string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+				Name                string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+				LaunchPlanName      string `valid:"length(0|255)"`
+				LaunchPlanVersion   string `valid:"length(0|255)"`
+				CronExpression      string
+				FixedRateValue      uint32
+				Unit                int32
+				KickoffTimeInputArg string
+				StartTime           time.Time
+				EndTime             time.Time
+				MaxConcurrency      uint32
+				ScheduledTimes      int
+				Launched            int
+				State               string `gorm:"index" valid:"length(0|255)"`
+				Message             string
+				Principal           string `valid:"length(0|255)"`
+			}
+
+			return tx.AutoMigrate(&Backfill{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("backfills")
+		},
+	},
 }
 
 var Migrations = append(LegacyMigrations, NoopMigrations...)
 
+// GetPartitionMigrations returns the opt-in migrations converting the node and task executions tables of postgres
+// databases to ones range partitioned by the month rows were created in, with partitions created through futureMonths
+// months from now. Rows are copied to the partitioned tables, which may take a while for large tables, and foreign keys
+// from or to them are dropped. Other databases are left as they are.
+func GetPartitionMigrations(futureMonths int) []*gormigrate.Migration {
+	migrations := make([]*gormigrate.Migration, 0, len(PartitionedTables))
+	for _, table := range PartitionedTables {
+		table := table
+		migrations = append(migrations, &gormigrate.Migration{
+			ID: fmt.Sprintf("2023-10-30-partition-%s", table.Name),
+			Migrate: func(tx *gorm.DB) error {
+				if tx.Dialector.Name() != "postgres" {
+					return nil
+				}
+				return tx.Transaction(func(tx *gorm.DB) error {
+					return partitionTable(tx, table.Name, table.PrimaryKey, futureMonths)
+				})
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if tx.Dialector.Name() != "postgres" {
+					return nil
+				}
+				return tx.Transaction(func(tx *gorm.DB) error {
+					return unpartitionTable(tx, table.Name, table.PrimaryKey)
+				})
+			},
+		})
+	}
+	return migrations
+}
+
 func alterTableColumnType(db *sql.DB, columnName, columnType string) error {
 	var err error
 	for _, table := range tables {
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PartitionedTables are the tables the partition migrations range partition by the month rows were created in, along
+// with their primary keys prior to partitioning.
+var PartitionedTables = []struct {
+	Name       string
+	PrimaryKey []string
+}{
+	{
+		Name:       "node_executions",
+		PrimaryKey: []string{"execution_project", "execution_domain", "execution_name", "node_id"},
+	},
+	{
+		Name: "task_executions",
+		PrimaryKey: []string{"project", "domain", "name", "version", "execution_project", "execution_domain",
+			"execution_name", "node_id", "retry_attempt"},
+	},
+}
+
+// Partitions are named after their table and month, e.g. node_executions_p2023_10.
+const (
+	partitionMonthFormat = "2006_01"
+	partitionNameFmt     = "%s_p%s"
+	// Rows created in months without a partition, such as restored ones, are kept in the default partition.
+	defaultPartitionNameFmt = "%s_default"
+	// Tables are renamed with this suffix while their rows are copied to their replacement.
+	replacedTableNameFmt = "%s_replaced"
+	partitionColumn      = "created_at"
+)
+
+// GetMonthStart returns the start of the month, in UTC, of the given time.
+func GetMonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetPartitionName returns the name of the partition of the table holding the rows created in the month.
+func GetPartitionName(table string, month time.Time) string {
+	return fmt.Sprintf(partitionNameFmt, table, GetMonthStart(month).Format(partitionMonthFormat))
+}
+
+// GetPartitionMonth returns the month of a partition of the table, or false if it isn't a monthly partition.
+func GetPartitionMonth(table, partition string) (time.Time, bool) {
+	prefix := fmt.Sprintf(partitionNameFmt, table, "")
+	if !strings.HasPrefix(partition, prefix) {
+		return time.Time{}, false
+	}
+	month, err := time.Parse(partitionMonthFormat, strings.TrimPrefix(partition, prefix))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// CreateMonthlyPartition creates the partition of the table holding the rows created in the month, unless it exists.
+func CreateMonthlyPartition(tx *gorm.DB, table string, month time.Time) error {
+	from := GetMonthStart(month)
+	// Partition bounds can't be bound parameters.
+	return tx.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')`,
+		GetPartitionName(table, from), table, from.Format(time.RFC3339),
+		from.AddDate(0, 1, 0).Format(time.RFC3339))).Error
+}
+
+// DetachPartition detaches the partition from its table and drops it.
+func DetachPartition(tx *gorm.DB, table, partition string) error {
+	if err := tx.Exec(fmt.Sprintf(`ALTER TABLE %s DETACH PARTITION %s`, table, partition)).Error; err != nil {
+		return err
+	}
+	return tx.Exec(fmt.Sprintf(`DROP TABLE %s`, partition)).Error
+}
+
+// ListPartitions returns the names of the partitions of the table.
+func ListPartitions(tx *gorm.DB, table string) ([]string, error) {
+	var partitions []string
+	err := tx.Raw(`SELECT child.relname FROM pg_inherits
+		JOIN pg_class child ON child.oid = pg_inherits.inhrelid
+		WHERE pg_inherits.inhparent = ?::regclass
+		ORDER BY child.relname`, table).Scan(&partitions).Error
+	return partitions, err
+}
+
+// IsPartitioned returns whether the table is partitioned.
+func IsPartitioned(tx *gorm.DB, table string) (bool, error) {
+	var count int64
+	err := tx.Raw(`SELECT COUNT(*) FROM pg_partitioned_table WHERE partrelid = to_regclass(?)`, table).
+		Scan(&count).Error
+	return count > 0, err
+}
+
+// Returns the statements creating the indexes of the table, other than its primary key. Indexes of partitioned tables
+// are recreated on the table and every partition.
+func getIndexDefinitions(tx *gorm.DB, table string) ([]string, error) {
+	var definitions []string
+	if err := tx.Raw(`SELECT indexdef FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ?
+		AND indexname NOT IN (SELECT conname FROM pg_constraint WHERE conrelid = ?::regclass AND contype = 'p')
+		ORDER BY indexname`, table, table).Scan(&definitions).Error; err != nil {
+		return nil, err
+	}
+	for idx, definition := range definitions {
+		definitions[idx] = strings.Replace(definition, " ON ONLY ", " ON ", 1)
+	}
+	return definitions, nil
+}
+
+// Foreign keys from or to the table can't be kept, since its primary key changes.
+func dropForeignKeys(tx *gorm.DB, table string) error {
+	var constraints []struct {
+		TableName string
+		Conname   string
+	}
+	if err := tx.Raw(`SELECT conrelid::regclass::text AS table_name, conname FROM pg_constraint
+		WHERE contype = 'f' AND (conrelid = ?::regclass OR confrelid = ?::regclass)`, table, table).
+		Scan(&constraints).Error; err != nil {
+		return err
+	}
+	for _, constraint := range constraints {
+		if err := tx.Exec(fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT %s`, constraint.TableName,
+			constraint.Conname)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replaces the table by a copy of it, created by createTable from the renamed original, keeping its rows, id sequence
+// and indexes.
+func replaceTable(tx *gorm.DB, table string, primaryKey []string, createTable func(replaced string) error) error {
+	indexDefinitions, err := getIndexDefinitions(tx, table)
+	if err != nil {
+		return err
+	}
+	if err = dropForeignKeys(tx, table); err != nil {
+		return err
+	}
+	replaced := fmt.Sprintf(replacedTableNameFmt, table)
+	if err = tx.Exec(fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, table, replaced)).Error; err != nil {
+		return err
+	}
+	if err = createTable(replaced); err != nil {
+		return err
+	}
+	if err = tx.Exec(fmt.Sprintf(`INSERT INTO %s SELECT * FROM %s`, table, replaced)).Error; err != nil {
+		return err
+	}
+	if err = tx.Exec(fmt.Sprintf(`ALTER SEQUENCE IF EXISTS %s_id_seq OWNED BY %s.id`, table, table)).Error; err != nil {
+		return err
+	}
+	if err = tx.Exec(fmt.Sprintf(`DROP TABLE %s`, replaced)).Error; err != nil {
+		return err
+	}
+	if err = tx.Exec(fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY (%s)`, table,
+		strings.Join(primaryKey, ", "))).Error; err != nil {
+		return err
+	}
+	for _, definition := range indexDefinitions {
+		if err = tx.Exec(definition).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Converts the table to one range partitioned by the month rows were created in. Partitions are created for every
+// month rows exist for, through futureMonths months from now. The partition column is appended to the primary key, as
+// postgres requires of unique constraints on partitioned tables.
+func partitionTable(tx *gorm.DB, table string, primaryKey []string, futureMonths int) error {
+	return replaceTable(tx, table, append(primaryKey, partitionColumn), func(replaced string) error {
+		if err := tx.Exec(fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING STORAGE)
+			PARTITION BY RANGE (%s)`, table, replaced, partitionColumn)).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s DEFAULT`,
+			fmt.Sprintf(defaultPartitionNameFmt, table), table)).Error; err != nil {
+			return err
+		}
+		var oldest *time.Time
+		if err := tx.Raw(fmt.Sprintf(`SELECT MIN(%s) FROM %s`, partitionColumn, replaced)).
+			Scan(&oldest).Error; err != nil {
+			return err
+		}
+		last := GetMonthStart(time.Now()).AddDate(0, futureMonths, 0)
+		month := GetMonthStart(time.Now())
+		if oldest != nil && oldest.Before(month) {
+			month = GetMonthStart(*oldest)
+		}
+		for ; !month.After(last); month = month.AddDate(0, 1, 0) {
+			if err := CreateMonthlyPartition(tx, table, month); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Converts the partitioned table back to an unpartitioned one with the given primary key. Detached partitions aren't
+// restored.
+func unpartitionTable(tx *gorm.DB, table string, primaryKey []string) error {
+	return replaceTable(tx, table, primaryKey, func(replaced string) error {
+		return tx.Exec(fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING STORAGE)`, table,
+			replaced)).Error
+	})
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPartitionName(t *testing.T) {
+	month := time.Date(2023, time.October, 31, 23, 0, 0, 0, time.FixedZone("", -2*60*60))
+	assert.Equal(t, "node_executions_p2023_11", GetPartitionName("node_executions", month))
+}
+
+func TestGetPartitionMonth(t *testing.T) {
+	month, ok := GetPartitionMonth("node_executions", "node_executions_p2023_10")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC), month)
+
+	_, ok = GetPartitionMonth("node_executions", "node_executions_default")
+	assert.False(t, ok)
+	_, ok = GetPartitionMonth("executions", "node_executions_p2023_10")
+	assert.False(t, ok)
+}
+
+func TestCreateMonthlyPartition(t *testing.T) {
+	gormDb := GetDbForTest(t)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(`CREATE TABLE IF NOT EXISTS task_executions_p2023_12 PARTITION OF task_executions ` +
+		`FOR VALUES FROM ('2023-12-01T00:00:00Z') TO ('2024-01-01T00:00:00Z')`)
+	_ = CreateMonthlyPartition(gormDb, "task_executions", time.Date(2023, time.December, 15, 0, 0, 0, 0, time.UTC))
+	assert.True(t, query.Triggered)
+}
+
+func TestDetachPartition(t *testing.T) {
+	gormDb := GetDbForTest(t)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(`ALTER TABLE task_executions DETACH PARTITION task_executions_p2023_12`)
+	_ = DetachPartition(gormDb, "task_executions", "task_executions_p2023_12")
+	assert.True(t, query.Triggered)
+}
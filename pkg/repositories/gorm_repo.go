@@ -26,7 +26,14 @@ type GormRepo struct {
 	descriptionEntityRepo        interfaces.DescriptionEntityRepoInterface
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo           schedulerInterfaces.SchedulerLeaseRepoInterface
 	signalRepo                   interfaces.SignalRepoInterface
+	outboxEventRepo              interfaces.OutboxEventRepoInterface
+	notificationDeadLetterRepo   interfaces.NotificationDeadLetterRepoInterface
+	searchRepo                   interfaces.SearchRepoInterface
+	partitionRepo                interfaces.PartitionRepoInterface
+	backfillRepo                 interfaces.BackfillRepoInterface
+	lockRepo                     interfaces.LockRepoInterface
 }
 
 func (r *GormRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
@@ -85,10 +92,38 @@ func (r *GormRepo) ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEn
 	return r.scheduleEntitiesSnapshotRepo
 }
 
+func (r *GormRepo) SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface {
+	return r.schedulerLeaseRepo
+}
+
 func (r *GormRepo) SignalRepo() interfaces.SignalRepoInterface {
 	return r.signalRepo
 }
 
+func (r *GormRepo) OutboxEventRepo() interfaces.OutboxEventRepoInterface {
+	return r.outboxEventRepo
+}
+
+func (r *GormRepo) NotificationDeadLetterRepo() interfaces.NotificationDeadLetterRepoInterface {
+	return r.notificationDeadLetterRepo
+}
+
+func (r *GormRepo) SearchRepo() interfaces.SearchRepoInterface {
+	return r.searchRepo
+}
+
+func (r *GormRepo) PartitionRepo() interfaces.PartitionRepoInterface {
+	return r.partitionRepo
+}
+
+func (r *GormRepo) BackfillRepo() interfaces.BackfillRepoInterface {
+	return r.backfillRepo
+}
+
+func (r *GormRepo) LockRepo() interfaces.LockRepoInterface {
+	return r.lockRepo
+}
+
 func (r *GormRepo) GetGormDB() *gorm.DB {
 	return r.db
 }
@@ -110,6 +145,14 @@ func NewGormRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope pr
 		descriptionEntityRepo:        gormimpl.NewDescriptionEntityRepo(db, errorTransformer, scope.NewSubScope("description_entities")),
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		schedulerLeaseRepo:           schedulerGormImpl.NewSchedulerLeaseRepo(db, errorTransformer, scope.NewSubScope("scheduler_leases")),
 		signalRepo:                   gormimpl.NewSignalRepo(db, errorTransformer, scope.NewSubScope("signals")),
+		outboxEventRepo:              gormimpl.NewOutboxEventRepo(db, errorTransformer, scope.NewSubScope("outbox_events")),
+		notificationDeadLetterRepo: gormimpl.NewNotificationDeadLetterRepo(db, errorTransformer,
+			scope.NewSubScope("notification_dead_letters")),
+		searchRepo:    gormimpl.NewSearchRepo(db, errorTransformer, scope.NewSubScope("search_documents")),
+		partitionRepo: gormimpl.NewPartitionRepo(db, errorTransformer, scope.NewSubScope("partitions")),
+		backfillRepo:  gormimpl.NewBackfillRepo(db, errorTransformer, scope.NewSubScope("backfills")),
+		lockRepo:      gormimpl.NewLockRepo(db, errorTransformer, scope.NewSubScope("locks")),
 	}
 }
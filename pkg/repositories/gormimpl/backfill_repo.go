@@ -0,0 +1,85 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	flyteAdminDbErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+
+	"gorm.io/gorm"
+)
+
+type BackfillRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteAdminDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *BackfillRepo) Create(ctx context.Context, input models.Backfill) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Omit("id").Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *BackfillRepo) Get(ctx context.Context, input models.BackfillKey) (models.Backfill, error) {
+	var backfill models.Backfill
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.Backfill{BackfillKey: input}).Take(&backfill)
+	timer.Stop()
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return models.Backfill{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"backfill [%s/%s/%s] not found", input.Project, input.Domain, input.Name)
+	} else if tx.Error != nil {
+		return models.Backfill{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return backfill, nil
+}
+
+func (r *BackfillRepo) ListRunning(ctx context.Context, limit int) ([]models.Backfill, error) {
+	var backfills []models.Backfill
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.Backfill{State: models.BackfillStateRunning}).Order("id").Limit(limit).
+		Find(&backfills)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return backfills, nil
+}
+
+func (r *BackfillRepo) Update(ctx context.Context, input models.Backfill) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	// Another admin instance may have concurrently launched more executions of the backfill.
+	tx := r.db.WithContext(ctx).Model(&models.Backfill{}).
+		Where("id = ? AND state = ? AND launched <= ?", input.ID, models.BackfillStateRunning, input.Launched).
+		Updates(map[string]interface{}{
+			"launched": input.Launched,
+			"state":    input.State,
+			"message":  input.Message,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// Returns an instance of BackfillRepoInterface
+func NewBackfillRepo(db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.BackfillRepoInterface {
+	metrics := newMetrics(scope)
+	return &BackfillRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
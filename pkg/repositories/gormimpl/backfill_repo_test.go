@@ -0,0 +1,97 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var backfillKey = models.BackfillKey{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "backfill",
+}
+
+func TestCreateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	created := false
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "backfills"`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			created = true
+		})
+
+	err := backfillRepo.Create(context.Background(), models.Backfill{
+		BackfillKey:       backfillKey,
+		LaunchPlanName:    "launch_plan",
+		LaunchPlanVersion: "version",
+		CronExpression:    "0 19 * * *",
+		MaxConcurrency:    2,
+		ScheduledTimes:    90,
+		State:             models.BackfillStateRunning,
+	})
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestGetBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "backfills" WHERE "backfills"."project" = $1 AND "backfills"."domain" = $2 AND "backfills"."name" = $3 LIMIT 1`).WithReply(
+		[]map[string]interface{}{{"id": 4, "project": "project", "domain": "domain", "name": "backfill",
+			"launched": 3, "state": models.BackfillStateRunning}})
+
+	backfill, err := backfillRepo.Get(context.Background(), backfillKey)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), backfill.ID)
+	assert.Equal(t, backfillKey, backfill.BackfillKey)
+	assert.Equal(t, 3, backfill.Launched)
+}
+
+func TestGetBackfill_NotFound(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	_, err := backfillRepo.Get(context.Background(), backfillKey)
+	assert.EqualError(t, err, "backfill [project/domain/backfill] not found")
+}
+
+func TestListRunningBackfills(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "backfills" WHERE "backfills"."state" = $1 ORDER BY id LIMIT 10`).WithReply(
+		[]map[string]interface{}{{"id": 1}, {"id": 2}})
+
+	backfills, err := backfillRepo.ListRunning(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, backfills, 2)
+	assert.Equal(t, uint(2), backfills[1].ID)
+}
+
+func TestUpdateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`UPDATE "backfills" SET "launched"=$1,"message"=$2,"state"=$3,"updated_at"=$4 WHERE id = $5 AND state = $6 AND launched <= $7`).
+		WithRowsNum(1)
+
+	updated, err := backfillRepo.Update(context.Background(), models.Backfill{
+		ID:       1,
+		Launched: 4,
+		State:    models.BackfillStateRunning,
+	})
+	assert.NoError(t, err)
+	assert.True(t, updated)
+}
@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"fmt"
 
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
 
@@ -28,8 +29,13 @@ const taskExecutionTableName = "task_executions"
 const taskTableName = "tasks"
 const workflowTableName = "workflows"
 const descriptionEntityTableName = "description_entities"
+const executionEventTableName = "execution_events"
+const nodeExecutionEventTableName = "node_execution_events"
+const projectTableName = "projects"
+const signalTableName = "signals"
 const AdminTagsTableName = "admin_tags"
 const executionAdminTagsTableName = "execution_admin_tags"
+const outboxEventTableName = "outbox_events"
 
 const limit = "limit"
 const filters = "filters"
@@ -38,6 +44,7 @@ var identifierGroupBy = fmt.Sprintf("%s, %s, %s", Project, Domain, Name)
 
 var entityToTableName = map[common.Entity]string{
 	common.Execution:           "executions",
+	common.ExecutionEvent:      "execution_events",
 	common.LaunchPlan:          "launch_plans",
 	common.NodeExecution:       "node_executions",
 	common.NodeExecutionEvent:  "node_execution_events",
@@ -89,7 +96,7 @@ func applyFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters [
 		if err != nil {
 			return nil, errors.GetInvalidInputError(err.Error())
 		}
-		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.Args)
+		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.GetArgs()...)
 	}
 	for _, mapFilter := range mapFilters {
 		tx = tx.Where(mapFilter.GetFilter())
@@ -97,21 +104,67 @@ func applyFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters [
 	return tx, nil
 }
 
+// Returns the query expression of the filter scoped to the table of its entity, or for groups, to the tables of the
+// entities of each grouped filter.
+func getScopedQueryExpr(filter common.InlineFilter) (common.GormQueryExpr, error) {
+	if group, ok := filter.(common.FilterGroup); ok {
+		filterExprs := make([]common.GormQueryExpr, 0, len(group.GetFilters()))
+		for _, groupedFilter := range group.GetFilters() {
+			filterExpr, err := getScopedQueryExpr(groupedFilter)
+			if err != nil {
+				return common.GormQueryExpr{}, err
+			}
+			filterExprs = append(filterExprs, filterExpr)
+		}
+		return group.GetGormGroupQueryExpr(filterExprs), nil
+	}
+	tableName, ok := entityToTableName[filter.GetEntity()]
+	if !ok {
+		return common.GormQueryExpr{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"unrecognized entity in filter expression: %v", filter.GetEntity())
+	}
+	return filter.GetGormJoinTableQueryExpr(tableName)
+}
+
 func applyScopedFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters []common.MapFilter) (*gorm.DB, error) {
 	for _, filter := range inlineFilters {
-		tableName, ok := entityToTableName[filter.GetEntity()]
-		if !ok {
-			return nil, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
-				"unrecognized entity in filter expression: %v", filter.GetEntity())
-		}
-		gormQueryExpr, err := filter.GetGormJoinTableQueryExpr(tableName)
+		gormQueryExpr, err := getScopedQueryExpr(filter)
 		if err != nil {
 			return nil, err
 		}
-		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.Args)
+		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.GetArgs()...)
 	}
 	for _, mapFilter := range mapFilters {
 		tx = tx.Where(mapFilter.GetFilter())
 	}
 	return tx, nil
 }
+
+// Orders the list query by the sort parameter, if any, and skips to the requested page. Ties between rows sharing
+// sort values are broken by id so that the rows on either side of a page cursor are the same across queries.
+func applyPagination(tx *gorm.DB, tableName string, input interfaces.ListResourceInput) (*gorm.DB, error) {
+	if input.SortParameter == nil {
+		if input.Cursor != nil {
+			return nil, errors.GetInvalidInputError("cursor")
+		}
+		return tx.Offset(input.Offset), nil
+	}
+	sortKeys := input.SortParameter.GetSortKeys()
+	lastSortKey := sortKeys[len(sortKeys)-1]
+	idColumn := fmt.Sprintf("%s.%s", tableName, ID)
+	comparison, idOrder := ">", "%s asc"
+	if lastSortKey.Direction == admin.Sort_DESCENDING {
+		comparison, idOrder = "<", "%s desc"
+	}
+	tx = tx.Order(input.SortParameter.GetGormOrderExpr()).Order(fmt.Sprintf(idOrder, idColumn))
+	if input.Cursor == nil {
+		return tx.Offset(input.Offset), nil
+	}
+	// Cursors are only issued for results sorted by a single key.
+	if len(sortKeys) != 1 || input.Cursor.SortKey != lastSortKey.Key ||
+		input.Cursor.Direction != lastSortKey.Direction {
+		return nil, errors.GetInvalidInputError("cursor")
+	}
+	return tx.Where(fmt.Sprintf("(%s.%s, %s) %s (?, ?)", tableName, input.Cursor.SortKey, idColumn, comparison),
+		input.Cursor.SortValue, input.Cursor.ID), nil
+}
@@ -28,7 +28,7 @@ func (r *DescriptionEntityRepo) Get(ctx context.Context, input interfaces.GetDes
 		return models.DescriptionEntity{}, err
 	}
 
-	tx := r.db.Table(descriptionEntityTableName)
+	tx := r.db.WithContext(ctx).Table(descriptionEntityTableName)
 	// Apply filters
 	tx, err = applyFilters(tx, filters, nil)
 	if err != nil {
@@ -53,16 +53,17 @@ func (r *DescriptionEntityRepo) List(
 		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
 	var descriptionEntities []models.DescriptionEntity
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, descriptionEntityTableName, input)
+	if err != nil {
+		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
 	timer := r.metrics.ListDuration.Start()
 	tx.Find(&descriptionEntities)
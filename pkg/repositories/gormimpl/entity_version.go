@@ -0,0 +1,41 @@
+package gormimpl
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Deletes the version of a task, workflow or launch plan from its table, along with its search document should it be
+// the latest version, and records the audit entry in the same transaction. Returns gorm.ErrRecordNotFound when no such
+// version exists.
+func deleteEntityVersion(db *gorm.DB, tableName string, resourceType core.ResourceType,
+	input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE project = ? AND domain = ? AND name = ? AND version = ?",
+			tableName), input.Project, input.Domain, input.Name, input.Version)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		// Documents are kept per named entity, searches no longer match it until another version is registered.
+		if err := tx.Where(&models.SearchDocument{
+			SearchDocumentKey: models.SearchDocumentKey{
+				ResourceType: resourceType,
+				Project:      input.Project,
+				Domain:       input.Domain,
+				Name:         input.Name,
+			},
+			Version: input.Version,
+		}).Delete(&models.SearchDocument{}).Error; err != nil {
+			return err
+		}
+		return tx.Omit("id").Create(&auditEntry).Error
+	})
+}
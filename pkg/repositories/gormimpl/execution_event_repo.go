@@ -9,6 +9,7 @@ import (
 	"github.com/flyteorg/flytestdlib/promutils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ExecutionEventRepo struct {
@@ -27,6 +28,50 @@ func (r *ExecutionEventRepo) Create(ctx context.Context, input models.ExecutionE
 	return nil
 }
 
+func (r *ExecutionEventRepo) BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Omit("id").Clauses(clause.OnConflict{DoNothing: true}).Create(&inputs)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionEventRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.ExecutionEventCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, err
+	}
+	var events []models.ExecutionEvent
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
+
+	// Apply filters
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, err
+	}
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, executionEventTableName, input)
+	if err != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, err
+	}
+
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.ExecutionEventCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.ExecutionEventCollectionOutput{
+		ExecutionEvents: events,
+	}, nil
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionEventRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionEventRepoInterface {
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
@@ -39,3 +42,74 @@ func TestCreateExecutionEvent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, created)
 }
+
+func TestBatchCreateExecutionEvents(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	created := false
+
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "execution_events" ("created_at","updated_at","deleted_at","execution_project","execution_domain","execution_name","request_id","occurred_at","phase") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT DO NOTHING`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			created = true
+		},
+	)
+	execEventRepo := NewExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	executionKey := models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	}
+	err := execEventRepo.BatchCreate(context.Background(), []models.ExecutionEvent{
+		{
+			RequestID:    "request id 1",
+			ExecutionKey: executionKey,
+			OccurredAt:   time.Now(),
+			Phase:        core.WorkflowExecution_RUNNING.String(),
+		},
+		{
+			RequestID:    "request id 2",
+			ExecutionKey: executionKey,
+			OccurredAt:   time.Now(),
+			Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestListExecutionEvents(t *testing.T) {
+	execEventRepo := NewExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	events := make([]map[string]interface{}, 0)
+	for _, phase := range []string{core.WorkflowExecution_RUNNING.String(), core.WorkflowExecution_SUCCEEDED.String()} {
+		events = append(events, map[string]interface{}{
+			"execution_project": "project",
+			"execution_domain":  "domain",
+			"execution_name":    "1",
+			"request_id":        "request id",
+			"occurred_at":       time.Now(),
+			"phase":             phase,
+		})
+	}
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_events" WHERE execution_name = $1 ORDER BY occurred_at asc,execution_events.id asc LIMIT 20%`).
+		WithReply(events)
+
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "occurred_at",
+		Direction: admin.Sort_ASCENDING,
+	}, models.ExecutionEventColumns)
+	assert.NoError(t, err)
+	collection, err := execEventRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.ExecutionEvent, "execution_name", "1"),
+		},
+		SortParameter: sortParameter,
+		Limit:         20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, collection.ExecutionEvents, 2)
+	assert.Equal(t, core.WorkflowExecution_RUNNING.String(), collection.ExecutionEvents[0].Phase)
+	assert.Equal(t, "1", collection.ExecutionEvents[1].ExecutionKey.Name)
+}
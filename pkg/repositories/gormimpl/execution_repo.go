@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
@@ -33,10 +35,10 @@ func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) erro
 	return nil
 }
 
-func (r *ExecutionRepo) Get(_ context.Context, input interfaces.Identifier) (models.Execution, error) {
+func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Execution{
+	tx := r.db.WithContext(ctx).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -67,44 +69,50 @@ func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution)
 	return nil
 }
 
-func (r *ExecutionRepo) List(_ context.Context, input interfaces.ListResourceInput) (
-	interfaces.ExecutionCollectionOutput, error) {
-	var err error
-	// First validate input.
-	if err = ValidateListInput(input); err != nil {
-		return interfaces.ExecutionCollectionOutput{}, err
-	}
-	var executions []models.Execution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
-	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
-	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
+func applyExecutionJoins(tx *gorm.DB, joinTableEntities map[common.Entity]bool) *gorm.DB {
+	if ok := joinTableEntities[common.LaunchPlan]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
 			launchPlanTableName, executionTableName, launchPlanTableName))
 	}
-	if ok := input.JoinTableEntities[common.Workflow]; ok {
+	if ok := joinTableEntities[common.Workflow]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.workflow_id = %s.id",
 			workflowTableName, executionTableName, workflowTableName))
 	}
-	if ok := input.JoinTableEntities[common.Task]; ok {
+	if ok := joinTableEntities[common.Task]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.task_id = %s.id",
 			taskTableName, executionTableName, taskTableName))
 	}
 
-	if ok := input.JoinTableEntities[common.AdminTag]; ok {
+	if ok := joinTableEntities[common.AdminTag]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.execution_name = %s.execution_name",
 			executionAdminTagsTableName, executionTableName, executionAdminTagsTableName))
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.id = %s.admin_tag_id",
 			AdminTagsTableName, AdminTagsTableName, executionAdminTagsTableName))
 	}
+	return tx
+}
+
+func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.ExecutionCollectionOutput, error) {
+	var err error
+	// First validate input.
+	if err = ValidateListInput(input); err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
+	}
+	var executions []models.Execution
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
+	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
+	tx = applyExecutionJoins(tx, input.JoinTableEntities)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, executionTableName, input)
+	if err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -120,7 +128,7 @@ func (r *ExecutionRepo) List(_ context.Context, input interfaces.ListResourceInp
 
 func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.Model(&models.Execution{})
+	tx := r.db.WithContext(ctx).Model(&models.Execution{})
 
 	// Add join condition as required by user-specified filters (which can potentially include join table attrs).
 	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
@@ -153,6 +161,353 @@ func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourc
 	return count, nil
 }
 
+// Expressions of the dimensions executions are grouped by, other than time.
+var executionGroupByExprs = map[interfaces.ExecutionGroupBy]string{
+	interfaces.ExecutionGroupByPhase:      "executions.phase",
+	interfaces.ExecutionGroupByLaunchPlan: "launch_plans.name",
+	interfaces.ExecutionGroupByWorkflow:   "workflows.name",
+	interfaces.ExecutionGroupByUser:       "executions.user",
+	interfaces.ExecutionGroupByCluster:    "executions.cluster",
+	interfaces.ExecutionGroupByErrorKind:  "executions.error_kind",
+}
+
+// The columns of the dimensions executions are grouped by, which are scanned into the fields of
+// interfaces.ExecutionAggregate.
+var executionGroupByColumns = map[interfaces.ExecutionGroupBy]string{
+	interfaces.ExecutionGroupByPhase:      "group_phase",
+	interfaces.ExecutionGroupByLaunchPlan: "group_launch_plan",
+	interfaces.ExecutionGroupByWorkflow:   "group_workflow",
+	interfaces.ExecutionGroupByUser:       "group_user",
+	interfaces.ExecutionGroupByCluster:    "group_cluster",
+	interfaces.ExecutionGroupByErrorKind:  "group_error_kind",
+	interfaces.ExecutionGroupByTime:       "group_time",
+}
+
+// Truncates creation times to the time bucket, formatted as RFC3339 in UTC.
+var postgresTimeBucketExprs = map[interfaces.ExecutionTimeBucket]string{
+	interfaces.ExecutionTimeBucketHour: `to_char(date_trunc('hour', executions.created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`,
+	interfaces.ExecutionTimeBucketDay:  `to_char(date_trunc('day', executions.created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`,
+	interfaces.ExecutionTimeBucketWeek: `to_char(date_trunc('week', executions.created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`,
+}
+
+var sqliteTimeBucketExprs = map[interfaces.ExecutionTimeBucket]string{
+	interfaces.ExecutionTimeBucketHour: `strftime('%Y-%m-%dT%H:00:00Z', executions.created_at)`,
+	interfaces.ExecutionTimeBucketDay:  `strftime('%Y-%m-%dT00:00:00Z', executions.created_at)`,
+	interfaces.ExecutionTimeBucketWeek: `strftime('%Y-%m-%dT00:00:00Z', executions.created_at, '-6 days', 'weekday 1')`,
+}
+
+// Percentiles of the durations of completed executions, which other databases than postgres can't compute.
+const postgresDurationPercentileFmt = "percentile_cont(%v) WITHIN GROUP (ORDER BY executions.duration) " +
+	"FILTER (WHERE executions.duration > 0) AS %s"
+
+var executionDurationPercentiles = []struct {
+	percentile float64
+	column     string
+}{
+	{percentile: 0.5, column: "duration_p50"},
+	{percentile: 0.9, column: "duration_p90"},
+	{percentile: 0.99, column: "duration_p99"},
+}
+
+func (r *ExecutionRepo) Aggregate(ctx context.Context, input interfaces.ExecutionAggregationInput) (
+	interfaces.ExecutionAggregationOutput, error) {
+	if input.Limit == 0 {
+		return interfaces.ExecutionAggregationOutput{}, adminErrors.GetInvalidInputError(limit)
+	}
+	isPostgres := r.db.Dialector.Name() == "postgres"
+	joinTableEntities := make(map[common.Entity]bool, len(input.JoinTableEntities)+2)
+	for entity, join := range input.JoinTableEntities {
+		joinTableEntities[entity] = join
+	}
+
+	selects := make([]string, 0, len(input.GroupBy)+1+len(executionDurationPercentiles))
+	groups := make([]string, 0, len(input.GroupBy))
+	for _, groupBy := range input.GroupBy {
+		column, ok := executionGroupByColumns[groupBy]
+		if !ok {
+			return interfaces.ExecutionAggregationOutput{}, adminErrors.GetInvalidInputError(string(groupBy))
+		}
+		var expr string
+		switch groupBy {
+		case interfaces.ExecutionGroupByTime:
+			timeBucketExprs := sqliteTimeBucketExprs
+			if isPostgres {
+				timeBucketExprs = postgresTimeBucketExprs
+			}
+			if expr, ok = timeBucketExprs[input.TimeBucket]; !ok {
+				return interfaces.ExecutionAggregationOutput{}, adminErrors.GetInvalidInputError(
+					string(input.TimeBucket))
+			}
+		case interfaces.ExecutionGroupByLaunchPlan:
+			joinTableEntities[common.LaunchPlan] = true
+			expr = executionGroupByExprs[groupBy]
+		case interfaces.ExecutionGroupByWorkflow:
+			joinTableEntities[common.Workflow] = true
+			expr = executionGroupByExprs[groupBy]
+		default:
+			expr = executionGroupByExprs[groupBy]
+		}
+		selects = append(selects, fmt.Sprintf("COALESCE(%s, '') AS %s", expr, column))
+		groups = append(groups, column)
+	}
+	selects = append(selects, "COUNT(*) AS execution_count")
+	if isPostgres {
+		for _, percentile := range executionDurationPercentiles {
+			selects = append(selects, fmt.Sprintf(postgresDurationPercentileFmt, percentile.percentile, percentile.column))
+		}
+	}
+
+	tx := applyExecutionJoins(r.db.WithContext(ctx).Model(&models.Execution{}), joinTableEntities)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.ExecutionAggregationOutput{}, err
+	}
+	tx = tx.Select(strings.Join(selects, ", "))
+	if len(groups) > 0 {
+		tx = tx.Group(strings.Join(groups, ", ")).Order(strings.Join(groups, ", "))
+	}
+
+	var aggregates []interfaces.ExecutionAggregate
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Limit(input.Limit).Scan(&aggregates)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.ExecutionAggregationOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.ExecutionAggregationOutput{
+		Aggregates: aggregates,
+	}, nil
+}
+
+// Matches rows by execution key, for postgres as well as sqlite which only supports comparing row values to lists of
+// VALUES.
+func getExecutionKeysMatch(keys []models.ExecutionKey) (string, []interface{}) {
+	placeholders := make([]string, len(keys))
+	args := make([]interface{}, 0, 3*len(keys))
+	for idx, key := range keys {
+		placeholders[idx] = "(?, ?, ?)"
+		args = append(args, key.Project, key.Domain, key.Name)
+	}
+	return fmt.Sprintf("(execution_project, execution_domain, execution_name) IN (VALUES %s)",
+		strings.Join(placeholders, ", ")), args
+}
+
+// The tables holding rows of executions, in an order they can be deleted in when foreign key constraints are enforced.
+var executionDeleteTables = []string{
+	executionEventTableName,
+	nodeExecutionEventTableName,
+	signalTableName,
+	outboxEventTableName,
+	executionAdminTagsTableName,
+	nodeExecutionTableName,
+	taskExecutionTableName,
+	executionTableName,
+}
+
+func appendDataReferences(references []storage.DataReference, uris ...string) []storage.DataReference {
+	for _, uri := range uris {
+		if len(uri) > 0 {
+			references = append(references, storage.DataReference(uri))
+		}
+	}
+	return references
+}
+
+// Returns the data references recorded by the executions matching the keys.
+func getExecutionDataReferences(tx *gorm.DB, keysMatch string, keys []interface{}) (
+	[]storage.DataReference, error) {
+	var executions []models.Execution
+	if err := tx.Select("inputs_uri", "user_inputs_uri").Where(keysMatch, keys...).
+		Find(&executions).Error; err != nil {
+		return nil, err
+	}
+	var nodeExecutions []models.NodeExecution
+	if err := tx.Select("input_uri", "dynamic_workflow_remote_closure_reference").Where(keysMatch, keys...).
+		Find(&nodeExecutions).Error; err != nil {
+		return nil, err
+	}
+	var taskExecutions []models.TaskExecution
+	if err := tx.Select("input_uri").Where(keysMatch, keys...).Find(&taskExecutions).Error; err != nil {
+		return nil, err
+	}
+	references := make([]storage.DataReference, 0, 2*(len(executions)+len(nodeExecutions))+len(taskExecutions))
+	for _, execution := range executions {
+		references = appendDataReferences(references, execution.InputsURI.String(), execution.UserInputsURI.String())
+	}
+	for _, nodeExecution := range nodeExecutions {
+		references = appendDataReferences(references, nodeExecution.InputURI,
+			nodeExecution.DynamicWorkflowRemoteClosureReference)
+	}
+	for _, taskExecution := range taskExecutions {
+		references = appendDataReferences(references, taskExecution.InputURI)
+	}
+	return references, nil
+}
+
+func (r *ExecutionRepo) Delete(ctx context.Context, input interfaces.ExecutionDeleteInput) (
+	interfaces.ExecutionDeleteOutput, error) {
+	output := interfaces.ExecutionDeleteOutput{
+		RowsAffected: make(map[string]int64, len(executionDeleteTables)),
+	}
+	if len(input.Executions) == 0 {
+		return output, nil
+	}
+	keysMatch, keys := getExecutionKeysMatch(input.Executions)
+
+	timer := r.metrics.DeleteDuration.Start()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if output.DataReferences, err = getExecutionDataReferences(tx, keysMatch, keys); err != nil {
+			return err
+		}
+		if input.DryRun {
+			for _, table := range executionDeleteTables {
+				var count int64
+				if err := tx.Table(table).Where(keysMatch, keys...).Count(&count).Error; err != nil {
+					return err
+				}
+				output.RowsAffected[table] = count
+			}
+			return nil
+		}
+		// Executions launched by the deleted node executions are kept, they just no longer refer to them.
+		if err := tx.Model(&models.Execution{}).Where("parent_node_execution_id IN (?)",
+			tx.Table(nodeExecutionTableName).Select("id").Where(keysMatch, keys...)).
+			UpdateColumn("parent_node_execution_id", nil).Error; err != nil {
+			return err
+		}
+		for _, table := range executionDeleteTables {
+			deleted := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", table, keysMatch), keys...)
+			if deleted.Error != nil {
+				return deleted.Error
+			}
+			output.RowsAffected[table] = deleted.RowsAffected
+		}
+		return nil
+	})
+	timer.Stop()
+	if err != nil {
+		return interfaces.ExecutionDeleteOutput{}, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return output, nil
+}
+
+func (r *ExecutionRepo) GetRecords(ctx context.Context, executions []models.ExecutionKey) (
+	interfaces.ExecutionRecords, error) {
+	var records interfaces.ExecutionRecords
+	if len(executions) == 0 {
+		return records, nil
+	}
+	keysMatch, keys := getExecutionKeysMatch(executions)
+
+	timer := r.metrics.GetDuration.Start()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, rows := range []interface{}{&records.Executions, &records.NodeExecutions, &records.TaskExecutions,
+			&records.ExecutionEvents, &records.NodeExecutionEvents} {
+			if err := tx.Where(keysMatch, keys...).Order("id").Find(rows).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	timer.Stop()
+	if err != nil {
+		return interfaces.ExecutionRecords{}, r.errorTransformer.ToFlyteAdminError(err)
+	}
+
+	nodeIDs := make(map[uint]string, len(records.NodeExecutions))
+	for _, nodeExecution := range records.NodeExecutions {
+		nodeIDs[nodeExecution.ID] = nodeExecution.NodeID
+	}
+	records.NodeExecutionParents = make(map[models.NodeExecutionKey]string)
+	for _, nodeExecution := range records.NodeExecutions {
+		if nodeExecution.ParentID != nil {
+			records.NodeExecutionParents[nodeExecution.NodeExecutionKey] = nodeIDs[*nodeExecution.ParentID]
+		}
+	}
+	return records, nil
+}
+
+// Creates node executions after their parents, as they refer to them by id.
+func createNodeExecutions(tx *gorm.DB, nodeExecutions []models.NodeExecution,
+	parents map[models.NodeExecutionKey]string) error {
+	isParent := make(map[models.NodeExecutionKey]bool, len(parents))
+	for key, parentNodeID := range parents {
+		isParent[models.NodeExecutionKey{ExecutionKey: key.ExecutionKey, NodeID: parentNodeID}] = true
+	}
+	ids := make(map[models.NodeExecutionKey]uint, len(parents))
+	pending := nodeExecutions
+	for len(pending) > 0 {
+		var remaining []models.NodeExecution
+		for _, nodeExecution := range pending {
+			nodeExecution.ParentID = nil
+			if parentNodeID, ok := parents[nodeExecution.NodeExecutionKey]; ok {
+				parentID, created := ids[models.NodeExecutionKey{
+					ExecutionKey: nodeExecution.ExecutionKey,
+					NodeID:       parentNodeID,
+				}]
+				if !created {
+					remaining = append(remaining, nodeExecution)
+					continue
+				}
+				nodeExecution.ParentID = &parentID
+			}
+			if err := tx.Omit("id").Create(&nodeExecution).Error; err != nil {
+				return err
+			}
+			if isParent[nodeExecution.NodeExecutionKey] {
+				// The id isn't the primary key, so it isn't returned when creating the row.
+				var created models.NodeExecution
+				if err := tx.Select("id").Where(&models.NodeExecution{
+					NodeExecutionKey: nodeExecution.NodeExecutionKey,
+				}).Take(&created).Error; err != nil {
+					return err
+				}
+				ids[nodeExecution.NodeExecutionKey] = created.ID
+			}
+		}
+		if len(remaining) == len(pending) {
+			return adminErrors.GetInvalidInputError(fmt.Sprintf("parent node [%s] of node execution [%s]",
+				parents[remaining[0].NodeExecutionKey], remaining[0].NodeID))
+		}
+		pending = remaining
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) CreateRecords(ctx context.Context, records interfaces.ExecutionRecords) error {
+	timer := r.metrics.CreateDuration.Start()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(records.Executions) > 0 {
+			if err := tx.Omit("id").Create(&records.Executions).Error; err != nil {
+				return err
+			}
+		}
+		if err := createNodeExecutions(tx, records.NodeExecutions, records.NodeExecutionParents); err != nil {
+			return err
+		}
+		if len(records.TaskExecutions) > 0 {
+			if err := tx.Omit("id").Create(&records.TaskExecutions).Error; err != nil {
+				return err
+			}
+		}
+		if len(records.ExecutionEvents) > 0 {
+			if err := tx.Omit("id").Create(&records.ExecutionEvents).Error; err != nil {
+				return err
+			}
+		}
+		if len(records.NodeExecutionEvents) > 0 {
+			return tx.Omit("id").Create(&records.NodeExecutionEvents).Error
+		}
+		return nil
+	})
+	timer.Stop()
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionRepo(
 	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionRepoInterface {
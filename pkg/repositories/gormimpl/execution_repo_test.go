@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,8 +11,11 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
@@ -43,7 +47,6 @@ func TestCreateExecution(t *testing.T) {
 
 func TestUpdateExecution(t *testing.T) {
 	GlobalMock := mocket.Catcher.Reset()
-	GlobalMock.Logging = true
 	updated := false
 
 	// Only match on queries that append expected filters
@@ -126,7 +129,6 @@ func TestGetExecution(t *testing.T) {
 	executions = append(executions, execution)
 
 	GlobalMock := mocket.Catcher.Reset()
-	GlobalMock.Logging = true
 
 	// Only match on queries that append expected filters
 	GlobalMock.NewMock().WithQuery(`SELECT * FROM "executions" WHERE "executions"."execution_project" = $1 AND "executions"."execution_domain" = $2 AND "executions"."execution_name" = $3 LIMIT 1`).WithReply(executions)
@@ -272,6 +274,73 @@ func TestListExecutions_Order(t *testing.T) {
 	assert.True(t, mockQuery.Triggered)
 }
 
+func TestListExecutions_Cursor(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "executions" WHERE tasks.project = $1 AND tasks.domain = $2 AND tasks.name = $3 AND (executions.created_at, executions.id) < ($4, $5) ORDER BY created_at desc,executions.id desc LIMIT 20`)
+	mockQuery.WithReply(make([]map[string]interface{}, 0))
+
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Direction: admin.Sort_DESCENDING,
+		Key:       "created_at",
+	}, models.ExecutionColumns)
+	require.NoError(t, err)
+	input := interfaces.ListResourceInput{
+		SortParameter: sortParameter,
+		Cursor: &common.PageCursor{
+			SortKey:   "created_at",
+			Direction: admin.Sort_DESCENDING,
+			SortValue: executionStartedAt,
+			ID:        10,
+		},
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Task, "project", project),
+			getEqualityFilter(common.Task, "domain", domain),
+			getEqualityFilter(common.Task, "name", name),
+		},
+		Limit: 20,
+	}
+	_, err = executionRepo.List(context.Background(), input)
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+
+	// Cursors are only valid for the sort order they were created with.
+	input.Cursor.Direction = admin.Sort_ASCENDING
+	_, err = executionRepo.List(context.Background(), input)
+	assert.Error(t, err)
+	input.SortParameter = nil
+	_, err = executionRepo.List(context.Background(), input)
+	assert.Error(t, err)
+}
+
+func TestListExecutions_FilterGroups(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`INNER JOIN launch_plans ON executions.launch_plan_id = launch_plans.id WHERE executions.execution_project = $1 AND ((executions.phase = $2 OR NOT (executions.error_code IS NULL AND launch_plans.name = $3))) LIMIT 20`)
+	mockQuery.WithReply(make([]map[string]interface{}, 0))
+
+	errorCodeFilter, err := common.NewNullFilter(common.Execution, common.IsNull, "error_code")
+	require.NoError(t, err)
+	notFilter, err := common.NewNotFilterGroup(errorCodeFilter, getEqualityFilter(common.LaunchPlan, "name", name))
+	require.NoError(t, err)
+	orFilter, err := common.NewOrFilterGroup(getEqualityFilter(common.Execution, "phase", "FAILED"), notFilter)
+	require.NoError(t, err)
+	_, err = executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "execution_project", project),
+			orFilter,
+		},
+		JoinTableEntities: map[common.Entity]bool{common.LaunchPlan: true},
+		Limit:             20,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+}
+
 func TestListExecutions_WithTags(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -346,7 +415,6 @@ func TestListExecutionsForWorkflow(t *testing.T) {
 	executions = append(executions, execution)
 
 	GlobalMock := mocket.Catcher.Reset()
-	GlobalMock.Logging = true
 	// Only match on queries that append expected filters
 	GlobalMock.NewMock().WithQuery(`SELECT "executions"."id","executions"."created_at","executions"."updated_at","executions"."deleted_at","executions"."execution_project","executions"."execution_domain","executions"."execution_name","executions"."launch_plan_id","executions"."workflow_id","executions"."task_id","executions"."phase","executions"."closure","executions"."spec","executions"."started_at","executions"."execution_created_at","executions"."execution_updated_at","executions"."duration","executions"."abort_cause","executions"."mode","executions"."source_execution_id","executions"."parent_node_execution_id","executions"."cluster","executions"."inputs_uri","executions"."user_inputs_uri","executions"."error_kind","executions"."error_code","executions"."user","executions"."state","executions"."launch_entity" FROM "executions" INNER JOIN workflows ON executions.workflow_id = workflows.id INNER JOIN tasks ON executions.task_id = tasks.id WHERE executions.execution_project = $1 AND executions.execution_domain = $2 AND executions.execution_name = $3 AND workflows.name = $4 AND tasks.name = $5 AND execution_admin_tags.execution_tag_name in ($6,$7) LIMIT 20`).WithReply(executions)
 	vals := []string{"tag1", "tag2"}
@@ -387,6 +455,241 @@ func TestListExecutionsForWorkflow(t *testing.T) {
 	}
 }
 
+func TestAggregateExecutions(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT COALESCE(launch_plans.name, '') AS group_launch_plan, COALESCE(to_char(date_trunc('day', executions.created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"'), '') AS group_time, COUNT(*) AS execution_count, percentile_cont(0.5) WITHIN GROUP (ORDER BY executions.duration) FILTER (WHERE executions.duration > 0) AS duration_p50, percentile_cont(0.9) WITHIN GROUP (ORDER BY executions.duration) FILTER (WHERE executions.duration > 0) AS duration_p90, percentile_cont(0.99) WITHIN GROUP (ORDER BY executions.duration) FILTER (WHERE executions.duration > 0) AS duration_p99 FROM "executions" INNER JOIN launch_plans ON executions.launch_plan_id = launch_plans.id WHERE executions.execution_project = $1 GROUP BY group_launch_plan, group_time ORDER BY group_launch_plan, group_time LIMIT 20`)
+	mockQuery.WithArgs(project)
+	mockQuery.WithReply([]map[string]interface{}{
+		{
+			"group_launch_plan": "lp",
+			"group_time":        "2018-02-17T00:00:00Z",
+			"execution_count":   4,
+			"duration_p50":      60e9,
+			"duration_p90":      90e9,
+			"duration_p99":      99e9,
+		},
+	})
+
+	output, err := executionRepo.Aggregate(context.Background(), interfaces.ExecutionAggregationInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+		},
+		GroupBy:    []interfaces.ExecutionGroupBy{interfaces.ExecutionGroupByLaunchPlan, interfaces.ExecutionGroupByTime},
+		TimeBucket: interfaces.ExecutionTimeBucketDay,
+		Limit:      20,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+	require.Len(t, output.Aggregates, 1)
+	assert.Equal(t, "lp", output.Aggregates[0].GroupLaunchPlan)
+	assert.Equal(t, "2018-02-17T00:00:00Z", output.Aggregates[0].GroupTime)
+	assert.Equal(t, int64(4), output.Aggregates[0].Count)
+	require.NotNil(t, output.Aggregates[0].DurationP90)
+	assert.Equal(t, 90e9, *output.Aggregates[0].DurationP90)
+}
+
+func TestAggregateExecutions_NonPostgres(t *testing.T) {
+	mocket.Catcher.Register()
+	db, err := gorm.Open(nonPostgresDialector{postgres.New(postgres.Config{DriverName: mocket.DriverName})})
+	assert.NoError(t, err)
+	executionRepo := NewExecutionRepo(db, errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT COALESCE(executions.phase, '') AS group_phase, COALESCE(strftime('%Y-%m-%dT00:00:00Z', executions.created_at, '-6 days', 'weekday 1'), '') AS group_time, COUNT(*) AS execution_count FROM "executions" GROUP BY group_phase, group_time ORDER BY group_phase, group_time LIMIT 10`)
+	mockQuery.WithReply([]map[string]interface{}{
+		{"group_phase": "SUCCEEDED", "group_time": "2018-02-12T00:00:00Z", "execution_count": 2},
+	})
+
+	output, err := executionRepo.Aggregate(context.Background(), interfaces.ExecutionAggregationInput{
+		GroupBy:    []interfaces.ExecutionGroupBy{interfaces.ExecutionGroupByPhase, interfaces.ExecutionGroupByTime},
+		TimeBucket: interfaces.ExecutionTimeBucketWeek,
+		Limit:      10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+	require.Len(t, output.Aggregates, 1)
+	assert.Equal(t, "SUCCEEDED", output.Aggregates[0].GroupPhase)
+	assert.Equal(t, int64(2), output.Aggregates[0].Count)
+	assert.Nil(t, output.Aggregates[0].DurationP50)
+}
+
+func TestAggregateExecutions_InvalidInput(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	_, err := executionRepo.Aggregate(context.Background(), interfaces.ExecutionAggregationInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+
+	_, err = executionRepo.Aggregate(context.Background(), interfaces.ExecutionAggregationInput{
+		GroupBy:    []interfaces.ExecutionGroupBy{interfaces.ExecutionGroupByTime},
+		TimeBucket: "month",
+		Limit:      10,
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: month")
+}
+
+func TestDeleteExecutions(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	keysMatch := `(execution_project, execution_domain, execution_name) IN (VALUES ($1, $2, $3), ($4, $5, $6))`
+	input := interfaces.ExecutionDeleteInput{
+		Executions: []models.ExecutionKey{
+			{Project: project, Domain: domain, Name: "a"},
+			{Project: project, Domain: domain, Name: "b"},
+		},
+	}
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT "inputs_uri","user_inputs_uri" FROM "executions" WHERE `+keysMatch).
+		WithArgs(project, domain, "a", project, domain, "b").
+		WithReply([]map[string]interface{}{{"inputs_uri": "s3://bucket/metadata/inputs", "user_inputs_uri": ""}})
+	GlobalMock.NewMock().WithQuery(
+		`SELECT "input_uri","dynamic_workflow_remote_closure_reference" FROM "node_executions" WHERE ` + keysMatch).
+		WithReply([]map[string]interface{}{{"input_uri": "", "dynamic_workflow_remote_closure_reference": "s3://closure"}})
+	updateQuery := GlobalMock.NewMock().WithQuery(
+		`UPDATE "executions" SET "parent_node_execution_id"=$1 WHERE parent_node_execution_id IN (SELECT id FROM "node_executions" WHERE ` +
+			`(execution_project, execution_domain, execution_name) IN (VALUES ($2, $3, $4), ($5, $6, $7)))`)
+	deleteQueries := make(map[string]*mocket.FakeResponse, len(executionDeleteTables))
+	for _, table := range executionDeleteTables {
+		deleteQueries[table] = GlobalMock.NewMock().WithQuery(
+			fmt.Sprintf("DELETE FROM %s WHERE %s", table, keysMatch)).WithRowsNum(2)
+	}
+
+	output, err := executionRepo.Delete(context.Background(), input)
+	assert.NoError(t, err)
+	assert.True(t, updateQuery.Triggered)
+	for table, deleteQuery := range deleteQueries {
+		assert.True(t, deleteQuery.Triggered, table)
+		assert.Equal(t, int64(2), output.RowsAffected[table], table)
+	}
+	assert.Equal(t, []storage.DataReference{"s3://bucket/metadata/inputs", "s3://closure"}, output.DataReferences)
+}
+
+func TestDeleteExecutions_DryRun(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	countQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT count(*) FROM "node_executions" WHERE (execution_project, execution_domain, execution_name) IN (VALUES ($1, $2, $3))`).
+		WithReply([]map[string]interface{}{{"rows": 7}})
+	deleteQuery := GlobalMock.NewMock().WithQuery(`DELETE`)
+
+	output, err := executionRepo.Delete(context.Background(), interfaces.ExecutionDeleteInput{
+		Executions: []models.ExecutionKey{{Project: project, Domain: domain, Name: "a"}},
+		DryRun:     true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, countQuery.Triggered)
+	assert.False(t, deleteQuery.Triggered)
+	assert.Equal(t, int64(7), output.RowsAffected[nodeExecutionTableName])
+	assert.Len(t, output.RowsAffected, len(executionDeleteTables))
+}
+
+func TestGetExecutionRecords(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	keysMatch := `(execution_project, execution_domain, execution_name) IN (VALUES ($1, $2, $3)) ORDER BY id`
+	parentID := uint(1)
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "executions" WHERE `+keysMatch).
+		WithArgs(project, domain, name).
+		WithReply([]map[string]interface{}{{"id": 1, "execution_project": project, "execution_domain": domain,
+			"execution_name": name, "phase": "SUCCEEDED"}})
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "node_executions" WHERE ` + keysMatch).
+		WithReply([]map[string]interface{}{
+			{"id": 1, "execution_project": project, "execution_domain": domain, "execution_name": name,
+				"node_id": "parent", "parent_id": nil},
+			{"id": 2, "execution_project": project, "execution_domain": domain, "execution_name": name,
+				"node_id": "child", "parent_id": &parentID},
+		})
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "task_executions" WHERE ` + keysMatch).
+		WithReply([]map[string]interface{}{{"id": 1, "node_id": "child", "retry_attempt": 0}})
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_events" WHERE ` + keysMatch).
+		WithReply([]map[string]interface{}{{"id": 1, "phase": "RUNNING"}, {"id": 2, "phase": "SUCCEEDED"}})
+
+	records, err := executionRepo.GetRecords(context.Background(), []models.ExecutionKey{
+		{Project: project, Domain: domain, Name: name},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, records.Executions, 1)
+	assert.Equal(t, "SUCCEEDED", records.Executions[0].Phase)
+	assert.Len(t, records.NodeExecutions, 2)
+	assert.Len(t, records.TaskExecutions, 1)
+	assert.Len(t, records.ExecutionEvents, 2)
+	assert.Empty(t, records.NodeExecutionEvents)
+	executionKey := models.ExecutionKey{Project: project, Domain: domain, Name: name}
+	assert.Equal(t, map[models.NodeExecutionKey]string{
+		{ExecutionKey: executionKey, NodeID: "child"}: "parent",
+	}, records.NodeExecutionParents)
+}
+
+func TestCreateExecutionRecords(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	executionKey := models.ExecutionKey{Project: project, Domain: domain, Name: name}
+	retryAttempt := uint32(0)
+
+	GlobalMock := mocket.Catcher.Reset()
+	executionQuery := GlobalMock.NewMock().WithQuery(`INSERT INTO "executions"`)
+	GlobalMock.NewMock().WithQuery(`SELECT "id" FROM "node_executions"`).WithArgs(project, domain, name, "parent").
+		WithReply([]map[string]interface{}{{"id": 123}})
+	var nodeExecutionArgs [][]interface{}
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "node_executions"`).WithCallback(
+		func(query string, args []driver.NamedValue) {
+			values := make([]interface{}, len(args))
+			for idx, arg := range args {
+				values[idx] = arg.Value
+			}
+			nodeExecutionArgs = append(nodeExecutionArgs, values)
+		})
+	taskExecutionQuery := GlobalMock.NewMock().WithQuery(`INSERT INTO "task_executions"`)
+	eventQuery := GlobalMock.NewMock().WithQuery(`INSERT INTO "execution_events"`)
+
+	err := executionRepo.CreateRecords(context.Background(), interfaces.ExecutionRecords{
+		Executions: []models.Execution{{ExecutionKey: executionKey, Phase: "SUCCEEDED"}},
+		// Children may be listed before their parents.
+		NodeExecutions: []models.NodeExecution{
+			{BaseModel: models.BaseModel{ID: 2}, NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: executionKey, NodeID: "child"}},
+			{BaseModel: models.BaseModel{ID: 1}, NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: executionKey, NodeID: "parent"}},
+		},
+		NodeExecutionParents: map[models.NodeExecutionKey]string{
+			{ExecutionKey: executionKey, NodeID: "child"}: "parent",
+		},
+		TaskExecutions: []models.TaskExecution{{TaskExecutionKey: models.TaskExecutionKey{
+			NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: executionKey, NodeID: "child"},
+			RetryAttempt:     &retryAttempt,
+		}}},
+		ExecutionEvents: []models.ExecutionEvent{{ExecutionKey: executionKey, Phase: "RUNNING"}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, executionQuery.Triggered)
+	assert.True(t, taskExecutionQuery.Triggered)
+	assert.True(t, eventQuery.Triggered)
+	require.Len(t, nodeExecutionArgs, 2)
+	assert.Contains(t, nodeExecutionArgs[0], "parent")
+	assert.Contains(t, nodeExecutionArgs[1], "child")
+	assert.Contains(t, nodeExecutionArgs[1], int64(123))
+}
+
+func TestCreateExecutionRecords_MissingParent(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	executionKey := models.ExecutionKey{Project: project, Domain: domain, Name: name}
+
+	mocket.Catcher.Reset()
+	err := executionRepo.CreateRecords(context.Background(), interfaces.ExecutionRecords{
+		NodeExecutions: []models.NodeExecution{
+			{NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: executionKey, NodeID: "child"}},
+		},
+		NodeExecutionParents: map[models.NodeExecutionKey]string{
+			{ExecutionKey: executionKey, NodeID: "child"}: "parent",
+		},
+	})
+	assert.Error(t, err)
+}
+
 func TestCountExecutions(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -53,7 +53,7 @@ func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) er
 func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlan, error) {
 	var launchPlan models.LaunchPlan
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.LaunchPlan{
+	tx := r.db.WithContext(ctx).Where(&models.LaunchPlan{
 		LaunchPlanKey: models.LaunchPlanKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -115,7 +115,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -125,9 +125,10 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 	if err != nil {
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, launchPlanTableName, input)
+	if err != nil {
+		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -152,7 +153,7 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -179,6 +180,24 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 
 }
 
+func (r *LaunchPlanRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	timer := r.metrics.DeleteDuration.Start()
+	err := deleteEntityVersion(r.db, launchPlanTableName, core.ResourceType_LAUNCH_PLAN, input, auditEntry)
+	timer.Stop()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return adminErrors.GetMissingEntityError(core.ResourceType_LAUNCH_PLAN.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 // Returns an instance of LaunchPlanRepoInterface
 func NewLaunchPlanRepo(
 	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
@@ -0,0 +1,63 @@
+package gormimpl
+
+import (
+	"context"
+	"hash/fnv"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"gorm.io/gorm"
+)
+
+// Runs fn while holding the postgres advisory lock with the key, unless it's held elsewhere.
+func runExclusively(ctx context.Context, db *gorm.DB, errorTransformer errors.ErrorTransformer, key int64,
+	fn func(ctx context.Context) error) (bool, error) {
+	// Other databases are expected to be used by a single admin instance.
+	if db.Dialector.Name() != "postgres" {
+		return true, fn(ctx)
+	}
+	var acquired bool
+	var fnErr error
+	// Session level advisory locks must be released on the connection which acquired them.
+	err := db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", key).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		fnErr = fn(ctx)
+		return conn.Exec("SELECT pg_advisory_unlock(?)", key).Error
+	})
+	if err != nil {
+		return acquired, errorTransformer.ToFlyteAdminError(err)
+	}
+	return acquired, fnErr
+}
+
+// Returns the key of the advisory lock with the name.
+func getLockKey(name string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte("flyteadmin:" + name))
+	return int64(hash.Sum64())
+}
+
+// LockRepo holds postgres advisory locks, keyed by the hash of their names.
+type LockRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+}
+
+func (r *LockRepo) RunExclusively(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	return runExclusively(ctx, r.db, r.errorTransformer, getLockKey(name), fn)
+}
+
+// Returns an instance of LockRepoInterface
+func NewLockRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, _ promutils.Scope) interfaces.LockRepoInterface {
+	return &LockRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+	}
+}
@@ -0,0 +1,45 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestRunExclusively(t *testing.T) {
+	lockRepo := NewLockRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	key := getLockKey("purger")
+	assert.NotEqual(t, getLockKey("backfiller"), key)
+
+	t.Run("other databases", func(t *testing.T) {
+		db, err := gorm.Open(nonPostgresDialector{postgres.New(postgres.Config{DriverName: mocket.DriverName})})
+		assert.NoError(t, err)
+		ran := false
+		acquired, err := NewLockRepo(db, errors.NewTestErrorTransformer(), mockScope.NewTestScope()).RunExclusively(
+			context.Background(), "purger", func(ctx context.Context) error {
+				ran = true
+				return nil
+			})
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, ran)
+	})
+	t.Run("held elsewhere", func(t *testing.T) {
+		GlobalMock := mocket.Catcher.Reset()
+		GlobalMock.Logging = true
+		GlobalMock.NewMock().WithQuery(`SELECT pg_try_advisory_lock($1)`).WithArgs(key).
+			WithReply([]map[string]interface{}{{"pg_try_advisory_lock": false}})
+		acquired, err := lockRepo.RunExclusively(context.Background(), "purger", func(ctx context.Context) error {
+			t.Fatal("ran without holding the lock")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
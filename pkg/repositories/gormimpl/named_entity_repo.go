@@ -112,9 +112,11 @@ type NamedEntityRepo struct {
 }
 
 func (r *NamedEntityRepo) Update(ctx context.Context, input models.NamedEntity) error {
+	// The metadata is only created when the read finds none, which a lagging read replica could miss.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 	timer := r.metrics.UpdateDuration.Start()
 	var metadata models.NamedEntityMetadata
-	tx := r.db.Where(&models.NamedEntityMetadata{
+	tx := r.db.WithContext(ctx).Where(&models.NamedEntityMetadata{
 		NamedEntityMetadataKey: models.NamedEntityMetadataKey{
 			ResourceType: input.ResourceType,
 			Project:      input.Project,
@@ -143,7 +145,7 @@ func (r *NamedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEnti
 		return models.NamedEntity{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot get NamedEntityMetadata for resource type: %v", input.ResourceType)
 	}
 
-	tx := r.db.Table(tableName).Joins(joinString)
+	tx := r.db.WithContext(ctx).Table(tableName).Joins(joinString)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, filters, nil)
@@ -182,7 +184,7 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 			"Cannot list entity names for resource type: %v", input.ResourceType)
 	}
 
-	tx := getSubQueryJoin(r.db, tableName, input)
+	tx := getSubQueryJoin(r.db.WithContext(ctx), tableName, input)
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
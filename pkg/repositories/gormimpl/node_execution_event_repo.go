@@ -5,6 +5,7 @@ import (
 
 	"github.com/flyteorg/flytestdlib/promutils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
@@ -27,6 +28,50 @@ func (r *NodeExecutionEventRepo) Create(ctx context.Context, input models.NodeEx
 	return nil
 }
 
+func (r *NodeExecutionEventRepo) BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.Omit("id").Clauses(clause.OnConflict{DoNothing: true}).Create(&inputs)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NodeExecutionEventRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.NodeExecutionEventCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.NodeExecutionEventCollectionOutput{}, err
+	}
+	var events []models.NodeExecutionEvent
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
+
+	// Apply filters
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.NodeExecutionEventCollectionOutput{}, err
+	}
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, nodeExecutionEventTableName, input)
+	if err != nil {
+		return interfaces.NodeExecutionEventCollectionOutput{}, err
+	}
+
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.NodeExecutionEventCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.NodeExecutionEventCollectionOutput{
+		NodeExecutionEvents: events,
+	}, nil
+}
+
 // Returns an instance of NodeExecutionRepoInterface
 func NewNodeExecutionEventRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NodeExecutionEventRepoInterface {
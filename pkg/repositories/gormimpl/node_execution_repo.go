@@ -34,7 +34,7 @@ func (r *NodeExecutionRepo) Create(ctx context.Context, execution *models.NodeEx
 func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.NodeExecution{
+	tx := r.db.WithContext(ctx).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -66,7 +66,7 @@ func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecut
 func (r *NodeExecutionRepo) GetWithChildren(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.NodeExecution{
+	tx := r.db.WithContext(ctx).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -112,7 +112,7 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 	var nodeExecutions []models.NodeExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
+	tx := r.db.WithContext(ctx).Limit(input.Limit).Preload("ChildNodeExecutions")
 	// And add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
 	tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.execution_project = %s.execution_project AND "+
@@ -125,9 +125,10 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	if err != nil {
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, nodeExecutionTableName, input)
+	if err != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -144,7 +145,7 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 func (r *NodeExecutionRepo) Exists(ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.ExistsDuration.Start()
-	tx := r.db.Select(ID).Where(&models.NodeExecution{
+	tx := r.db.WithContext(ctx).Select(ID).Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.NodeId,
 			ExecutionKey: models.ExecutionKey{
@@ -163,7 +164,7 @@ func (r *NodeExecutionRepo) Exists(ctx context.Context, input interfaces.NodeExe
 
 func (r *NodeExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.Model(&models.NodeExecution{}).Preload("ChildNodeExecutions")
+	tx := r.db.WithContext(ctx).Model(&models.NodeExecution{}).Preload("ChildNodeExecutions")
 
 	// Add join condition (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// (this query isn't called for deletes).
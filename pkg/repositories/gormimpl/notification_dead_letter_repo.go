@@ -0,0 +1,80 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	flyteAdminDbErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"google.golang.org/grpc/codes"
+
+	"gorm.io/gorm"
+)
+
+type NotificationDeadLetterRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteAdminDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationDeadLetterRepo) Create(ctx context.Context, input models.NotificationDeadLetter) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Omit("id").Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeadLetterRepo) Get(ctx context.Context, id uint) (models.NotificationDeadLetter, error) {
+	var deadLetter models.NotificationDeadLetter
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.NotificationDeadLetter{ID: id}).Take(&deadLetter)
+	timer.Stop()
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return models.NotificationDeadLetter{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"dead-lettered notification [%d] not found", id)
+	} else if tx.Error != nil {
+		return models.NotificationDeadLetter{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return deadLetter, nil
+}
+
+func (r *NotificationDeadLetterRepo) ListPending(ctx context.Context, limit int) (
+	[]models.NotificationDeadLetter, error) {
+	var deadLetters []models.NotificationDeadLetter
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where("replayed_at IS NULL").Order("id").Limit(limit).Find(&deadLetters)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return deadLetters, nil
+}
+
+func (r *NotificationDeadLetterRepo) MarkReplayed(ctx context.Context, id uint) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.NotificationDeadLetter{}).Where("id = ?", id).
+		Update("replayed_at", time.Now())
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of NotificationDeadLetterRepoInterface
+func NewNotificationDeadLetterRepo(db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationDeadLetterRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationDeadLetterRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,79 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationDeadLetter(t *testing.T) {
+	deadLetterRepo := NewNotificationDeadLetterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	created := false
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "notification_dead_letters"`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			created = true
+		})
+
+	err := deadLetterRepo.Create(context.Background(), models.NotificationDeadLetter{
+		Processor:        "aws",
+		NotificationType: "email",
+		Message:          []byte("message"),
+		Reason:           "failed to send",
+		Attempts:         3,
+	})
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestGetNotificationDeadLetter(t *testing.T) {
+	deadLetterRepo := NewNotificationDeadLetterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "notification_dead_letters" WHERE "notification_dead_letters"."id" = $1 LIMIT 1`).WithReply(
+		[]map[string]interface{}{{"id": 4, "processor": "gcp", "reason": "failed to send"}})
+
+	deadLetter, err := deadLetterRepo.Get(context.Background(), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), deadLetter.ID)
+	assert.Equal(t, "gcp", deadLetter.Processor)
+}
+
+func TestListPendingNotificationDeadLetters(t *testing.T) {
+	deadLetterRepo := NewNotificationDeadLetterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "notification_dead_letters" WHERE replayed_at IS NULL ORDER BY id LIMIT 10`).WithReply(
+		[]map[string]interface{}{{"id": 1}, {"id": 2}})
+
+	deadLetters, err := deadLetterRepo.ListPending(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, deadLetters, 2)
+	assert.Equal(t, uint(2), deadLetters[1].ID)
+}
+
+func TestMarkNotificationDeadLetterReplayed(t *testing.T) {
+	deadLetterRepo := NewNotificationDeadLetterRepo(GetDbForTest(t), errors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	updated := false
+	GlobalMock.NewMock().WithQuery(`UPDATE "notification_dead_letters" SET "replayed_at"=$1 WHERE id = $2`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			updated = true
+		})
+
+	assert.NoError(t, deadLetterRepo.MarkReplayed(context.Background(), 1))
+	assert.True(t, updated)
+}
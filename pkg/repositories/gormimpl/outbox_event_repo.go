@@ -0,0 +1,86 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"gorm.io/gorm"
+)
+
+// Arbitrary key of the postgres advisory lock held by the outbox relay.
+const outboxRelayLockKey = 7306514269170450432
+
+type OutboxEventRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *OutboxEventRepo) RunExclusively(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return runExclusively(ctx, r.db, r.errorTransformer, outboxRelayLockKey, fn)
+}
+
+func (r *OutboxEventRepo) ListPending(ctx context.Context, limit, maxAttempts int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where("published_at IS NULL AND attempts < ?", maxAttempts).
+		Order("id").Limit(limit).Find(&events)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return events, nil
+}
+
+func (r *OutboxEventRepo) MarkPublished(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id IN ?", ids).
+		Update("published_at", time.Now())
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxEventRepo) MarkFailed(ctx context.Context, id uint, lastError string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": lastError,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxEventRepo) DeletePublished(ctx context.Context, publishedBefore time.Time) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.WithContext(ctx).Where("published_at < ?", publishedBefore).Delete(&models.OutboxEvent{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of OutboxEventRepoInterface
+func NewOutboxEventRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.OutboxEventRepoInterface {
+	metrics := newMetrics(scope)
+	return &OutboxEventRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
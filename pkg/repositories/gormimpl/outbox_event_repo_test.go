@@ -0,0 +1,74 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPendingOutboxEvents(t *testing.T) {
+	outboxEventRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "outbox_events" WHERE published_at IS NULL AND attempts < $1 ORDER BY id LIMIT 20`).WithReply(
+		[]map[string]interface{}{
+			{"id": 1, "sink": "external_events", "deduplication_key": "a"},
+			{"id": 2, "sink": "cloud_events", "deduplication_key": "a"},
+		})
+
+	events, err := outboxEventRepo.ListPending(context.Background(), 20, 10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint(1), events[0].ID)
+	assert.Equal(t, "cloud_events", events[1].Sink)
+}
+
+func TestMarkOutboxEventsPublished(t *testing.T) {
+	outboxEventRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	updated := false
+	GlobalMock.NewMock().WithQuery(`UPDATE "outbox_events" SET "published_at"=$1 WHERE id IN ($2,$3)`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			updated = true
+		})
+
+	assert.NoError(t, outboxEventRepo.MarkPublished(context.Background(), []uint{1, 2}))
+	assert.True(t, updated)
+}
+
+func TestMarkOutboxEventFailed(t *testing.T) {
+	outboxEventRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	updated := false
+	GlobalMock.NewMock().WithQuery(`UPDATE "outbox_events" SET "attempts"=attempts + 1,"last_error"=$1 WHERE id = $2`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			assert.Equal(t, "connection refused", values[0].Value)
+			updated = true
+		})
+
+	assert.NoError(t, outboxEventRepo.MarkFailed(context.Background(), 1, "connection refused"))
+	assert.True(t, updated)
+}
+
+func TestDeletePublishedOutboxEvents(t *testing.T) {
+	outboxEventRepo := NewOutboxEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	deleted := false
+	GlobalMock.NewMock().WithQuery(`DELETE FROM "outbox_events" WHERE published_at < $1`).WithCallback(
+		func(s string, values []driver.NamedValue) {
+			deleted = true
+		})
+
+	assert.NoError(t, outboxEventRepo.DeletePublished(context.Background(), time.Now().Add(-time.Hour)))
+	assert.True(t, deleted)
+}
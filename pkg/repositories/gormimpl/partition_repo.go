@@ -0,0 +1,108 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+// Implementation of PartitionRepoInterface.
+type PartitionRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *PartitionRepo) maintainTable(tx *gorm.DB, table string, input interfaces.PartitionMaintenanceInput,
+	output *interfaces.PartitionMaintenanceOutput) error {
+	partitions, err := config.ListPartitions(tx, table)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool, len(partitions))
+	for _, partition := range partitions {
+		existing[partition] = true
+	}
+	currentMonth := config.GetMonthStart(time.Now())
+	for month := 0; month <= input.FutureMonths; month++ {
+		partitionMonth := currentMonth.AddDate(0, month, 0)
+		partition := config.GetPartitionName(table, partitionMonth)
+		if existing[partition] {
+			continue
+		}
+		if !input.DryRun {
+			if err = config.CreateMonthlyPartition(tx, table, partitionMonth); err != nil {
+				return err
+			}
+		}
+		output.Created = append(output.Created, partition)
+	}
+	// Rows are created in the current month, so emptied partitions of past months stay empty. Rows restored for those
+	// months once their partitions are dropped are kept in the default partition.
+	for _, partition := range partitions {
+		month, ok := config.GetPartitionMonth(table, partition)
+		if !ok || !month.Before(currentMonth) {
+			continue
+		}
+		// Only the partitions the purger has emptied are detached, those holding the rows of executions kept by the
+		// retention policies, or still running, stay however old they are.
+		var hasRows bool
+		if err = tx.Raw(fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s)`, partition)).Scan(&hasRows).Error; err != nil {
+			return err
+		}
+		if hasRows {
+			continue
+		}
+		if !input.DryRun {
+			if err = config.DetachPartition(tx, table, partition); err != nil {
+				return err
+			}
+		}
+		output.Detached = append(output.Detached, partition)
+	}
+	return nil
+}
+
+func (r *PartitionRepo) Maintain(ctx context.Context, input interfaces.PartitionMaintenanceInput) (
+	interfaces.PartitionMaintenanceOutput, error) {
+	var output interfaces.PartitionMaintenanceOutput
+	if r.db.Dialector.Name() != "postgres" {
+		return output, nil
+	}
+	tx := r.db.WithContext(ctx)
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	for _, table := range config.PartitionedTables {
+		partitioned, err := config.IsPartitioned(tx, table.Name)
+		if err != nil {
+			return output, r.errorTransformer.ToFlyteAdminError(err)
+		}
+		if !partitioned {
+			logger.Debugf(ctx, "Skipping the maintenance of the partitions of unpartitioned table [%s]", table.Name)
+			continue
+		}
+		if err = r.maintainTable(tx, table.Name, input, &output); err != nil {
+			return output, r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	return output, nil
+}
+
+// Returns an instance of PartitionRepoInterface
+func NewPartitionRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.PartitionRepoInterface {
+	metrics := newMetrics(scope)
+	return &PartitionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
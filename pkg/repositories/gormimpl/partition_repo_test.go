@@ -0,0 +1,77 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintainPartitions(t *testing.T) {
+	partitionRepo := NewPartitionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	currentMonth := config.GetMonthStart(time.Now())
+	emptied := config.GetPartitionName("node_executions", currentMonth.AddDate(0, -2, 0))
+	populated := config.GetPartitionName("node_executions", currentMonth.AddDate(0, -1, 0))
+	current := config.GetPartitionName("node_executions", currentMonth)
+	next := config.GetPartitionName("node_executions", currentMonth.AddDate(0, 1, 0))
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	// Only node executions are partitioned.
+	GlobalMock.NewMock().WithQuery(`SELECT COUNT(*) FROM pg_partitioned_table`).WithArgs("node_executions").
+		WithReply([]map[string]interface{}{{"count": 1}})
+	GlobalMock.NewMock().WithQuery(`FROM pg_inherits`).WithArgs("node_executions").WithReply(
+		[]map[string]interface{}{
+			{"relname": "node_executions_default"}, {"relname": emptied}, {"relname": populated},
+			{"relname": current},
+		})
+	GlobalMock.NewMock().WithQuery(`SELECT EXISTS (SELECT 1 FROM ` + emptied + `)`).WithReply(
+		[]map[string]interface{}{{"exists": false}})
+	GlobalMock.NewMock().WithQuery(`SELECT EXISTS (SELECT 1 FROM ` + populated + `)`).WithReply(
+		[]map[string]interface{}{{"exists": true}})
+	createQuery := GlobalMock.NewMock().WithQuery(`CREATE TABLE IF NOT EXISTS ` + next)
+	detachQuery := GlobalMock.NewMock().WithQuery(`DETACH PARTITION ` + emptied)
+
+	output, err := partitionRepo.Maintain(context.Background(), interfaces.PartitionMaintenanceInput{
+		FutureMonths: 1,
+		DryRun:       true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{next}, output.Created)
+	assert.Equal(t, []string{emptied}, output.Detached)
+	assert.False(t, createQuery.Triggered)
+	assert.False(t, detachQuery.Triggered)
+}
+
+func TestMaintainPartitions_KeepsPopulated(t *testing.T) {
+	partitionRepo := NewPartitionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	currentMonth := config.GetMonthStart(time.Now())
+	// Holds the task executions of an execution kept by the retention policies, however old.
+	kept := config.GetPartitionName("task_executions", currentMonth.AddDate(-2, 0, 0))
+	populated := config.GetPartitionName("task_executions", currentMonth.AddDate(0, -1, 0))
+	current := config.GetPartitionName("task_executions", currentMonth)
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT COUNT(*) FROM pg_partitioned_table`).WithArgs("task_executions").
+		WithReply([]map[string]interface{}{{"count": 1}})
+	GlobalMock.NewMock().WithQuery(`FROM pg_inherits`).WithArgs("task_executions").WithReply(
+		[]map[string]interface{}{{"relname": kept}, {"relname": populated}, {"relname": current}})
+	keptQuery := GlobalMock.NewMock().WithQuery(`SELECT EXISTS (SELECT 1 FROM ` + kept + `)`).WithReply(
+		[]map[string]interface{}{{"exists": true}})
+	detachQuery := GlobalMock.NewMock().WithQuery(`DETACH PARTITION`)
+	GlobalMock.NewMock().WithQuery(`SELECT EXISTS (SELECT 1 FROM ` + populated + `)`).WithReply(
+		[]map[string]interface{}{{"exists": true}})
+
+	output, err := partitionRepo.Maintain(context.Background(), interfaces.PartitionMaintenanceInput{})
+	assert.NoError(t, err)
+	assert.Empty(t, output.Detached)
+	assert.True(t, keptQuery.Triggered)
+	assert.False(t, detachQuery.Triggered)
+}
@@ -34,7 +34,7 @@ func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error
 func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
 	var project models.Project
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Project{
+	tx := r.db.WithContext(ctx).Where(&models.Project{
 		Identifier: projectID,
 	}).Take(&project)
 	timer.Stop()
@@ -52,7 +52,8 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
 	var projects []models.Project
 
-	tx := r.db.Offset(input.Offset)
+	var err error
+	tx := r.db.WithContext(ctx)
 	if input.Limit != 0 {
 		tx = tx.Limit(input.Limit)
 	}
@@ -62,16 +63,16 @@ func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInp
 	if len(input.InlineFilters) == 0 && len(input.MapFilters) == 0 {
 		tx = tx.Where("state != ?", int32(admin.Project_ARCHIVED))
 	} else {
-		var err error
 		tx, err = applyFilters(tx, input.InlineFilters, input.MapFilters)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Apply sort ordering
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, projectTableName, input)
+	if err != nil {
+		return nil, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -102,7 +102,7 @@ func TestListProjects(t *testing.T) {
 		Limit:         1,
 		InlineFilters: []common.InlineFilter{filter},
 		SortParameter: alphabeticalSortParam,
-	}, `SELECT * FROM "projects" WHERE name = $1 ORDER BY identifier asc LIMIT 1`, t)
+	}, `SELECT * FROM "projects" WHERE name = $1 ORDER BY identifier asc,projects.id asc LIMIT 1`, t)
 }
 
 func TestListProjects_NoFilters(t *testing.T) {
@@ -110,14 +110,14 @@ func TestListProjects_NoFilters(t *testing.T) {
 		Offset:        0,
 		Limit:         1,
 		SortParameter: alphabeticalSortParam,
-	}, `SELECT * FROM "projects" WHERE state != $1 ORDER BY identifier asc`, t)
+	}, `SELECT * FROM "projects" WHERE state != $1 ORDER BY identifier asc,projects.id asc`, t)
 }
 
 func TestListProjects_NoLimit(t *testing.T) {
 	testListProjects(interfaces.ListResourceInput{
 		Offset:        0,
 		SortParameter: alphabeticalSortParam,
-	}, `SELECT * FROM "projects" WHERE state != $1 ORDER BY identifier asc`, t)
+	}, `SELECT * FROM "projects" WHERE state != $1 ORDER BY identifier asc,projects.id asc`, t)
 }
 
 func TestUpdateProject(t *testing.T) {
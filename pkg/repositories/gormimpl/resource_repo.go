@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	flyteAdminDbErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -60,9 +61,11 @@ func (r *ResourceRepo) CreateOrUpdate(ctx context.Context, input models.Resource
 	if input.Priority == 0 {
 		return flyteAdminDbErrors.GetInvalidInputError(fmt.Sprintf("invalid priority %v", input))
 	}
+	// The record is only created when the read finds none, which a lagging read replica could miss.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 	timer := r.metrics.GetDuration.Start()
 	var record models.Resource
-	tx := r.db.FirstOrCreate(&record, models.Resource{
+	tx := r.db.WithContext(ctx).FirstOrCreate(&record, models.Resource{
 		Project:      input.Project,
 		Domain:       input.Domain,
 		Workflow:     input.Workflow,
@@ -114,7 +117,7 @@ func (r *ResourceRepo) Get(ctx context.Context, ID interfaces.ResourceID) (model
 		launchPlan = append(launchPlan, ID.LaunchPlan)
 	}
 
-	tx := r.db.Where(txWhereClause, ID.ResourceType, domain, project, workflow, launchPlan)
+	tx := r.db.WithContext(ctx).Where(txWhereClause, ID.ResourceType, domain, project, workflow, launchPlan)
 	tx.Order(priorityDescending).First(&resources)
 	timer.Stop()
 
@@ -140,7 +143,7 @@ func (r *ResourceRepo) GetProjectLevel(ctx context.Context, ID interfaces.Resour
 
 	txWhereClause := "resource_type = ? AND domain = '' AND project = ? AND workflow = '' AND launch_plan = ''"
 
-	tx := r.db.Where(txWhereClause, ID.ResourceType, ID.Project)
+	tx := r.db.WithContext(ctx).Where(txWhereClause, ID.ResourceType, ID.Project)
 	tx.Order(priorityDescending).First(&resources)
 	timer.Stop()
 
@@ -159,7 +162,7 @@ func (r *ResourceRepo) GetRaw(ctx context.Context, ID interfaces.ResourceID) (mo
 	}
 	var model models.Resource
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Resource{
+	tx := r.db.WithContext(ctx).Where(&models.Resource{
 		Project:      ID.Project,
 		Domain:       ID.Domain,
 		Workflow:     ID.Workflow,
@@ -181,7 +184,7 @@ func (r *ResourceRepo) ListAll(ctx context.Context, resourceType string) ([]mode
 	var resources []models.Resource
 	timer := r.metrics.ListDuration.Start()
 
-	tx := r.db.Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
+	tx := r.db.WithContext(ctx).Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
 	timer.Stop()
 
 	if tx.Error != nil {
@@ -193,7 +196,7 @@ func (r *ResourceRepo) ListAll(ctx context.Context, resourceType string) ([]mode
 func (r *ResourceRepo) Delete(ctx context.Context, ID interfaces.ResourceID) error {
 	var tx *gorm.DB
 	r.metrics.DeleteDuration.Time(func() {
-		tx = r.db.Where(&models.Resource{
+		tx = r.db.WithContext(ctx).Where(&models.Resource{
 			Project:      ID.Project,
 			Domain:       ID.Domain,
 			Workflow:     ID.Workflow,
@@ -0,0 +1,115 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flytestdlib/promutils"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+const searchDocumentTableName = "search_documents"
+
+// Postgres matches documents against the tsvector of the search_vector column, where each term is a prefix query.
+const (
+	tsQueryFmt       = "to_tsquery('simple', ?)"
+	tsQueryTermFmt   = "%s:*"
+	tsQueryTermJoin  = " & "
+	tsRankSelectFmt  = "%s.*, ts_rank(search_vector, %s) AS search_rank"
+	tsVectorMatchFmt = "search_vector @@ %s"
+)
+
+// Other databases fall back to matching terms anywhere in the text columns, ranked using the postgres default weights
+// of the columns in the search_vector.
+var searchColumnWeights = []struct {
+	column string
+	weight float64
+}{
+	{column: "name", weight: 1.0},
+	{column: "description", weight: 0.4},
+	{column: "labels", weight: 0.2},
+	{column: "variables", weight: 0.2},
+}
+
+// Implementation of SearchRepoInterface.
+type SearchRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SearchRepo) applyTextSearch(tx *gorm.DB, terms []string) *gorm.DB {
+	if r.db.Dialector.Name() == "postgres" {
+		queryTerms := make([]string, len(terms))
+		for idx, term := range terms {
+			queryTerms[idx] = fmt.Sprintf(tsQueryTermFmt, term)
+		}
+		tsQuery := strings.Join(queryTerms, tsQueryTermJoin)
+		return tx.Select(fmt.Sprintf(tsRankSelectFmt, searchDocumentTableName, tsQueryFmt), tsQuery).
+			Where(fmt.Sprintf(tsVectorMatchFmt, tsQueryFmt), tsQuery)
+	}
+
+	rankExprs := make([]string, 0, len(terms)*len(searchColumnWeights))
+	rankArgs := make([]interface{}, 0, len(terms)*len(searchColumnWeights))
+	for _, term := range terms {
+		// Terms are alphanumeric, so there's no need to escape LIKE wildcards.
+		pattern := "%" + term + "%"
+		matchExprs := make([]string, len(searchColumnWeights))
+		matchArgs := make([]interface{}, len(searchColumnWeights))
+		for idx, column := range searchColumnWeights {
+			matchExprs[idx] = fmt.Sprintf("LOWER(%s) LIKE ?", column.column)
+			matchArgs[idx] = pattern
+			rankExprs = append(rankExprs, fmt.Sprintf("CASE WHEN LOWER(%s) LIKE ? THEN %v ELSE 0 END",
+				column.column, column.weight))
+			rankArgs = append(rankArgs, pattern)
+		}
+		tx = tx.Where(fmt.Sprintf("(%s)", strings.Join(matchExprs, " OR ")), matchArgs...)
+	}
+	return tx.Select(fmt.Sprintf("%s.*, %s AS search_rank", searchDocumentTableName, strings.Join(rankExprs, " + ")),
+		rankArgs...)
+}
+
+func (r *SearchRepo) Search(ctx context.Context, input interfaces.SearchInput) ([]models.SearchDocument, error) {
+	if len(input.Terms) == 0 {
+		return nil, errors.GetInvalidInputError("terms")
+	}
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	tx := r.applyTextSearch(r.db.WithContext(ctx).Model(&models.SearchDocument{}), input.Terms)
+	if len(input.ResourceTypes) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.%s IN ?", searchDocumentTableName, ResourceType), input.ResourceTypes)
+	}
+	if len(input.Project) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.%s = ?", searchDocumentTableName, Project), input.Project)
+	}
+	if len(input.Domain) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.%s = ?", searchDocumentTableName, Domain), input.Domain)
+	}
+
+	var documents []models.SearchDocument
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Order("search_rank desc").Order(identifierGroupBy).Order(ResourceType).
+		Limit(input.Limit).Offset(input.Offset).Find(&documents)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return documents, nil
+}
+
+// Returns an instance of SearchRepoInterface
+func NewSearchRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.SearchRepoInterface {
+	metrics := newMetrics(scope)
+	return &SearchRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
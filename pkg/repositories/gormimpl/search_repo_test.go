@@ -0,0 +1,88 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+func TestSearch(t *testing.T) {
+	searchRepo := NewSearchRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT search_documents.*, ts_rank(search_vector, to_tsquery('simple', $1)) AS search_rank FROM "search_documents" WHERE search_vector @@ to_tsquery('simple', $2) AND search_documents.resource_type IN ($3,$4) AND search_documents.project = $5 ORDER BY search_rank desc,project, domain, name,resource_type LIMIT 10 OFFSET 20`)
+	mockQuery.WithArgs("hello:* & world:*", "hello:* & world:*", int64(core.ResourceType_TASK), int64(core.ResourceType_WORKFLOW), project)
+	mockQuery.WithReply([]map[string]interface{}{
+		{
+			"resource_type": core.ResourceType_TASK,
+			"project":       project,
+			"domain":        domain,
+			"name":          "hello_world",
+			"version":       version,
+			"search_rank":   0.5,
+		},
+	})
+
+	documents, err := searchRepo.Search(context.Background(), interfaces.SearchInput{
+		Terms:         []string{"hello", "world"},
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK, core.ResourceType_WORKFLOW},
+		Project:       project,
+		Limit:         10,
+		Offset:        20,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+	assert.Len(t, documents, 1)
+	assert.Equal(t, "hello_world", documents[0].Name)
+	assert.Equal(t, version, documents[0].Version)
+	assert.Equal(t, 0.5, documents[0].SearchRank)
+}
+
+// Mocks databases other than postgres, with the postgres query syntax.
+type nonPostgresDialector struct {
+	gorm.Dialector
+}
+
+func (nonPostgresDialector) Name() string {
+	return "sqlite"
+}
+
+func TestSearch_Fallback(t *testing.T) {
+	mocket.Catcher.Register()
+	db, err := gorm.Open(nonPostgresDialector{postgres.New(postgres.Config{DriverName: mocket.DriverName})})
+	assert.NoError(t, err)
+	searchRepo := NewSearchRepo(db, errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock().WithQuery(
+		`SELECT search_documents.*, CASE WHEN LOWER(name) LIKE $1 THEN 1 ELSE 0 END + CASE WHEN LOWER(description) LIKE $2 THEN 0.4 ELSE 0 END + CASE WHEN LOWER(labels) LIKE $3 THEN 0.2 ELSE 0 END + CASE WHEN LOWER(variables) LIKE $4 THEN 0.2 ELSE 0 END AS search_rank FROM "search_documents" WHERE ((LOWER(name) LIKE $5 OR LOWER(description) LIKE $6 OR LOWER(labels) LIKE $7 OR LOWER(variables) LIKE $8)) AND search_documents.domain = $9 ORDER BY search_rank desc,project, domain, name,resource_type LIMIT 10`)
+	mockQuery.WithArgs("%hello%", "%hello%", "%hello%", "%hello%", "%hello%", "%hello%", "%hello%", "%hello%", domain)
+	mockQuery.WithReply([]map[string]interface{}{})
+
+	_, err = searchRepo.Search(context.Background(), interfaces.SearchInput{
+		Terms:  []string{"hello"},
+		Domain: domain,
+		Limit:  10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+}
+
+func TestSearch_InvalidInput(t *testing.T) {
+	searchRepo := NewSearchRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	_, err := searchRepo.Search(context.Background(), interfaces.SearchInput{Limit: 10})
+	assert.EqualError(t, err, "missing and/or invalid parameters: terms")
+	_, err = searchRepo.Search(context.Background(), interfaces.SearchInput{Terms: []string{"hello"}})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
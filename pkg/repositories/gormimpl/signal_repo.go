@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminerrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	flyteAdminDbErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
@@ -25,7 +26,7 @@ type SignalRepo struct {
 func (s *SignalRepo) Get(ctx context.Context, input models.SignalKey) (models.Signal, error) {
 	var signal models.Signal
 	timer := s.metrics.GetDuration.Start()
-	tx := s.db.Where(&models.Signal{
+	tx := s.db.WithContext(ctx).Where(&models.Signal{
 		SignalKey: input,
 	}).Take(&signal)
 	timer.Stop()
@@ -40,8 +41,10 @@ func (s *SignalRepo) Get(ctx context.Context, input models.SignalKey) (models.Si
 
 // GetOrCreate returns a signal if it already exists, if not it creates a new one given the input
 func (s *SignalRepo) GetOrCreate(ctx context.Context, input *models.Signal) error {
+	// The signal is only created when the read finds none, which a lagging read replica could miss.
+	ctx = context.WithValue(ctx, common.PrimaryReadsKey, true)
 	timer := s.metrics.CreateDuration.Start()
-	tx := s.db.FirstOrCreate(&input, input)
+	tx := s.db.WithContext(ctx).FirstOrCreate(&input, input)
 	timer.Stop()
 	if tx.Error != nil {
 		return s.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -56,16 +59,17 @@ func (s *SignalRepo) List(ctx context.Context, input interfaces.ListResourceInpu
 		return nil, err
 	}
 	var signals []models.Signal
-	tx := s.db.Limit(input.Limit).Offset(input.Offset)
+	tx := s.db.WithContext(ctx).Limit(input.Limit)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return nil, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, signalTableName, input)
+	if err != nil {
+		return nil, err
 	}
 	timer := s.metrics.ListDuration.Start()
 	tx.Find(&signals)
@@ -85,7 +89,7 @@ func (s *SignalRepo) Update(ctx context.Context, input models.SignalKey, value [
 	}
 
 	timer := s.metrics.GetDuration.Start()
-	tx := s.db.Model(&signal).Select("value").Updates(signal)
+	tx := s.db.WithContext(ctx).Model(&signal).Select("value").Updates(signal)
 	timer.Stop()
 	if tx.Error != nil {
 		return s.errorTransformer.ToFlyteAdminError(tx.Error)
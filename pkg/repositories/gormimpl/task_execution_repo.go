@@ -33,7 +33,7 @@ func (r *TaskExecutionRepo) Create(ctx context.Context, input models.TaskExecuti
 func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
 	var taskExecution models.TaskExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.TaskExecution{
+	tx := r.db.WithContext(ctx).Where(&models.TaskExecution{
 		TaskExecutionKey: models.TaskExecutionKey{
 			TaskKey: models.TaskKey{
 				Project: input.TaskExecutionID.TaskId.Project,
@@ -95,7 +95,7 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}
 
 	var taskExecutions []models.TaskExecution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
+	tx := r.db.WithContext(ctx).Limit(input.Limit).Preload("ChildNodeExecution")
 
 	// And add three join conditions (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// We are joining on task -> taskExec -> NodeExec -> Exec.
@@ -111,9 +111,10 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.TaskExecutionCollectionOutput{}, err
 	}
 
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, taskExecutionTableName, input)
+	if err != nil {
+		return interfaces.TaskExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -130,7 +131,7 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 
 func (r *TaskExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.Model(&models.TaskExecution{})
+	tx := r.db.WithContext(ctx).Model(&models.TaskExecution{})
 
 	// Add three join conditions (joining multiple tables is fine even we only filter on a subset of table attributes).
 	// We are joining on task -> taskExec -> NodeExec -> Exec.
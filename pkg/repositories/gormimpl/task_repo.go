@@ -49,7 +49,7 @@ func (r *TaskRepo) Create(_ context.Context, input models.Task, descriptionEntit
 func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Task, error) {
 	var task models.Task
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Task{
+	tx := r.db.WithContext(ctx).Where(&models.Task{
 		TaskKey: models.TaskKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -80,15 +80,16 @@ func (r *TaskRepo) List(
 		return interfaces.TaskCollectionOutput{}, err
 	}
 	var tasks []models.Task
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.TaskCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, taskTableName, input)
+	if err != nil {
+		return interfaces.TaskCollectionOutput{}, err
 	}
 	timer := r.metrics.ListDuration.Start()
 	tx.Find(&tasks)
@@ -110,7 +111,7 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.TaskCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -139,6 +140,24 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 	}, nil
 }
 
+func (r *TaskRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	timer := r.metrics.DeleteDuration.Start()
+	err := deleteEntityVersion(r.db, taskTableName, core.ResourceType_TASK, input, auditEntry)
+	timer.Stop()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return flyteAdminDbErrors.GetMissingEntityError(core.ResourceType_TASK.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 // Returns an instance of TaskRepoInterface
 func NewTaskRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.TaskRepoInterface {
@@ -2,11 +2,13 @@ package gormimpl
 
 import (
 	"context"
+	"database/sql/driver"
 	"testing"
 
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/stretchr/testify/assert"
@@ -282,3 +284,52 @@ func TestListTaskIds_MissingParameters(t *testing.T) {
 	// Limit must be specified
 	assert.Equal(t, "missing and/or invalid parameters: limit", err.Error())
 }
+
+func TestDeleteTask(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	identifier := interfaces.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	}
+	auditEntry := models.AuditEntry{
+		Action:       models.AuditActionDelete,
+		ResourceType: core.ResourceType_TASK,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+		Version:      version,
+		Principal:    "admin",
+		Reason:       "leaked secret",
+	}
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	// No rows are deleted for a missing version.
+	err := taskRepo.Delete(context.Background(), identifier, auditEntry)
+	assert.EqualError(t, err, "missing entity of type TASK with identifier project:\"project\" domain:\"domain\" name:\"name\" version:\"XYZ\" ")
+
+	GlobalMock.NewMock().WithQuery(
+		`DELETE FROM tasks WHERE project = $1 AND domain = $2 AND name = $3 AND version = $4`).
+		WithArgs(project, domain, name, version).WithRowsNum(1)
+	searchDocumentDeleted := false
+	GlobalMock.NewMock().WithQuery(
+		`DELETE FROM "search_documents" WHERE "search_documents"."resource_type" = $1 AND "search_documents"."project" = $2 AND "search_documents"."domain" = $3 AND "search_documents"."name" = $4 AND "search_documents"."version" = $5`).
+		WithCallback(func(s string, values []driver.NamedValue) {
+			searchDocumentDeleted = true
+		})
+	var auditArgs []driver.NamedValue
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "audit_entries"`).
+		WithCallback(func(s string, values []driver.NamedValue) {
+			auditArgs = values
+		})
+	err = taskRepo.Delete(context.Background(), identifier, auditEntry)
+	assert.NoError(t, err)
+	assert.True(t, searchDocumentDeleted)
+	// created_at, action, resource_type, project, domain, name, version, principal, reason
+	assert.Len(t, auditArgs, 9)
+	assert.Equal(t, "DELETE", auditArgs[1].Value)
+	assert.Equal(t, "admin", auditArgs[7].Value)
+	assert.Equal(t, "leaked secret", auditArgs[8].Value)
+}
@@ -43,7 +43,7 @@ func (r *WorkflowRepo) Create(_ context.Context, input models.Workflow, descript
 func (r *WorkflowRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Workflow, error) {
 	var workflow models.Workflow
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Workflow{
+	tx := r.db.WithContext(ctx).Where(&models.Workflow{
 		WorkflowKey: models.WorkflowKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -73,16 +73,17 @@ func (r *WorkflowRepo) List(
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 	var workflows []models.Workflow
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Limit(input.Limit)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = applyPagination(tx, workflowTableName, input)
+	if err != nil {
+		return interfaces.WorkflowCollectionOutput{}, err
 	}
 	timer := r.metrics.ListDuration.Start()
 	tx.Find(&workflows)
@@ -103,7 +104,7 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -130,6 +131,24 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 	}, nil
 }
 
+func (r *WorkflowRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	timer := r.metrics.DeleteDuration.Start()
+	err := deleteEntityVersion(r.db, workflowTableName, core.ResourceType_WORKFLOW, input, auditEntry)
+	timer.Stop()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return flyteAdminDbErrors.GetMissingEntityError(core.ResourceType_WORKFLOW.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 // Returns an instance of WorkflowRepoInterface
 func NewWorkflowRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.WorkflowRepoInterface {
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=BackfillRepoInterface -output=../mocks -case=underscore
+
+type BackfillRepoInterface interface {
+	// Inserts a backfill model into the database store.
+	Create(ctx context.Context, input models.Backfill) error
+	// Returns a matching backfill if it exists.
+	Get(ctx context.Context, input models.BackfillKey) (models.Backfill, error)
+	// Returns the running backfills, oldest first. A limit must be provided for the results page size.
+	ListRunning(ctx context.Context, limit int) ([]models.Backfill, error)
+	// Updates the number of executions launched, the state and the message of a running backfill, unless more of its
+	// executions were already recorded as launched. Returns whether the backfill was updated, which it isn't once it
+	// stopped running, e.g. when it was cancelled.
+	Update(ctx context.Context, input models.Backfill) (bool, error)
+}
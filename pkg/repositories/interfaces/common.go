@@ -14,8 +14,10 @@ type Identifier struct {
 
 // Parameters for querying multiple resources.
 type ListResourceInput struct {
-	Limit         int
-	Offset        int
+	Limit  int
+	Offset int
+	// When set, the rows after the cursor in the sort order are listed instead of the rows after Offset.
+	Cursor        *common.PageCursor
 	InlineFilters []common.InlineFilter
 	// MapFilters refers to primary entity filters defined as map values rather than inline sql queries.
 	// These exist to permit filtering on "IS NULL" which isn't permitted with inline filter queries and
@@ -11,4 +11,14 @@ import (
 type ExecutionEventRepoInterface interface {
 	// Inserts a workflow execution event into the database store.
 	Create(ctx context.Context, input models.ExecutionEvent) error
+	// Inserts a batch of workflow execution events into the database store in a single statement. Events which were already
+	// recorded are skipped.
+	BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error
+	// Returns workflow execution events matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) (ExecutionEventCollectionOutput, error)
+}
+
+// Response format for a query on workflow execution events.
+type ExecutionEventCollectionOutput struct {
+	ExecutionEvents []models.ExecutionEvent
 }
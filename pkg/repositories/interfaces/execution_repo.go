@@ -3,6 +3,9 @@ package interfaces
 import (
 	"context"
 
+	"github.com/flyteorg/flytestdlib/storage"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 )
 
@@ -18,9 +21,101 @@ type ExecutionRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) (ExecutionCollectionOutput, error)
 	// Returns count of executions matching query parameters.
 	Count(ctx context.Context, input CountResourceInput) (int64, error)
+	// Returns the counts and duration percentiles of executions matching query parameters, grouped as requested.
+	Aggregate(ctx context.Context, input ExecutionAggregationInput) (ExecutionAggregationOutput, error)
+	// Deletes executions along with the node executions, task executions, events, signals and tags recorded for them.
+	Delete(ctx context.Context, input ExecutionDeleteInput) (ExecutionDeleteOutput, error)
+	// Returns executions along with the node executions, task executions and events recorded for them.
+	GetRecords(ctx context.Context, executions []models.ExecutionKey) (ExecutionRecords, error)
+	// Creates executions along with their node executions, task executions and events in a single transaction.
+	CreateRecords(ctx context.Context, records ExecutionRecords) error
 }
 
 // Response format for a query on workflows.
 type ExecutionCollectionOutput struct {
 	Executions []models.Execution
 }
+
+// Dimensions executions can be grouped by when aggregated.
+type ExecutionGroupBy string
+
+const (
+	ExecutionGroupByPhase      ExecutionGroupBy = "phase"
+	ExecutionGroupByLaunchPlan ExecutionGroupBy = "launch_plan"
+	ExecutionGroupByWorkflow   ExecutionGroupBy = "workflow"
+	ExecutionGroupByUser       ExecutionGroupBy = "user"
+	ExecutionGroupByCluster    ExecutionGroupBy = "cluster"
+	ExecutionGroupByErrorKind  ExecutionGroupBy = "error_kind"
+	// Groups executions by when they were created, truncated to the TimeBucket.
+	ExecutionGroupByTime ExecutionGroupBy = "time"
+)
+
+// Granularity of the time groups of executions.
+type ExecutionTimeBucket string
+
+const (
+	ExecutionTimeBucketHour ExecutionTimeBucket = "hour"
+	ExecutionTimeBucketDay  ExecutionTimeBucket = "day"
+	// Weeks start on Mondays.
+	ExecutionTimeBucketWeek ExecutionTimeBucket = "week"
+)
+
+type ExecutionAggregationInput struct {
+	InlineFilters []common.InlineFilter
+	MapFilters    []common.MapFilter
+	// A set of the entities (besides executions) that should be joined with when performing the query. Those of
+	// launch plans and workflows are joined with regardless when grouping by them.
+	JoinTableEntities map[common.Entity]bool
+	// Dimensions to group by, all executions are aggregated into a single group when empty.
+	GroupBy    []ExecutionGroupBy
+	TimeBucket ExecutionTimeBucket
+	// The maximum number of groups to return.
+	Limit int
+}
+
+// ExecutionAggregate is the aggregation of a group of executions. Only the fields of the dimensions grouped by are set,
+// names are those of launch plans and workflows and times are formatted as RFC3339 in UTC.
+type ExecutionAggregate struct {
+	GroupPhase      string
+	GroupLaunchPlan string
+	GroupWorkflow   string
+	GroupUser       string
+	GroupCluster    string
+	GroupErrorKind  string
+	GroupTime       string
+	Count           int64 `gorm:"column:execution_count"`
+	// Percentiles of the durations, in nanoseconds, of the executions which completed. Unset when none did, or when
+	// the database doesn't support computing them.
+	DurationP50 *float64
+	DurationP90 *float64
+	DurationP99 *float64
+}
+
+type ExecutionAggregationOutput struct {
+	Aggregates []ExecutionAggregate
+}
+
+type ExecutionDeleteInput struct {
+	Executions []models.ExecutionKey
+	// Only counts the rows which would be deleted when set.
+	DryRun bool
+}
+
+type ExecutionDeleteOutput struct {
+	// The number of rows deleted, or which would be deleted, from each table.
+	RowsAffected map[string]int64
+	// The inputs and dynamic workflow closures recorded for the executions, node executions and task executions.
+	DataReferences []storage.DataReference
+}
+
+// ExecutionRecords are the rows recorded for a set of executions, ordered by id.
+type ExecutionRecords struct {
+	Executions     []models.Execution
+	NodeExecutions []models.NodeExecution
+	// The node ids of the parents of child node executions. Node executions are created before their children when
+	// creating records, with ParentID set from these rather than the ids of the rows they were read from.
+	NodeExecutionParents map[models.NodeExecutionKey]string
+	TaskExecutions       []models.TaskExecution
+	ExecutionEvents      []models.ExecutionEvent
+	NodeExecutionEvents  []models.NodeExecutionEvent
+}
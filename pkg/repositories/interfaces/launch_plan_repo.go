@@ -23,6 +23,9 @@ type LaunchPlanRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
 	// Returns a list of identifiers for launch plans.  A limit must be provided for the results page size.
 	ListLaunchPlanIdentifiers(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
+	// Deletes a launch plan version, along with its search document should it be the latest version, and records the audit entry
+	// in the same transaction.
+	Delete(ctx context.Context, input Identifier, auditEntry models.AuditEntry) error
 }
 
 type SetStateInput struct {
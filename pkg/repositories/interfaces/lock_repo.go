@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+)
+
+//go:generate mockery -name=LockRepoInterface -output=../mocks -case=underscore
+
+// LockRepoInterface runs the background work of which a single admin instance must run at a time.
+type LockRepoInterface interface {
+	// Runs fn while holding the named lock, unless another admin instance holds it, in which case false is returned.
+	RunExclusively(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
@@ -11,4 +11,9 @@ import (
 type NodeExecutionEventRepoInterface interface {
 	// Inserts a node execution event into the database store.
 	Create(ctx context.Context, input models.NodeExecutionEvent) error
+	// Inserts a batch of node execution events into the database store in a single statement. Events which were already
+	// recorded are skipped.
+	BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error
+	// Returns node execution events matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) (NodeExecutionEventCollectionOutput, error)
 }
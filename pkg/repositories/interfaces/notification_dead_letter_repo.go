@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationDeadLetterRepoInterface -output=../mocks -case=underscore
+
+type NotificationDeadLetterRepoInterface interface {
+	// Records a notification which couldn't be processed.
+	Create(ctx context.Context, input models.NotificationDeadLetter) error
+	// Returns a dead-lettered notification.
+	Get(ctx context.Context, id uint) (models.NotificationDeadLetter, error)
+	// Returns the dead-lettered notifications which weren't replayed yet, oldest first. A limit must be provided for the
+	// results page size.
+	ListPending(ctx context.Context, limit int) ([]models.NotificationDeadLetter, error)
+	// Marks a dead-lettered notification as replayed.
+	MarkReplayed(ctx context.Context, id uint) error
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=OutboxEventRepoInterface -output=../mocks -case=underscore
+
+type OutboxEventRepoInterface interface {
+	// Runs fn unless it's already running elsewhere, in which case false is returned. Used to ensure a single relay
+	// publishes outbox events at a time.
+	RunExclusively(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+	// Returns the oldest unpublished outbox events, in the order they were recorded, which were attempted fewer than
+	// maxAttempts times. A limit must be provided for the results page size.
+	ListPending(ctx context.Context, limit, maxAttempts int) ([]models.OutboxEvent, error)
+	// Marks outbox events as published.
+	MarkPublished(ctx context.Context, ids []uint) error
+	// Records a failed attempt to publish an outbox event.
+	MarkFailed(ctx context.Context, id uint, lastError string) error
+	// Deletes the outbox events published before the given time.
+	DeletePublished(ctx context.Context, publishedBefore time.Time) error
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+)
+
+//go:generate mockery -name=PartitionRepoInterface -output=../mocks -case=underscore
+
+type PartitionMaintenanceInput struct {
+	// How many months ahead of the current one partitions are created for.
+	FutureMonths int
+	// When set, the partitions which would be created and detached are returned without changing anything.
+	DryRun bool
+}
+
+type PartitionMaintenanceOutput struct {
+	// The names of the partitions created.
+	Created []string
+	// The names of the partitions detached and dropped.
+	Detached []string
+}
+
+type PartitionRepoInterface interface {
+	// Creates the monthly partitions of the partitioned execution tables for the months to come, and detaches and drops
+	// those of past months left without rows or past the max age. Tables which aren't partitioned are left as they are.
+	Maintain(ctx context.Context, input PartitionMaintenanceInput) (PartitionMaintenanceOutput, error)
+}
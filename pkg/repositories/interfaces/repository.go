@@ -23,7 +23,14 @@ type Repository interface {
 	DescriptionEntityRepo() DescriptionEntityRepoInterface
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface
 	SignalRepo() SignalRepoInterface
+	OutboxEventRepo() OutboxEventRepoInterface
+	NotificationDeadLetterRepo() NotificationDeadLetterRepoInterface
+	SearchRepo() SearchRepoInterface
+	PartitionRepo() PartitionRepoInterface
+	BackfillRepo() BackfillRepoInterface
+	LockRepo() LockRepoInterface
 
 	GetGormDB() *gorm.DB
 }
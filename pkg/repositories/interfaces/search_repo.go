@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=SearchRepoInterface -output=../mocks -case=underscore
+
+// SearchInput selects the search documents matching all of the search terms.
+type SearchInput struct {
+	// Lowercase alphanumeric terms, each of which must prefix a word of the document.
+	Terms []string
+	// Optional, restricts the results to documents of these resource types.
+	ResourceTypes []core.ResourceType
+	// Optional, restricts the results to documents of the project and domain.
+	Project string
+	Domain  string
+	Limit   int
+	Offset  int
+}
+
+type SearchRepoInterface interface {
+	// Returns the documents matching the search, best matches first, with their SearchRank set.
+	Search(ctx context.Context, input SearchInput) ([]models.SearchDocument, error)
+}
@@ -17,6 +17,9 @@ type TaskRepoInterface interface {
 	// Returns tasks with only the project, name, and domain filled in.
 	// A limit must be provided.
 	ListTaskIdentifiers(ctx context.Context, input ListResourceInput) (TaskCollectionOutput, error)
+	// Deletes a task version, along with its search document should it be the latest version, and records the audit entry
+	// in the same transaction.
+	Delete(ctx context.Context, input Identifier, auditEntry models.AuditEntry) error
 }
 
 // Response format for a query on tasks.
@@ -15,6 +15,9 @@ type WorkflowRepoInterface interface {
 	// Returns workflow revisions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
 	ListIdentifiers(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
+	// Deletes a workflow version, along with its search document should it be the latest version, and records the audit entry
+	// in the same transaction.
+	Delete(ctx context.Context, input Identifier, auditEntry models.AuditEntry) error
 }
 
 // Response format for a query on workflows.
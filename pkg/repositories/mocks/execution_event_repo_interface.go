@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	interfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -15,6 +16,38 @@ type ExecutionEventRepoInterface struct {
 	mock.Mock
 }
 
+type ExecutionEventRepoInterface_BatchCreate struct {
+	*mock.Call
+}
+
+func (_m ExecutionEventRepoInterface_BatchCreate) Return(_a0 error) *ExecutionEventRepoInterface_BatchCreate {
+	return &ExecutionEventRepoInterface_BatchCreate{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutionEventRepoInterface) OnBatchCreate(ctx context.Context, inputs []models.ExecutionEvent) *ExecutionEventRepoInterface_BatchCreate {
+	c_call := _m.On("BatchCreate", ctx, inputs)
+	return &ExecutionEventRepoInterface_BatchCreate{Call: c_call}
+}
+
+func (_m *ExecutionEventRepoInterface) OnBatchCreateMatch(matchers ...interface{}) *ExecutionEventRepoInterface_BatchCreate {
+	c_call := _m.On("BatchCreate", matchers...)
+	return &ExecutionEventRepoInterface_BatchCreate{Call: c_call}
+}
+
+// BatchCreate provides a mock function with given fields: ctx, inputs
+func (_m *ExecutionEventRepoInterface) BatchCreate(ctx context.Context, inputs []models.ExecutionEvent) error {
+	ret := _m.Called(ctx, inputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ExecutionEvent) error); ok {
+		r0 = rf(ctx, inputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type ExecutionEventRepoInterface_Create struct {
 	*mock.Call
 }
@@ -46,3 +79,42 @@ func (_m *ExecutionEventRepoInterface) Create(ctx context.Context, input models.
 
 	return r0
 }
+
+type ExecutionEventRepoInterface_List struct {
+	*mock.Call
+}
+
+func (_m ExecutionEventRepoInterface_List) Return(_a0 interfaces.ExecutionEventCollectionOutput, _a1 error) *ExecutionEventRepoInterface_List {
+	return &ExecutionEventRepoInterface_List{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *ExecutionEventRepoInterface) OnList(ctx context.Context, input interfaces.ListResourceInput) *ExecutionEventRepoInterface_List {
+	c_call := _m.On("List", ctx, input)
+	return &ExecutionEventRepoInterface_List{Call: c_call}
+}
+
+func (_m *ExecutionEventRepoInterface) OnListMatch(matchers ...interface{}) *ExecutionEventRepoInterface_List {
+	c_call := _m.On("List", matchers...)
+	return &ExecutionEventRepoInterface_List{Call: c_call}
+}
+
+// List provides a mock function with given fields: ctx, input
+func (_m *ExecutionEventRepoInterface) List(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionEventCollectionOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 interfaces.ExecutionEventCollectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.ListResourceInput) interfaces.ExecutionEventCollectionOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(interfaces.ExecutionEventCollectionOutput)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.ListResourceInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
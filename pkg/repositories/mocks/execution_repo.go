@@ -13,6 +13,13 @@ type GetExecutionFunc func(ctx context.Context, input interfaces.Identifier) (mo
 type ListExecutionFunc func(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.ExecutionCollectionOutput, error)
 type CountExecutionFunc func(ctx context.Context, input interfaces.CountResourceInput) (int64, error)
+type AggregateExecutionFunc func(ctx context.Context, input interfaces.ExecutionAggregationInput) (
+	interfaces.ExecutionAggregationOutput, error)
+type DeleteExecutionFunc func(ctx context.Context, input interfaces.ExecutionDeleteInput) (
+	interfaces.ExecutionDeleteOutput, error)
+type GetExecutionRecordsFunc func(ctx context.Context, executions []models.ExecutionKey) (
+	interfaces.ExecutionRecords, error)
+type CreateExecutionRecordsFunc func(ctx context.Context, records interfaces.ExecutionRecords) error
 
 type MockExecutionRepo struct {
 	createFunction CreateExecutionFunc
@@ -20,6 +27,10 @@ type MockExecutionRepo struct {
 	getFunction    GetExecutionFunc
 	listFunction   ListExecutionFunc
 	countFunction  CountExecutionFunc
+	aggregateFunc  AggregateExecutionFunc
+	deleteFunction DeleteExecutionFunc
+	getRecordsFunc GetExecutionRecordsFunc
+	createRecords  CreateExecutionRecordsFunc
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution) error {
@@ -78,6 +89,53 @@ func (r *MockExecutionRepo) SetCountCallback(countFunction CountExecutionFunc) {
 	r.countFunction = countFunction
 }
 
+func (r *MockExecutionRepo) Aggregate(ctx context.Context, input interfaces.ExecutionAggregationInput) (
+	interfaces.ExecutionAggregationOutput, error) {
+	if r.aggregateFunc != nil {
+		return r.aggregateFunc(ctx, input)
+	}
+	return interfaces.ExecutionAggregationOutput{}, nil
+}
+
+func (r *MockExecutionRepo) SetAggregateCallback(aggregateFunc AggregateExecutionFunc) {
+	r.aggregateFunc = aggregateFunc
+}
+
+func (r *MockExecutionRepo) Delete(ctx context.Context, input interfaces.ExecutionDeleteInput) (
+	interfaces.ExecutionDeleteOutput, error) {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(ctx, input)
+	}
+	return interfaces.ExecutionDeleteOutput{}, nil
+}
+
+func (r *MockExecutionRepo) SetDeleteCallback(deleteFunction DeleteExecutionFunc) {
+	r.deleteFunction = deleteFunction
+}
+
+func (r *MockExecutionRepo) GetRecords(ctx context.Context, executions []models.ExecutionKey) (
+	interfaces.ExecutionRecords, error) {
+	if r.getRecordsFunc != nil {
+		return r.getRecordsFunc(ctx, executions)
+	}
+	return interfaces.ExecutionRecords{}, nil
+}
+
+func (r *MockExecutionRepo) SetGetRecordsCallback(getRecordsFunc GetExecutionRecordsFunc) {
+	r.getRecordsFunc = getRecordsFunc
+}
+
+func (r *MockExecutionRepo) CreateRecords(ctx context.Context, records interfaces.ExecutionRecords) error {
+	if r.createRecords != nil {
+		return r.createRecords(ctx, records)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) SetCreateRecordsCallback(createRecords CreateExecutionRecordsFunc) {
+	r.createRecords = createRecords
+}
+
 func NewMockExecutionRepo() interfaces.ExecutionRepoInterface {
 	return &MockExecutionRepo{}
 }
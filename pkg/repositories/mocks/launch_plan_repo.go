@@ -11,6 +11,7 @@ import (
 type CreateLaunchPlanFunc func(input models.LaunchPlan) error
 type UpdateLaunchPlanFunc func(input models.LaunchPlan) error
 type SetActiveLaunchPlanFunc func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error
+type DeleteLaunchPlanFunc func(input interfaces.Identifier, auditEntry models.AuditEntry) error
 type GetLaunchPlanFunc func(input interfaces.Identifier) (models.LaunchPlan, error)
 type ListLaunchPlanFunc func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error)
 type ListLaunchPlanIdentifiersFunc func(input interfaces.ListResourceInput) (
@@ -23,6 +24,7 @@ type MockLaunchPlanRepo struct {
 	getFunction       GetLaunchPlanFunc
 	listFunction      ListLaunchPlanFunc
 	listIdsFunction   ListLaunchPlanIdentifiersFunc
+	deleteFunction    DeleteLaunchPlanFunc
 }
 
 func (r *MockLaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
@@ -102,6 +104,17 @@ func (r *MockLaunchPlanRepo) SetListLaunchPlanIdentifiersCallback(fn ListLaunchP
 	r.listIdsFunction = fn
 }
 
+func (r *MockLaunchPlanRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input, auditEntry)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanRepo) SetDeleteCallback(deleteFunction DeleteLaunchPlanFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockLaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return &MockLaunchPlanRepo{}
 }
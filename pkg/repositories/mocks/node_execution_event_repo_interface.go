@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	interfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -15,6 +16,38 @@ type NodeExecutionEventRepoInterface struct {
 	mock.Mock
 }
 
+type NodeExecutionEventRepoInterface_BatchCreate struct {
+	*mock.Call
+}
+
+func (_m NodeExecutionEventRepoInterface_BatchCreate) Return(_a0 error) *NodeExecutionEventRepoInterface_BatchCreate {
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) *NodeExecutionEventRepoInterface_BatchCreate {
+	c_call := _m.On("BatchCreate", ctx, inputs)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c_call}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreateMatch(matchers ...interface{}) *NodeExecutionEventRepoInterface_BatchCreate {
+	c_call := _m.On("BatchCreate", matchers...)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c_call}
+}
+
+// BatchCreate provides a mock function with given fields: ctx, inputs
+func (_m *NodeExecutionEventRepoInterface) BatchCreate(ctx context.Context, inputs []models.NodeExecutionEvent) error {
+	ret := _m.Called(ctx, inputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.NodeExecutionEvent) error); ok {
+		r0 = rf(ctx, inputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NodeExecutionEventRepoInterface_Create struct {
 	*mock.Call
 }
@@ -46,3 +79,42 @@ func (_m *NodeExecutionEventRepoInterface) Create(ctx context.Context, input mod
 
 	return r0
 }
+
+type NodeExecutionEventRepoInterface_List struct {
+	*mock.Call
+}
+
+func (_m NodeExecutionEventRepoInterface_List) Return(_a0 interfaces.NodeExecutionEventCollectionOutput, _a1 error) *NodeExecutionEventRepoInterface_List {
+	return &NodeExecutionEventRepoInterface_List{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnList(ctx context.Context, input interfaces.ListResourceInput) *NodeExecutionEventRepoInterface_List {
+	c_call := _m.On("List", ctx, input)
+	return &NodeExecutionEventRepoInterface_List{Call: c_call}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnListMatch(matchers ...interface{}) *NodeExecutionEventRepoInterface_List {
+	c_call := _m.On("List", matchers...)
+	return &NodeExecutionEventRepoInterface_List{Call: c_call}
+}
+
+// List provides a mock function with given fields: ctx, input
+func (_m *NodeExecutionEventRepoInterface) List(ctx context.Context, input interfaces.ListResourceInput) (interfaces.NodeExecutionEventCollectionOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 interfaces.NodeExecutionEventCollectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.ListResourceInput) interfaces.NodeExecutionEventCollectionOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(interfaces.NodeExecutionEventCollectionOutput)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.ListResourceInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
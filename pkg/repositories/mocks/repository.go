@@ -8,21 +8,28 @@ import (
 )
 
 type MockRepository struct {
-	taskRepo                      interfaces.TaskRepoInterface
-	workflowRepo                  interfaces.WorkflowRepoInterface
-	launchPlanRepo                interfaces.LaunchPlanRepoInterface
-	executionRepo                 interfaces.ExecutionRepoInterface
-	ExecutionEventRepoIface       interfaces.ExecutionEventRepoInterface
-	nodeExecutionRepo             interfaces.NodeExecutionRepoInterface
-	NodeExecutionEventRepoIface   interfaces.NodeExecutionEventRepoInterface
-	ProjectRepoIface              interfaces.ProjectRepoInterface
-	resourceRepo                  interfaces.ResourceRepoInterface
-	taskExecutionRepo             interfaces.TaskExecutionRepoInterface
-	namedEntityRepo               interfaces.NamedEntityRepoInterface
-	descriptionEntityRepo         interfaces.DescriptionEntityRepoInterface
-	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
-	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
-	signalRepo                    interfaces.SignalRepoInterface
+	taskRepo                        interfaces.TaskRepoInterface
+	workflowRepo                    interfaces.WorkflowRepoInterface
+	launchPlanRepo                  interfaces.LaunchPlanRepoInterface
+	executionRepo                   interfaces.ExecutionRepoInterface
+	ExecutionEventRepoIface         interfaces.ExecutionEventRepoInterface
+	nodeExecutionRepo               interfaces.NodeExecutionRepoInterface
+	NodeExecutionEventRepoIface     interfaces.NodeExecutionEventRepoInterface
+	ProjectRepoIface                interfaces.ProjectRepoInterface
+	resourceRepo                    interfaces.ResourceRepoInterface
+	taskExecutionRepo               interfaces.TaskExecutionRepoInterface
+	namedEntityRepo                 interfaces.NamedEntityRepoInterface
+	descriptionEntityRepo           interfaces.DescriptionEntityRepoInterface
+	schedulableEntityRepo           sIface.SchedulableEntityRepoInterface
+	schedulableEntitySnapshotRepo   sIface.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo              sIface.SchedulerLeaseRepoInterface
+	signalRepo                      interfaces.SignalRepoInterface
+	OutboxEventRepoIface            interfaces.OutboxEventRepoInterface
+	NotificationDeadLetterRepoIface interfaces.NotificationDeadLetterRepoInterface
+	SearchRepoIface                 interfaces.SearchRepoInterface
+	PartitionRepoIface              interfaces.PartitionRepoInterface
+	BackfillRepoIface               interfaces.BackfillRepoInterface
+	LockRepoIface                   interfaces.LockRepoInterface
 }
 
 func (r *MockRepository) GetGormDB() *gorm.DB {
@@ -37,6 +44,10 @@ func (r *MockRepository) ScheduleEntitiesSnapshotRepo() sIface.ScheduleEntitiesS
 	return r.schedulableEntitySnapshotRepo
 }
 
+func (r *MockRepository) SchedulerLeaseRepo() sIface.SchedulerLeaseRepoInterface {
+	return r.schedulerLeaseRepo
+}
+
 func (r *MockRepository) TaskRepo() interfaces.TaskRepoInterface {
 	return r.taskRepo
 }
@@ -89,22 +100,53 @@ func (r *MockRepository) SignalRepo() interfaces.SignalRepoInterface {
 	return r.signalRepo
 }
 
+func (r *MockRepository) OutboxEventRepo() interfaces.OutboxEventRepoInterface {
+	return r.OutboxEventRepoIface
+}
+
+func (r *MockRepository) NotificationDeadLetterRepo() interfaces.NotificationDeadLetterRepoInterface {
+	return r.NotificationDeadLetterRepoIface
+}
+
+func (r *MockRepository) SearchRepo() interfaces.SearchRepoInterface {
+	return r.SearchRepoIface
+}
+
+func (r *MockRepository) PartitionRepo() interfaces.PartitionRepoInterface {
+	return r.PartitionRepoIface
+}
+
+func (r *MockRepository) BackfillRepo() interfaces.BackfillRepoInterface {
+	return r.BackfillRepoIface
+}
+
+func (r *MockRepository) LockRepo() interfaces.LockRepoInterface {
+	return r.LockRepoIface
+}
+
 func NewMockRepository() interfaces.Repository {
 	return &MockRepository{
-		taskRepo:                      NewMockTaskRepo(),
-		workflowRepo:                  NewMockWorkflowRepo(),
-		launchPlanRepo:                NewMockLaunchPlanRepo(),
-		executionRepo:                 NewMockExecutionRepo(),
-		nodeExecutionRepo:             NewMockNodeExecutionRepo(),
-		ProjectRepoIface:              NewMockProjectRepo(),
-		resourceRepo:                  NewMockResourceRepo(),
-		taskExecutionRepo:             NewMockTaskExecutionRepo(),
-		namedEntityRepo:               NewMockNamedEntityRepo(),
-		descriptionEntityRepo:         NewMockDescriptionEntityRepo(),
-		ExecutionEventRepoIface:       &ExecutionEventRepoInterface{},
-		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
-		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
-		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
-		signalRepo:                    &SignalRepoInterface{},
+		taskRepo:                        NewMockTaskRepo(),
+		workflowRepo:                    NewMockWorkflowRepo(),
+		launchPlanRepo:                  NewMockLaunchPlanRepo(),
+		executionRepo:                   NewMockExecutionRepo(),
+		nodeExecutionRepo:               NewMockNodeExecutionRepo(),
+		ProjectRepoIface:                NewMockProjectRepo(),
+		resourceRepo:                    NewMockResourceRepo(),
+		taskExecutionRepo:               NewMockTaskExecutionRepo(),
+		namedEntityRepo:                 NewMockNamedEntityRepo(),
+		descriptionEntityRepo:           NewMockDescriptionEntityRepo(),
+		ExecutionEventRepoIface:         &ExecutionEventRepoInterface{},
+		NodeExecutionEventRepoIface:     &NodeExecutionEventRepoInterface{},
+		schedulableEntityRepo:           &sMocks.SchedulableEntityRepoInterface{},
+		schedulableEntitySnapshotRepo:   &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
+		schedulerLeaseRepo:              &sMocks.SchedulerLeaseRepoInterface{},
+		signalRepo:                      &SignalRepoInterface{},
+		OutboxEventRepoIface:            &OutboxEventRepoInterface{},
+		NotificationDeadLetterRepoIface: &NotificationDeadLetterRepoInterface{},
+		SearchRepoIface:                 &SearchRepoInterface{},
+		PartitionRepoIface:              &PartitionRepoInterface{},
+		BackfillRepoIface:               &BackfillRepoInterface{},
+		LockRepoIface:                   &LockRepoInterface{},
 	}
 }
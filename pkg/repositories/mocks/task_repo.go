@@ -9,6 +9,7 @@ import (
 )
 
 type CreateTaskFunc func(input models.Task, descriptionEntity *models.DescriptionEntity) error
+type DeleteTaskFunc func(input interfaces.Identifier, auditEntry models.AuditEntry) error
 type GetTaskFunc func(input interfaces.Identifier) (models.Task, error)
 type ListTaskFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
 type ListTaskIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
@@ -18,6 +19,7 @@ type MockTaskRepo struct {
 	getFunction               GetTaskFunc
 	listFunction              ListTaskFunc
 	listUniqueTaskIdsFunction ListTaskIdentifiersFunc
+	deleteFunction            DeleteTaskFunc
 }
 
 func (r *MockTaskRepo) Create(ctx context.Context, input models.Task, descriptionEntity *models.DescriptionEntity) error {
@@ -74,6 +76,17 @@ func (r *MockTaskRepo) SetListTaskIdentifiersCallback(listFunction ListTaskIdent
 	r.listUniqueTaskIdsFunction = listFunction
 }
 
+func (r *MockTaskRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input, auditEntry)
+	}
+	return nil
+}
+
+func (r *MockTaskRepo) SetDeleteCallback(deleteFunction DeleteTaskFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockTaskRepo() interfaces.TaskRepoInterface {
 	return &MockTaskRepo{}
 }
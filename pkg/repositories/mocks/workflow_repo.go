@@ -9,6 +9,7 @@ import (
 )
 
 type CreateWorkflowFunc func(input models.Workflow, descriptionEntity *models.DescriptionEntity) error
+type DeleteWorkflowFunc func(input interfaces.Identifier, auditEntry models.AuditEntry) error
 type GetWorkflowFunc func(input interfaces.Identifier) (models.Workflow, error)
 type ListWorkflowFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
 type ListIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
@@ -18,6 +19,7 @@ type MockWorkflowRepo struct {
 	getFunction         GetWorkflowFunc
 	listFunction        ListWorkflowFunc
 	listIdentifiersFunc ListIdentifiersFunc
+	deleteFunction      DeleteWorkflowFunc
 }
 
 func (r *MockWorkflowRepo) Create(ctx context.Context, input models.Workflow, descriptionEntity *models.DescriptionEntity) error {
@@ -75,6 +77,17 @@ func (r *MockWorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces
 	return interfaces.WorkflowCollectionOutput{}, nil
 }
 
+func (r *MockWorkflowRepo) Delete(ctx context.Context, input interfaces.Identifier, auditEntry models.AuditEntry) error {
+	if r.deleteFunction != nil {
+		return r.deleteFunction(input, auditEntry)
+	}
+	return nil
+}
+
+func (r *MockWorkflowRepo) SetDeleteCallback(deleteFunction DeleteWorkflowFunc) {
+	r.deleteFunction = deleteFunction
+}
+
 func NewMockWorkflowRepo() interfaces.WorkflowRepoInterface {
 	return &MockWorkflowRepo{}
 }
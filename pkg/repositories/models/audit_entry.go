@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The action recorded by an audit entry.
+type AuditAction string
+
+const (
+	// AuditActionDelete records the deletion of a registered entity version.
+	AuditActionDelete AuditAction = "DELETE"
+)
+
+// AuditEntry records an administrative action on a registered task, workflow or launch plan version.
+type AuditEntry struct {
+	ID           uint `gorm:"primary_key;autoIncrement"`
+	CreatedAt    time.Time
+	Action       AuditAction       `valid:"length(0|255)"`
+	ResourceType core.ResourceType `gorm:"index:audit_entry_entity_idx"`
+	Project      string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+	Domain       string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+	Name         string            `gorm:"index:audit_entry_entity_idx" valid:"length(0|255)"`
+	Version      string            `valid:"length(0|255)"`
+	// The user, or app, which took the action.
+	Principal string `valid:"length(0|255)"`
+	// Why the action was taken, as given by the principal.
+	Reason string
+}
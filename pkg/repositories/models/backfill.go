@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// The states of a backfill.
+const (
+	BackfillStateRunning   = "RUNNING"
+	BackfillStateSucceeded = "SUCCEEDED"
+	BackfillStateFailed    = "FAILED"
+	BackfillStateCancelled = "CANCELLED"
+)
+
+// Backfill primary key
+type BackfillKey struct {
+	Project string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+	Domain  string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+	Name    string `gorm:"uniqueIndex:idx_backfills_key" valid:"length(0|255)"`
+}
+
+// Backfill launches an execution of a launch plan version for each of the times its schedule is scheduled at over a
+// time range, at most MaxConcurrency of which run at a time.
+type Backfill struct {
+	ID        uint `gorm:"primary_key;autoIncrement"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	BackfillKey
+	LaunchPlanName    string `valid:"length(0|255)"`
+	LaunchPlanVersion string `valid:"length(0|255)"`
+	// The schedule of the launch plan version, as for a SchedulableEntity.
+	CronExpression      string
+	FixedRateValue      uint32
+	Unit                admin.FixedRateUnit
+	KickoffTimeInputArg string
+	StartTime           time.Time
+	EndTime             time.Time
+	MaxConcurrency      uint32
+	// The number of times scheduled over the range, the first Launched of which were launched.
+	ScheduledTimes int
+	Launched       int
+	State          string `gorm:"index" valid:"length(0|255)"`
+	// Why the backfill failed.
+	Message   string
+	Principal string `valid:"length(0|255)"`
+}
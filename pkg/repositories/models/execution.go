@@ -66,6 +66,12 @@ type Execution struct {
 	LaunchEntity string
 	// Tags associated with the execution
 	Tags []AdminTag `gorm:"many2many:execution_admin_tags;"`
+	// Events describing the state change being saved, recorded in the same transaction.
+	OutboxEvents []OutboxEvent `gorm:"-"`
+}
+
+func (e *Execution) AfterSave(tx *gorm.DB) error {
+	return createOutboxEvents(tx, e.OutboxEvents)
 }
 
 type AdminTag struct {
@@ -11,3 +11,5 @@ type ExecutionEvent struct {
 	OccurredAt time.Time
 	Phase      string `gorm:"primary_key"`
 }
+
+var ExecutionEventColumns = modelColumns(ExecutionEvent{})
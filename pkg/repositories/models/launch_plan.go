@@ -1,5 +1,7 @@
 package models
 
+import "gorm.io/gorm"
+
 // Launch plan primary key
 type LaunchPlanKey struct {
 	Project string `gorm:"primary_key;index:lp_project_domain_name_idx,lp_project_domain_idx" valid:"length(0|255)"`
@@ -31,6 +33,12 @@ type LaunchPlan struct {
 	// Hash of the launch plan
 	Digest       []byte
 	ScheduleType LaunchPlanScheduleType
+	// The searchable text of the entity, recorded in the same transaction.
+	SearchDocument *SearchDocument `gorm:"-"`
+}
+
+func (l *LaunchPlan) AfterCreate(tx *gorm.DB) error {
+	return upsertSearchDocument(tx, l.SearchDocument)
 }
 
 var LaunchPlanColumns = modelColumns(LaunchPlan{})
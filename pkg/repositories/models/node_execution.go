@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // IMPORTANT: If you update the model below, be sure to double check model definitions in
@@ -50,6 +52,12 @@ type NodeExecution struct {
 	DynamicWorkflowRemoteClosureReference string
 	// Metadata that is only relevant to the flyteadmin service that is used to parse the model and track additional attributes.
 	InternalData []byte
+	// Events describing the state change being saved, recorded in the same transaction.
+	OutboxEvents []OutboxEvent `gorm:"-"`
+}
+
+func (n *NodeExecution) AfterSave(tx *gorm.DB) error {
+	return createOutboxEvents(tx, n.OutboxEvents)
 }
 
 var NodeExecutionColumns = modelColumns(NodeExecution{})
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// NotificationDeadLetter is a notification which a notifications processor couldn't process.
+type NotificationDeadLetter struct {
+	ID        uint `gorm:"primary_key;autoIncrement"`
+	CreatedAt time.Time
+	// The processor which received the notification, e.g. aws or kafka.
+	Processor string `valid:"length(0|255)"`
+	// The key the notification was published with.
+	NotificationType string `valid:"length(0|255)"`
+	// The serialized admin.EmailMessage, unset when the notification couldn't be decoded.
+	Message []byte
+	// The message as it was received, set when the notification couldn't be decoded.
+	RawMessage []byte
+	// Why the notification couldn't be processed.
+	Reason   string
+	Attempts int
+	// Unset until the notification was replayed.
+	ReplayedAt *time.Time `gorm:"index"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The sinks outbox events are published to.
+const (
+	OutboxSinkExternalEvents = "external_events"
+	OutboxSinkCloudEvents    = "cloud_events"
+)
+
+// OutboxEvent is an event recorded in the same transaction as the execution state change it describes, which is
+// published to its sink by the outbox relay.
+type OutboxEvent struct {
+	ID        uint `gorm:"primary_key;autoIncrement"`
+	CreatedAt time.Time
+	// The execution the event belongs to, events of the same execution are published in the order they were recorded.
+	ExecutionProject string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_outbox_events_execution" valid:"length(0|255)"`
+	Sink             string `gorm:"uniqueIndex:idx_outbox_events_deduplication_key" valid:"length(0|255)"`
+	// Identifies the event regardless of how many times it's recorded or published, e.g. when events are resent.
+	DeduplicationKey string `gorm:"uniqueIndex:idx_outbox_events_deduplication_key" valid:"length(0|255)"`
+	// The proto message name of the payload.
+	EventType string `valid:"length(0|255)"`
+	Payload   []byte
+	Attempts  int
+	LastError string
+	// Unset until the event was published.
+	PublishedAt *time.Time `gorm:"index"`
+}
+
+// Records the outbox events of a state change within the transaction saving it. Events which were already recorded are
+// ignored.
+func createOutboxEvents(tx *gorm.DB, events []OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("id").Create(&events).Error
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Identifies a SearchDocument, a document is kept per named entity.
+type SearchDocumentKey struct {
+	ResourceType core.ResourceType `gorm:"uniqueIndex:search_document_key_idx" valid:"length(0|255)"`
+	Project      string            `gorm:"uniqueIndex:search_document_key_idx;index:search_document_project_domain_idx" valid:"length(0|255)"`
+	Domain       string            `gorm:"uniqueIndex:search_document_key_idx;index:search_document_project_domain_idx" valid:"length(0|255)"`
+	Name         string            `gorm:"uniqueIndex:search_document_key_idx" valid:"length(0|255)"`
+}
+
+// SearchDocument holds the searchable text of the most recently registered version of a task, workflow or launch
+// plan. In postgres it's indexed by the search_vector column added in migrations.
+type SearchDocument struct {
+	ID        uint `gorm:"primary_key;autoIncrement"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	SearchDocumentKey
+	Version string `valid:"length(0|255)"`
+	// Short and long descriptions of the entity.
+	Description string
+	// Space separated label keys and values.
+	Labels string
+	// Space separated names of the interface input and output variables.
+	Variables string
+	// How well the document matches a search, only set for search results.
+	SearchRank float64 `gorm:"->;-:migration"`
+}
+
+var searchDocumentTextColumns = []string{"updated_at", "version", "description", "labels", "variables"}
+
+// Records the search document of a newly registered entity version within the transaction creating it, replacing that
+// of the previous version.
+func upsertSearchDocument(tx *gorm.DB, document *SearchDocument) error {
+	if document == nil {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "resource_type"}, {Name: "project"}, {Name: "domain"}, {Name: "name"},
+		},
+		DoUpdates: clause.AssignmentColumns(searchDocumentTextColumns),
+	}).Omit("id").Create(document).Error
+}
@@ -1,5 +1,7 @@
 package models
 
+import "gorm.io/gorm"
+
 // IMPORTANT: If you update the model below, be sure to double check model definitions in
 // pkg/repositories/config/migration_models.go
 
@@ -22,6 +24,12 @@ type Task struct {
 	Type string `valid:"length(0|255)"`
 	// ShortDescription for the task.
 	ShortDescription string
+	// The searchable text of the entity, recorded in the same transaction.
+	SearchDocument *SearchDocument `gorm:"-"`
+}
+
+func (t *Task) AfterCreate(tx *gorm.DB) error {
+	return upsertSearchDocument(tx, t.SearchDocument)
 }
 
 var TaskColumns = modelColumns(Task{})
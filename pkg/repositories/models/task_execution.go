@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // IMPORTANT: If you update the model below, be sure to double check model definitions in
@@ -36,6 +38,12 @@ type TaskExecution struct {
 	Duration               time.Duration
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID;references:ID"`
+	// Events describing the state change being saved, recorded in the same transaction.
+	OutboxEvents []OutboxEvent `gorm:"-"`
+}
+
+func (t *TaskExecution) AfterSave(tx *gorm.DB) error {
+	return createOutboxEvents(tx, t.OutboxEvents)
 }
 
 var TaskExecutionColumns = modelColumns(TaskExecution{})
@@ -1,5 +1,7 @@
 package models
 
+import "gorm.io/gorm"
+
 // Workflow primary key
 type WorkflowKey struct {
 	Project string `gorm:"primary_key;index:workflow_project_domain_name_idx;index:workflow_project_domain_idx"  valid:"length(0|255)"`
@@ -18,6 +20,12 @@ type Workflow struct {
 	Digest []byte
 	// ShortDescription for the workflow.
 	ShortDescription string
+	// The searchable text of the entity, recorded in the same transaction.
+	SearchDocument *SearchDocument `gorm:"-"`
+}
+
+func (w *Workflow) AfterCreate(tx *gorm.DB) error {
+	return upsertSearchDocument(tx, w.SearchDocument)
 }
 
 var WorkflowColumns = modelColumns(Workflow{})
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/database"
+	"github.com/flyteorg/flytestdlib/logger"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const readReplicaResolverName = "flyteadmin:read_replicas"
+
+// Routes the reads of a database made outside of transactions to its read replicas in turn, much like gorm's
+// dbresolver plugin.
+type readReplicaResolver struct {
+	primary  gorm.ConnPool
+	replicas []gorm.ConnPool
+	next     uint64
+}
+
+func (r *readReplicaResolver) Name() string {
+	return readReplicaResolverName
+}
+
+func (r *readReplicaResolver) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if err := callbacks.Query().Before("gorm:query").Register(readReplicaResolverName, r.routeRead); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register(readReplicaResolverName, r.routeRead); err != nil {
+		return err
+	}
+	// Statements reused after a read are routed back to the primary for writes.
+	if err := callbacks.Create().Before("gorm:create").Register(readReplicaResolverName, r.routeWrite); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register(readReplicaResolverName, r.routeWrite); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register(readReplicaResolverName, r.routeWrite); err != nil {
+		return err
+	}
+	return callbacks.Raw().Before("gorm:raw").Register(readReplicaResolverName, r.routeWrite)
+}
+
+func (r *readReplicaResolver) routeRead(db *gorm.DB) {
+	statement := db.Statement
+	// Transactions and dedicated connections keep to the connection they were started on.
+	if statement.ConnPool != r.primary {
+		return
+	}
+	if primaryReads, ok := statement.Context.Value(common.PrimaryReadsKey).(bool); ok && primaryReads {
+		return
+	}
+	if _, ok := statement.Clauses[clause.Locking{}.Name()]; ok {
+		return
+	}
+	// Raw statements other than selects may write.
+	if sql := strings.TrimSpace(statement.SQL.String()); len(sql) > 0 &&
+		!strings.HasPrefix(strings.ToUpper(sql), "SELECT") {
+		return
+	}
+	next := atomic.AddUint64(&r.next, 1)
+	statement.ConnPool = r.replicas[next%uint64(len(r.replicas))]
+}
+
+func (r *readReplicaResolver) routeWrite(db *gorm.DB) {
+	for _, replica := range r.replicas {
+		if db.Statement.ConnPool == replica {
+			db.Statement.ConnPool = r.primary
+			return
+		}
+	}
+}
+
+// WithReadReplicas routes the reads of the postgres database made outside of transactions to the configured read
+// replicas, if any. Reads whose context sets common.PrimaryReadsKey are still made against the primary.
+func WithReadReplicas(ctx context.Context, gormDb *gorm.DB, dbConfig *database.DbConfig,
+	replicasConfig *runtimeInterfaces.DbReadReplicasConfig) error {
+	if replicasConfig == nil || len(replicasConfig.Postgres) == 0 {
+		return nil
+	}
+	if gormDb.Dialector.Name() != "postgres" {
+		return fmt.Errorf("read replicas are only supported for postgres databases")
+	}
+	resolver := &readReplicaResolver{
+		primary: gormDb.ConnPool,
+	}
+	for _, pgConfig := range replicasConfig.Postgres {
+		replicaDb, err := gorm.Open(postgres.Open(getPostgresDsn(ctx, pgConfig)), &gorm.Config{
+			Logger: gormDb.Logger,
+		})
+		if err != nil {
+			return err
+		}
+		if err = setupDbConnectionPool(ctx, replicaDb, dbConfig); err != nil {
+			return err
+		}
+		resolver.replicas = append(resolver.replicas, replicaDb.ConnPool)
+	}
+	logger.Infof(ctx, "Routing database reads to %d read replicas", len(resolver.replicas))
+	return gormDb.Use(resolver)
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/database"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type replicatedItem struct {
+	Name string
+}
+
+func openReplicatedItemsDb(t *testing.T, name string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), name+".db")), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&replicatedItem{}))
+	assert.NoError(t, db.Create(&replicatedItem{Name: name}).Error)
+	return db
+}
+
+func getReplicatedItemNames(t *testing.T, db *gorm.DB) []string {
+	var names []string
+	assert.NoError(t, db.Model(&replicatedItem{}).Pluck("name", &names).Error)
+	return names
+}
+
+func TestReadReplicaResolver(t *testing.T) {
+	primaryDb := openReplicatedItemsDb(t, "primary")
+	replicaDb := openReplicatedItemsDb(t, "replica")
+	resolver := &readReplicaResolver{
+		primary:  primaryDb.ConnPool,
+		replicas: []gorm.ConnPool{replicaDb.ConnPool},
+	}
+	assert.NoError(t, primaryDb.Use(resolver))
+
+	t.Run("reads", func(t *testing.T) {
+		assert.Equal(t, []string{"replica"}, getReplicatedItemNames(t, primaryDb))
+		var count int64
+		assert.NoError(t, primaryDb.Model(&replicatedItem{}).Where("name = ?", "replica").Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+	t.Run("primary reads", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), common.PrimaryReadsKey, true)
+		assert.Equal(t, []string{"primary"}, getReplicatedItemNames(t, primaryDb.WithContext(ctx)))
+	})
+	t.Run("transactions", func(t *testing.T) {
+		err := primaryDb.Transaction(func(tx *gorm.DB) error {
+			assert.Equal(t, []string{"primary"}, getReplicatedItemNames(t, tx))
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("writes", func(t *testing.T) {
+		assert.NoError(t, primaryDb.Create(&replicatedItem{Name: "written"}).Error)
+		assert.Equal(t, []string{"replica"}, getReplicatedItemNames(t, primaryDb))
+		ctx := context.WithValue(context.Background(), common.PrimaryReadsKey, true)
+		assert.Equal(t, []string{"primary", "written"}, getReplicatedItemNames(t, primaryDb.WithContext(ctx)))
+	})
+	t.Run("writes after reads", func(t *testing.T) {
+		tx := primaryDb.Session(&gorm.Session{})
+		tx.Statement.ConnPool = replicaDb.ConnPool
+		resolver.routeWrite(tx)
+		assert.Equal(t, primaryDb.ConnPool, tx.Statement.ConnPool)
+	})
+	t.Run("raw writes", func(t *testing.T) {
+		tx := primaryDb.Raw("UPDATE replicated_items SET name = ? RETURNING name", "updated")
+		resolver.routeRead(tx)
+		assert.Equal(t, primaryDb.ConnPool, tx.Statement.ConnPool)
+	})
+	t.Run("locking reads", func(t *testing.T) {
+		tx := primaryDb.Clauses(clause.Locking{Strength: "UPDATE"})
+		resolver.routeRead(tx)
+		assert.Equal(t, primaryDb.ConnPool, tx.Statement.ConnPool)
+	})
+}
+
+func TestWithReadReplicas(t *testing.T) {
+	ctx := context.TODO()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "admin.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	t.Run("no replicas", func(t *testing.T) {
+		assert.NoError(t, WithReadReplicas(ctx, db, &database.DbConfig{}, &runtimeInterfaces.DbReadReplicasConfig{}))
+		_, registered := db.Config.Plugins[readReplicaResolverName]
+		assert.False(t, registered)
+	})
+	t.Run("unsupported database", func(t *testing.T) {
+		err := WithReadReplicas(ctx, db, &database.DbConfig{}, &runtimeInterfaces.DbReadReplicasConfig{
+			Postgres: []database.PostgresConfig{{Host: "replica"}},
+		})
+		assert.EqualError(t, err, "read replicas are only supported for postgres databases")
+	})
+}
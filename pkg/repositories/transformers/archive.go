@@ -0,0 +1,183 @@
+package transformers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Returns nil for unset timestamps.
+func fromTimestampProto(ts *timestamp.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid timestamp [%v]: %v", ts, err)
+	}
+	return &t, nil
+}
+
+// FromExecutionProto returns the model of an execution served by FromExecutionModel, such as one restored from an
+// archive. The columns referring to other rows, and those of the inputs which aren't part of the execution, are left
+// unset.
+func FromExecutionProto(execution *admin.Execution) (*models.Execution, error) {
+	spec, err := proto.Marshal(execution.Spec)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal spec: %v", err)
+	}
+	closure, err := proto.Marshal(execution.Closure)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal closure: %v", err)
+	}
+	state := int32(execution.Closure.GetStateChangeDetails().GetState())
+	executionModel := &models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: execution.GetId().GetProject(),
+			Domain:  execution.GetId().GetDomain(),
+			Name:    execution.GetId().GetName(),
+		},
+		Phase:      execution.GetClosure().GetPhase().String(),
+		Spec:       spec,
+		Closure:    closure,
+		AbortCause: execution.GetClosure().GetAbortMetadata().GetCause(),
+		Mode:       int32(execution.GetSpec().GetMetadata().GetMode()),
+		Cluster:    execution.GetSpec().GetMetadata().GetSystemMetadata().GetExecutionCluster(),
+		User:       execution.GetSpec().GetMetadata().GetPrincipal(),
+		State:      &state,
+	}
+	if execution.GetSpec().GetLaunchPlan() != nil {
+		executionModel.LaunchEntity = strings.ToLower(execution.GetSpec().GetLaunchPlan().GetResourceType().String())
+	}
+	if executionModel.StartedAt, err = fromTimestampProto(execution.GetClosure().GetStartedAt()); err != nil {
+		return nil, err
+	}
+	if executionModel.ExecutionCreatedAt, err = fromTimestampProto(execution.GetClosure().GetCreatedAt()); err != nil {
+		return nil, err
+	}
+	if executionModel.ExecutionUpdatedAt, err = fromTimestampProto(execution.GetClosure().GetUpdatedAt()); err != nil {
+		return nil, err
+	}
+	if execution.GetClosure().GetDuration() != nil {
+		if executionModel.Duration, err = ptypes.Duration(execution.GetClosure().GetDuration()); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid duration: %v", err)
+		}
+	}
+	if executionError := execution.GetClosure().GetError(); executionError != nil {
+		kind := executionError.Kind.String()
+		executionModel.ErrorKind = &kind
+		executionModel.ErrorCode = &executionError.Code
+	}
+	return executionModel, nil
+}
+
+// FromNodeExecutionProto returns the model of a node execution served by FromNodeExecutionModel, such as one restored
+// from an archive. The ids of parent node executions are left unset.
+func FromNodeExecutionProto(nodeExecution *admin.NodeExecution) (*models.NodeExecution, error) {
+	closure, err := proto.Marshal(nodeExecution.Closure)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal closure: %v", err)
+	}
+	metadata, err := proto.Marshal(nodeExecution.Metadata)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal nodeExecutionMetadata: %v", err)
+	}
+	nodeExecutionModel := &models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{
+			ExecutionKey: models.ExecutionKey{
+				Project: nodeExecution.GetId().GetExecutionId().GetProject(),
+				Domain:  nodeExecution.GetId().GetExecutionId().GetDomain(),
+				Name:    nodeExecution.GetId().GetExecutionId().GetName(),
+			},
+			NodeID: nodeExecution.GetId().GetNodeId(),
+		},
+		Phase:                 nodeExecution.GetClosure().GetPhase().String(),
+		InputURI:              nodeExecution.InputUri,
+		Closure:               closure,
+		NodeExecutionMetadata: metadata,
+	}
+	if nodeExecutionModel.StartedAt, err = fromTimestampProto(nodeExecution.GetClosure().GetStartedAt()); err != nil {
+		return nil, err
+	}
+	if nodeExecutionModel.NodeExecutionCreatedAt, err = fromTimestampProto(
+		nodeExecution.GetClosure().GetCreatedAt()); err != nil {
+		return nil, err
+	}
+	if nodeExecutionModel.NodeExecutionUpdatedAt, err = fromTimestampProto(
+		nodeExecution.GetClosure().GetUpdatedAt()); err != nil {
+		return nil, err
+	}
+	if nodeExecution.GetClosure().GetDuration() != nil {
+		if nodeExecutionModel.Duration, err = ptypes.Duration(nodeExecution.GetClosure().GetDuration()); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid duration: %v", err)
+		}
+	}
+	if executionError := nodeExecution.GetClosure().GetError(); executionError != nil {
+		kind := executionError.Kind.String()
+		nodeExecutionModel.ErrorKind = &kind
+		nodeExecutionModel.ErrorCode = &executionError.Code
+	}
+	if taskNodeMetadata := nodeExecution.GetClosure().GetTaskNodeMetadata(); taskNodeMetadata != nil {
+		cacheStatus := taskNodeMetadata.CacheStatus.String()
+		nodeExecutionModel.CacheStatus = &cacheStatus
+	}
+	return nodeExecutionModel, nil
+}
+
+// FromTaskExecutionProto returns the model of a task execution served by FromTaskExecutionModel, such as one restored
+// from an archive.
+func FromTaskExecutionProto(taskExecution *admin.TaskExecution) (*models.TaskExecution, error) {
+	closure, err := proto.Marshal(taskExecution.Closure)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal closure: %v", err)
+	}
+	id := taskExecution.GetId()
+	retryAttempt := id.GetRetryAttempt()
+	taskExecutionModel := &models.TaskExecution{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{
+				Project: id.GetTaskId().GetProject(),
+				Domain:  id.GetTaskId().GetDomain(),
+				Name:    id.GetTaskId().GetName(),
+				Version: id.GetTaskId().GetVersion(),
+			},
+			NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: models.ExecutionKey{
+					Project: id.GetNodeExecutionId().GetExecutionId().GetProject(),
+					Domain:  id.GetNodeExecutionId().GetExecutionId().GetDomain(),
+					Name:    id.GetNodeExecutionId().GetExecutionId().GetName(),
+				},
+				NodeID: id.GetNodeExecutionId().GetNodeId(),
+			},
+			RetryAttempt: &retryAttempt,
+		},
+		Phase:    taskExecution.GetClosure().GetPhase().String(),
+		InputURI: taskExecution.InputUri,
+		Closure:  closure,
+	}
+	if taskExecutionModel.StartedAt, err = fromTimestampProto(taskExecution.GetClosure().GetStartedAt()); err != nil {
+		return nil, err
+	}
+	if taskExecutionModel.TaskExecutionCreatedAt, err = fromTimestampProto(
+		taskExecution.GetClosure().GetCreatedAt()); err != nil {
+		return nil, err
+	}
+	if taskExecutionModel.TaskExecutionUpdatedAt, err = fromTimestampProto(
+		taskExecution.GetClosure().GetUpdatedAt()); err != nil {
+		return nil, err
+	}
+	if taskExecution.GetClosure().GetDuration() != nil {
+		if taskExecutionModel.Duration, err = ptypes.Duration(taskExecution.GetClosure().GetDuration()); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid duration: %v", err)
+		}
+	}
+	return taskExecutionModel, nil
+}
@@ -0,0 +1,142 @@
+package transformers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+func TestFromExecutionProto(t *testing.T) {
+	createdAt := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	startedAt := createdAt.Add(time.Minute)
+	updatedAt := createdAt.Add(time.Hour)
+	execution := &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "lp"},
+			Metadata: &admin.ExecutionMetadata{
+				Mode:           admin.ExecutionMetadata_SCHEDULED,
+				Principal:      "principal",
+				SystemMetadata: &admin.SystemMetadata{ExecutionCluster: "cluster"},
+			},
+		},
+		Closure: &admin.ExecutionClosure{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &admin.ExecutionClosure_Error{
+				Error: &core.ExecutionError{Code: "code", Kind: core.ExecutionError_USER},
+			},
+			Duration: ptypes.DurationProto(updatedAt.Sub(startedAt)),
+			StateChangeDetails: &admin.ExecutionStateChangeDetails{
+				State: admin.ExecutionState_EXECUTION_ARCHIVED,
+			},
+		},
+	}
+	var err error
+	execution.Closure.CreatedAt, err = ptypes.TimestampProto(createdAt)
+	require.NoError(t, err)
+	execution.Closure.StartedAt, err = ptypes.TimestampProto(startedAt)
+	require.NoError(t, err)
+	execution.Closure.UpdatedAt, err = ptypes.TimestampProto(updatedAt)
+	require.NoError(t, err)
+
+	executionModel, err := FromExecutionProto(execution)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"}, executionModel.ExecutionKey)
+	assert.Equal(t, "FAILED", executionModel.Phase)
+	assert.Equal(t, int32(admin.ExecutionMetadata_SCHEDULED), executionModel.Mode)
+	assert.Equal(t, "cluster", executionModel.Cluster)
+	assert.Equal(t, "principal", executionModel.User)
+	assert.Equal(t, "launch_plan", executionModel.LaunchEntity)
+	assert.Equal(t, int32(admin.ExecutionState_EXECUTION_ARCHIVED), *executionModel.State)
+	assert.Equal(t, startedAt, *executionModel.StartedAt)
+	assert.Equal(t, createdAt, *executionModel.ExecutionCreatedAt)
+	assert.Equal(t, updatedAt, *executionModel.ExecutionUpdatedAt)
+	assert.Equal(t, time.Hour-time.Minute, executionModel.Duration)
+	assert.Equal(t, "USER", *executionModel.ErrorKind)
+	assert.Equal(t, "code", *executionModel.ErrorCode)
+
+	served, err := FromExecutionModel(context.Background(), *executionModel, &ExecutionTransformerOptions{})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(execution, served))
+}
+
+func TestFromNodeExecutionProto(t *testing.T) {
+	nodeExecution := &admin.NodeExecution{
+		Id: &core.NodeExecutionIdentifier{
+			NodeId:      "n0",
+			ExecutionId: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		},
+		InputUri: "s3://inputs",
+		Closure: &admin.NodeExecutionClosure{
+			Phase:     core.NodeExecution_SUCCEEDED,
+			StartedAt: ptypes.TimestampNow(),
+			Duration:  ptypes.DurationProto(time.Minute),
+			TargetMetadata: &admin.NodeExecutionClosure_TaskNodeMetadata{
+				TaskNodeMetadata: &admin.TaskNodeMetadata{CacheStatus: core.CatalogCacheStatus_CACHE_HIT},
+			},
+		},
+		Metadata: &admin.NodeExecutionMetaData{IsParentNode: true, SpecNodeId: "n0"},
+	}
+
+	nodeExecutionModel, err := FromNodeExecutionProto(nodeExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, "n0", nodeExecutionModel.NodeID)
+	assert.Equal(t, "name", nodeExecutionModel.Name)
+	assert.Equal(t, "SUCCEEDED", nodeExecutionModel.Phase)
+	assert.Equal(t, "s3://inputs", nodeExecutionModel.InputURI)
+	assert.NotNil(t, nodeExecutionModel.StartedAt)
+	assert.Nil(t, nodeExecutionModel.NodeExecutionCreatedAt)
+	assert.Equal(t, time.Minute, nodeExecutionModel.Duration)
+	assert.Equal(t, "CACHE_HIT", *nodeExecutionModel.CacheStatus)
+	assert.Nil(t, nodeExecutionModel.ErrorKind)
+
+	served, err := FromNodeExecutionModel(*nodeExecutionModel, nil)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(nodeExecution, served))
+}
+
+func TestFromTaskExecutionProto(t *testing.T) {
+	taskExecution := &admin.TaskExecution{
+		Id: &core.TaskExecutionIdentifier{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+				Version:      "v1",
+			},
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId:      "n0",
+				ExecutionId: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+			},
+			RetryAttempt: 2,
+		},
+		InputUri: "s3://inputs",
+		Closure: &admin.TaskExecutionClosure{
+			Phase:    core.TaskExecution_FAILED,
+			Duration: ptypes.DurationProto(time.Second),
+		},
+	}
+
+	taskExecutionModel, err := FromTaskExecutionProto(taskExecution)
+	assert.NoError(t, err)
+	assert.Equal(t, models.TaskKey{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+		taskExecutionModel.TaskKey)
+	assert.Equal(t, "n0", taskExecutionModel.NodeID)
+	assert.Equal(t, uint32(2), *taskExecutionModel.RetryAttempt)
+	assert.Equal(t, "FAILED", taskExecutionModel.Phase)
+	assert.Equal(t, time.Second, taskExecutionModel.Duration)
+
+	served, err := FromTaskExecutionModel(*taskExecutionModel, nil)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(taskExecution, served))
+}
@@ -0,0 +1,90 @@
+package transformers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+)
+
+func getDeduplicationKey(id string, phase fmt.Stringer, occurredAt *timestamp.Timestamp) string {
+	return fmt.Sprintf("%s.%s.%d", id, phase, occurredAt.AsTime().UnixNano())
+}
+
+// Transforms a workflow, node or task execution event request to the outbox events publishing it to each of the sinks.
+// Events are keyed by the entity they describe, its phase and when it occurred, so the same event is recorded once per
+// sink no matter how many times it's sent.
+func CreateOutboxEventModels(sinks []string, request proto.Message) ([]models.OutboxEvent, error) {
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	var executionID *core.WorkflowExecutionIdentifier
+	var deduplicationKey string
+	switch r := request.(type) {
+	case *admin.WorkflowExecutionEventRequest:
+		executionID = r.GetEvent().GetExecutionId()
+		deduplicationKey = getDeduplicationKey(fmt.Sprintf("%s/%s/%s", executionID.GetProject(),
+			executionID.GetDomain(), executionID.GetName()), r.GetEvent().GetPhase(), r.GetEvent().GetOccurredAt())
+	case *admin.NodeExecutionEventRequest:
+		nodeExecutionID := r.GetEvent().GetId()
+		executionID = nodeExecutionID.GetExecutionId()
+		deduplicationKey = getDeduplicationKey(fmt.Sprintf("%s/%s/%s/%s", executionID.GetProject(),
+			executionID.GetDomain(), executionID.GetName(), nodeExecutionID.GetNodeId()), r.GetEvent().GetPhase(),
+			r.GetEvent().GetOccurredAt())
+	case *admin.TaskExecutionEventRequest:
+		nodeExecutionID := r.GetEvent().GetParentNodeExecutionId()
+		executionID = nodeExecutionID.GetExecutionId()
+		taskID := r.GetEvent().GetTaskId()
+		deduplicationKey = getDeduplicationKey(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s/%s/%d", executionID.GetProject(),
+			executionID.GetDomain(), executionID.GetName(), nodeExecutionID.GetNodeId(), taskID.GetProject(),
+			taskID.GetDomain(), taskID.GetName(), taskID.GetVersion(), r.GetEvent().GetRetryAttempt()),
+			r.GetEvent().GetPhase(), r.GetEvent().GetOccurredAt())
+	default:
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "unsupported outbox event type [%+v]",
+			reflect.TypeOf(request))
+	}
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal outbox event with error: %v", err)
+	}
+
+	events := make([]models.OutboxEvent, len(sinks))
+	for i, sink := range sinks {
+		events[i] = models.OutboxEvent{
+			ExecutionProject: executionID.GetProject(),
+			ExecutionDomain:  executionID.GetDomain(),
+			ExecutionName:    executionID.GetName(),
+			Sink:             sink,
+			DeduplicationKey: deduplicationKey,
+			EventType:        proto.MessageName(request),
+			Payload:          payload,
+		}
+	}
+	return events, nil
+}
+
+// Transforms an outbox event model back to the execution event request it records.
+func FromOutboxEventModel(event models.OutboxEvent) (proto.Message, error) {
+	var request proto.Message
+	switch event.EventType {
+	case proto.MessageName(&admin.WorkflowExecutionEventRequest{}):
+		request = &admin.WorkflowExecutionEventRequest{}
+	case proto.MessageName(&admin.NodeExecutionEventRequest{}):
+		request = &admin.NodeExecutionEventRequest{}
+	case proto.MessageName(&admin.TaskExecutionEventRequest{}):
+		request = &admin.TaskExecutionEventRequest{}
+	default:
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "unsupported outbox event type [%s]", event.EventType)
+	}
+	if err := proto.Unmarshal(event.Payload, request); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal outbox event [%d] with error: %v",
+			event.ID, err)
+	}
+	return request, nil
+}
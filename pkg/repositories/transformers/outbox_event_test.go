@@ -0,0 +1,99 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var outboxExecutionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestCreateOutboxEventModels(t *testing.T) {
+	occurredAt, _ := ptypes.TimestampProto(time.Unix(100, 5))
+	request := &admin.WorkflowExecutionEventRequest{
+		RequestId: "request",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: outboxExecutionID,
+			Phase:       core.WorkflowExecution_RUNNING,
+			OccurredAt:  occurredAt,
+		},
+	}
+	outboxEvents, err := CreateOutboxEventModels(
+		[]string{models.OutboxSinkExternalEvents, models.OutboxSinkCloudEvents}, request)
+	assert.NoError(t, err)
+	assert.Len(t, outboxEvents, 2)
+	payload, _ := proto.Marshal(request)
+	for i, sink := range []string{models.OutboxSinkExternalEvents, models.OutboxSinkCloudEvents} {
+		assert.Equal(t, models.OutboxEvent{
+			ExecutionProject: "project",
+			ExecutionDomain:  "domain",
+			ExecutionName:    "name",
+			Sink:             sink,
+			DeduplicationKey: "project/domain/name.RUNNING.100000000005",
+			EventType:        "flyteidl.admin.WorkflowExecutionEventRequest",
+			Payload:          payload,
+		}, outboxEvents[i])
+	}
+
+	fromModel, err := FromOutboxEventModel(outboxEvents[0])
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(request, fromModel))
+}
+
+func TestCreateOutboxEventModels_DeduplicationKeys(t *testing.T) {
+	occurredAt, _ := ptypes.TimestampProto(time.Unix(100, 0))
+	nodeExecutionID := &core.NodeExecutionIdentifier{
+		NodeId:      "n0",
+		ExecutionId: outboxExecutionID,
+	}
+	outboxEvents, err := CreateOutboxEventModels([]string{models.OutboxSinkCloudEvents}, &admin.NodeExecutionEventRequest{
+		Event: &event.NodeExecutionEvent{
+			Id:         nodeExecutionID,
+			Phase:      core.NodeExecution_SUCCEEDED,
+			OccurredAt: occurredAt,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "project/domain/name/n0.SUCCEEDED.100000000000", outboxEvents[0].DeduplicationKey)
+
+	outboxEvents, err = CreateOutboxEventModels([]string{models.OutboxSinkCloudEvents}, &admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			TaskId: &core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "v1",
+			},
+			ParentNodeExecutionId: nodeExecutionID,
+			RetryAttempt:          2,
+			Phase:                 core.TaskExecution_RUNNING,
+			OccurredAt:            occurredAt,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "project/domain/name/n0/project/domain/task/v1/2.RUNNING.100000000000",
+		outboxEvents[0].DeduplicationKey)
+	assert.Equal(t, "name", outboxEvents[0].ExecutionName)
+}
+
+func TestCreateOutboxEventModels_NoSinks(t *testing.T) {
+	outboxEvents, err := CreateOutboxEventModels(nil, &admin.WorkflowExecutionEventRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, outboxEvents)
+}
+
+func TestFromOutboxEventModel_UnsupportedType(t *testing.T) {
+	_, err := FromOutboxEventModel(models.OutboxEvent{EventType: "flyteidl.admin.EmailMessage"})
+	assert.EqualError(t, err, "unsupported outbox event type [flyteidl.admin.EmailMessage]")
+}
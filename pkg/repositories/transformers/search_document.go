@@ -0,0 +1,82 @@
+package transformers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+func getDescriptionText(description *admin.DescriptionEntity) string {
+	if description == nil {
+		return ""
+	}
+	// Long descriptions stored remotely only have a uri, which isn't worth searching.
+	return strings.TrimSpace(description.ShortDescription + " " + description.GetLongDescription().GetValue())
+}
+
+func getLabelsText(labels *admin.Labels) string {
+	words := make([]string, 0, 2*len(labels.GetValues()))
+	for key, value := range labels.GetValues() {
+		words = append(words, key, value)
+	}
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+func getVariablesText(inputs *core.VariableMap, outputs *core.VariableMap, parameters *core.ParameterMap) string {
+	names := make([]string, 0, len(inputs.GetVariables())+len(outputs.GetVariables())+len(parameters.GetParameters()))
+	for name := range inputs.GetVariables() {
+		names = append(names, name)
+	}
+	for name := range outputs.GetVariables() {
+		names = append(names, name)
+	}
+	for name := range parameters.GetParameters() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, " ")
+}
+
+func newSearchDocument(resourceType core.ResourceType, id *core.Identifier) *models.SearchDocument {
+	return &models.SearchDocument{
+		SearchDocumentKey: models.SearchDocumentKey{
+			ResourceType: resourceType,
+			Project:      id.GetProject(),
+			Domain:       id.GetDomain(),
+			Name:         id.GetName(),
+		},
+		Version: id.GetVersion(),
+	}
+}
+
+// Returns the search document of a registered task version.
+func CreateTaskSearchDocument(request admin.TaskCreateRequest) *models.SearchDocument {
+	document := newSearchDocument(core.ResourceType_TASK, request.Id)
+	document.Description = getDescriptionText(request.GetSpec().GetDescription())
+	typedInterface := request.GetSpec().GetTemplate().GetInterface()
+	document.Variables = getVariablesText(typedInterface.GetInputs(), typedInterface.GetOutputs(), nil)
+	return document
+}
+
+// Returns the search document of a registered workflow version.
+func CreateWorkflowSearchDocument(request admin.WorkflowCreateRequest) *models.SearchDocument {
+	document := newSearchDocument(core.ResourceType_WORKFLOW, request.Id)
+	document.Description = getDescriptionText(request.GetSpec().GetDescription())
+	typedInterface := request.GetSpec().GetTemplate().GetInterface()
+	document.Variables = getVariablesText(typedInterface.GetInputs(), typedInterface.GetOutputs(), nil)
+	return document
+}
+
+// Returns the search document of a registered launch plan version.
+func CreateLaunchPlanSearchDocument(launchPlan admin.LaunchPlan) *models.SearchDocument {
+	document := newSearchDocument(core.ResourceType_LAUNCH_PLAN, launchPlan.Id)
+	document.Labels = getLabelsText(launchPlan.GetSpec().GetLabels())
+	document.Variables = getVariablesText(nil, launchPlan.GetClosure().GetExpectedOutputs(),
+		launchPlan.GetClosure().GetExpectedInputs())
+	return document
+}
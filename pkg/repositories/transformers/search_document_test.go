@@ -0,0 +1,95 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+var searchDocumentIdentifier = &core.Identifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "core.flyte_basics.hello_world",
+	Version: "xyz",
+}
+
+var searchDocumentInterface = &core.TypedInterface{
+	Inputs: &core.VariableMap{
+		Variables: map[string]*core.Variable{"name": {}, "greeting": {}},
+	},
+	Outputs: &core.VariableMap{
+		Variables: map[string]*core.Variable{"o0": {}},
+	},
+}
+
+func getSearchDocumentKey(resourceType core.ResourceType) models.SearchDocumentKey {
+	return models.SearchDocumentKey{
+		ResourceType: resourceType,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "core.flyte_basics.hello_world",
+	}
+}
+
+func TestCreateTaskSearchDocument(t *testing.T) {
+	document := CreateTaskSearchDocument(admin.TaskCreateRequest{
+		Id: searchDocumentIdentifier,
+		Spec: &admin.TaskSpec{
+			Template: &core.TaskTemplate{Interface: searchDocumentInterface},
+			Description: &admin.DescriptionEntity{
+				ShortDescription: shortDescription,
+				LongDescription:  &admin.Description{Content: &admin.Description_Value{Value: "Says hello"}},
+			},
+		},
+	})
+	assert.Equal(t, &models.SearchDocument{
+		SearchDocumentKey: getSearchDocumentKey(core.ResourceType_TASK),
+		Version:           "xyz",
+		Description:       "hello Says hello",
+		Variables:         "greeting name o0",
+	}, document)
+}
+
+func TestCreateWorkflowSearchDocument(t *testing.T) {
+	document := CreateWorkflowSearchDocument(admin.WorkflowCreateRequest{
+		Id: searchDocumentIdentifier,
+		Spec: &admin.WorkflowSpec{
+			Template: &core.WorkflowTemplate{Interface: searchDocumentInterface},
+			Description: &admin.DescriptionEntity{
+				ShortDescription: shortDescription,
+				LongDescription:  &admin.Description{Content: &admin.Description_Uri{Uri: "s3://bucket/description"}},
+			},
+		},
+	})
+	assert.Equal(t, &models.SearchDocument{
+		SearchDocumentKey: getSearchDocumentKey(core.ResourceType_WORKFLOW),
+		Version:           "xyz",
+		Description:       shortDescription,
+		Variables:         "greeting name o0",
+	}, document)
+}
+
+func TestCreateLaunchPlanSearchDocument(t *testing.T) {
+	document := CreateLaunchPlanSearchDocument(admin.LaunchPlan{
+		Id: searchDocumentIdentifier,
+		Spec: &admin.LaunchPlanSpec{
+			Labels: &admin.Labels{Values: map[string]string{"team": "ml"}},
+		},
+		Closure: &admin.LaunchPlanClosure{
+			ExpectedInputs: &core.ParameterMap{
+				Parameters: map[string]*core.Parameter{"name": {}},
+			},
+			ExpectedOutputs: searchDocumentInterface.Outputs,
+		},
+	})
+	assert.Equal(t, &models.SearchDocument{
+		SearchDocumentKey: getSearchDocumentKey(core.ResourceType_LAUNCH_PLAN),
+		Version:           "xyz",
+		Labels:            "ml team",
+		Variables:         "name o0",
+	}, document)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/plugins"
 
@@ -12,7 +13,12 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 
+	backfill "github.com/flyteorg/flyteadmin/pkg/async/backfill/implementations"
+	backfillInterfaces "github.com/flyteorg/flyteadmin/pkg/async/backfill/interfaces"
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/implementations"
+	eventInterfaces "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	retention "github.com/flyteorg/flyteadmin/pkg/async/retention/implementations"
+	retentionInterfaces "github.com/flyteorg/flyteadmin/pkg/async/retention/interfaces"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
 
@@ -46,7 +52,68 @@ type AdminService struct {
 	VersionManager           interfaces.VersionInterface
 	DescriptionEntityManager interfaces.DescriptionEntityInterface
 	MetricsManager           interfaces.MetricsInterface
+	SearchManager            interfaces.SearchInterface
+	BackfillManager          interfaces.BackfillInterface
 	Metrics                  AdminMetrics
+
+	executionEventWriter     eventInterfaces.WorkflowExecutionEventWriter
+	nodeExecutionEventWriter eventInterfaces.NodeExecutionEventWriter
+	// Unset unless the events outbox is enabled.
+	outboxRelay eventInterfaces.OutboxRelay
+	// Unset unless executions are purged in the background.
+	purger retentionInterfaces.Purger
+	// Unset unless the execution tables are partitioned.
+	partitionMaintainer retentionInterfaces.PartitionMaintainer
+	backfiller          backfillInterfaces.Backfiller
+	eventsDrainTimeout  time.Duration
+}
+
+// Close waits for the queued execution and node execution events to be persisted, and for the outbox relay, the purger,
+// the partition maintainer and the backfiller to stop. It should be called once the service stopped serving requests.
+func (m *AdminService) Close(ctx context.Context) error {
+	if m.eventsDrainTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.eventsDrainTimeout)
+		defer cancel()
+	}
+	// Both writers are closed regardless, once the timeout passes the remaining events are spilled.
+	executionEventsErr := m.executionEventWriter.Close(ctx)
+	nodeExecutionEventsErr := m.nodeExecutionEventWriter.Close(ctx)
+	var outboxErr error
+	if m.outboxRelay != nil {
+		outboxErr = m.outboxRelay.Close(ctx)
+	}
+	var purgerErr error
+	if m.purger != nil {
+		purgerErr = m.purger.Close(ctx)
+	}
+	var partitionMaintainerErr error
+	if m.partitionMaintainer != nil {
+		partitionMaintainerErr = m.partitionMaintainer.Close(ctx)
+	}
+	var backfillerErr error
+	if m.backfiller != nil {
+		backfillerErr = m.backfiller.Close(ctx)
+	}
+	if executionEventsErr != nil {
+		return fmt.Errorf("failed to drain execution events: %w", executionEventsErr)
+	}
+	if nodeExecutionEventsErr != nil {
+		return fmt.Errorf("failed to drain node execution events: %w", nodeExecutionEventsErr)
+	}
+	if outboxErr != nil {
+		return fmt.Errorf("failed to stop the outbox relay: %w", outboxErr)
+	}
+	if purgerErr != nil {
+		return fmt.Errorf("failed to stop the purger: %w", purgerErr)
+	}
+	if partitionMaintainerErr != nil {
+		return fmt.Errorf("failed to stop the partition maintainer: %w", partitionMaintainerErr)
+	}
+	if backfillerErr != nil {
+		return fmt.Errorf("failed to stop the backfiller: %w", backfillerErr)
+	}
+	return nil
 }
 
 // Intercepts all admin requests to handle panics during execution.
@@ -83,6 +150,11 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 	if err != nil {
 		logger.Fatal(ctx, err)
 	}
+	err = repositories.WithReadReplicas(
+		ctx, db, databaseConfig, configuration.ApplicationConfiguration().GetDbReadReplicasConfig())
+	if err != nil {
+		logger.Fatal(ctx, err)
+	}
 	dbScope := adminScope.NewSubScope("database")
 	repo := repositories.NewGormRepo(
 		db, errors.NewPostgresErrorTransformer(adminScope.NewSubScope("errors")), dbScope)
@@ -99,7 +171,8 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 	pluginRegistry.RegisterDefault(plugins.PluginIDWorkflowExecutor, workflowExecutor)
 
 	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
-	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
+	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(),
+		repo.NotificationDeadLetterRepo(), adminScope)
 	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(), adminScope)
 	cloudEventPublisher := cloudevent.NewCloudEventsPublisher(ctx, *configuration.ApplicationConfiguration().GetCloudEventsConfig(), adminScope)
 	go func() {
@@ -107,6 +180,36 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		processor.StartProcessing()
 	}()
 
+	var outboxRelay eventInterfaces.OutboxRelay
+	if outboxConfig := applicationConfiguration.GetEventsOutboxConfig(); outboxConfig.Enabled {
+		outboxRelay = eventWriter.NewOutboxRelay(repo.OutboxEventRepo(), outboxConfig, eventPublisher,
+			cloudEventPublisher, adminScope.NewSubScope("outbox_relay"))
+		go func() {
+			logger.Info(ctx, "Started relaying outbox events.")
+			outboxRelay.Run()
+		}()
+	}
+
+	var purger retentionInterfaces.Purger
+	if retentionConfig := applicationConfiguration.GetRetentionConfig(); retentionConfig.Enabled {
+		purger = retention.NewPurger(repo, dataStorageClient, applicationConfiguration.GetMetadataStoragePrefix(),
+			retentionConfig, adminScope.NewSubScope("purger"))
+		go func() {
+			logger.Info(ctx, "Started purging executions past their retention period.")
+			purger.Run()
+		}()
+	}
+
+	var partitionMaintainer retentionInterfaces.PartitionMaintainer
+	if partitioningConfig := configuration.ApplicationConfiguration().GetDbPartitioningConfig(); partitioningConfig.Enabled {
+		partitionMaintainer = retention.NewPartitionMaintainer(repo, *partitioningConfig,
+			adminScope.NewSubScope("partition_maintainer"))
+		go func() {
+			logger.Info(ctx, "Started maintaining the partitions of the execution tables.")
+			partitionMaintainer.Run()
+		}()
+	}
+
 	// Configure workflow scheduler async processes.
 	schedulerConfig := configuration.ApplicationConfiguration().GetSchedulerConfig()
 	workflowScheduler := schedule.NewWorkflowScheduler(repo, schedule.WorkflowSchedulerConfig{
@@ -136,7 +239,9 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 	namedEntityManager := manager.NewNamedEntityManager(repo, configuration, adminScope.NewSubScope("named_entity_manager"))
 	descriptionEntityManager := manager.NewDescriptionEntityManager(repo, configuration, adminScope.NewSubScope("description_entity_manager"))
 
-	executionEventWriter := eventWriter.NewWorkflowExecutionEventWriter(repo, applicationConfiguration.GetAsyncEventsBufferSize())
+	eventWriterConfig := applicationConfiguration.GetAsyncEventsWriterConfig()
+	executionEventWriter := eventWriter.NewWorkflowExecutionEventWriter(repo, applicationConfiguration.GetAsyncEventsBufferSize(),
+		eventWriterConfig, adminScope.NewSubScope("workflow_execution_event_writer"))
 	go func() {
 		executionEventWriter.Run()
 	}()
@@ -146,6 +251,15 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		publisher, urlData, workflowManager, namedEntityManager, eventPublisher, cloudEventPublisher, executionEventWriter)
 	versionManager := manager.NewVersionManager()
 
+	backfillManager := manager.NewBackfillManager(repo, configuration, launchPlanManager, executionManager,
+		adminScope.NewSubScope("backfill_manager"))
+	backfiller := backfill.NewBackfiller(backfillManager, repo.LockRepo(), applicationConfiguration.GetBackfillConfig(),
+		adminScope.NewSubScope("backfiller"))
+	go func() {
+		logger.Info(ctx, "Started launching the executions of backfills.")
+		backfiller.Run()
+	}()
+
 	scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
 	logger.Info(ctx, "Successfully initialized a new scheduled workflow executor")
 	go func() {
@@ -153,15 +267,16 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		scheduledWorkflowExecutor.Run()
 	}()
 
-	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(repo, applicationConfiguration.GetAsyncEventsBufferSize())
+	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(repo, applicationConfiguration.GetAsyncEventsBufferSize(),
+		eventWriterConfig, adminScope.NewSubScope("node_execution_event_writer"))
 	go func() {
 		nodeExecutionEventWriter.Run()
 	}()
 
 	nodeExecutionManager := manager.NewNodeExecutionManager(repo, configuration, applicationConfiguration.GetMetadataStoragePrefix(), dataStorageClient,
-		adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, cloudEventPublisher, nodeExecutionEventWriter)
+		adminScope.NewSubScope("node_execution_manager"), urlData, publisher, eventPublisher, cloudEventPublisher, nodeExecutionEventWriter)
 	taskExecutionManager := manager.NewTaskExecutionManager(repo, configuration, dataStorageClient,
-		adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher, cloudEventPublisher)
+		adminScope.NewSubScope("task_execution_manager"), urlData, publisher, eventPublisher, cloudEventPublisher)
 
 	logger.Info(ctx, "Initializing a new AdminService")
 	return &AdminService{
@@ -175,10 +290,19 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		VersionManager:           versionManager,
 		NodeExecutionManager:     nodeExecutionManager,
 		TaskExecutionManager:     taskExecutionManager,
-		ProjectManager:           manager.NewProjectManager(repo, configuration),
+		ProjectManager:           manager.NewProjectManager(repo, configuration, eventScheduler),
 		ResourceManager:          resources.NewResourceManager(repo, configuration.ApplicationConfiguration()),
 		MetricsManager: manager.NewMetricsManager(workflowManager, executionManager, nodeExecutionManager,
 			taskExecutionManager, adminScope.NewSubScope("metrics_manager")),
-		Metrics: InitMetrics(adminScope),
+		SearchManager:            manager.NewSearchManager(repo),
+		BackfillManager:          backfillManager,
+		Metrics:                  InitMetrics(adminScope),
+		executionEventWriter:     executionEventWriter,
+		nodeExecutionEventWriter: nodeExecutionEventWriter,
+		outboxRelay:              outboxRelay,
+		purger:                   purger,
+		partitionMaintainer:      partitionMaintainer,
+		backfiller:               backfiller,
+		eventsDrainTimeout:       eventWriterConfig.DrainTimeout.Duration,
 	}
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"testing"
 
+	eventMocks "github.com/flyteorg/flyteadmin/pkg/async/events/mocks"
+	retentionMocks "github.com/flyteorg/flyteadmin/pkg/async/retention/mocks"
 	"github.com/flyteorg/flytestdlib/logger"
 
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_interceptPanic(t *testing.T) {
@@ -38,3 +41,30 @@ func Test_interceptPanic(t *testing.T) {
 		a()
 	}()
 }
+
+func TestAdminService_Close(t *testing.T) {
+	executionEventWriter := &eventMocks.WorkflowExecutionEventWriter{}
+	executionEventWriter.On("Close", mock.Anything).Return(nil)
+	nodeExecutionEventWriter := &eventMocks.NodeExecutionEventWriter{}
+	nodeExecutionEventWriter.On("Close", mock.Anything).Return(nil)
+	outboxRelay := &eventMocks.OutboxRelay{}
+	outboxRelay.On("Close", mock.Anything).Return(context.DeadlineExceeded)
+	m := AdminService{
+		executionEventWriter:     executionEventWriter,
+		nodeExecutionEventWriter: nodeExecutionEventWriter,
+		outboxRelay:              outboxRelay,
+	}
+
+	assert.EqualError(t, m.Close(context.Background()), "failed to stop the outbox relay: context deadline exceeded")
+	executionEventWriter.AssertExpectations(t)
+	nodeExecutionEventWriter.AssertExpectations(t)
+
+	m.outboxRelay = nil
+	purger := &retentionMocks.Purger{}
+	purger.On("Close", mock.Anything).Return(context.DeadlineExceeded)
+	m.purger = purger
+	assert.EqualError(t, m.Close(context.Background()), "failed to stop the purger: context deadline exceeded")
+
+	m.purger = nil
+	assert.NoError(t, m.Close(context.Background()))
+}
@@ -68,6 +68,11 @@ func NewSignalServer(ctx context.Context, configuration runtimeIfaces.Configurat
 	if err != nil {
 		logger.Fatal(ctx, err)
 	}
+	err = repositories.WithReadReplicas(
+		ctx, db, databaseConfig, configuration.ApplicationConfiguration().GetDbReadReplicasConfig())
+	if err != nil {
+		logger.Fatal(ctx, err)
+	}
 	dbScope := adminScope.NewSubScope("database")
 	repo := repositories.NewGormRepo(
 		db, errors.NewPostgresErrorTransformer(adminScope.NewSubScope("errors")), dbScope)
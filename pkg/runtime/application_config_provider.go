@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/config"
@@ -15,6 +17,8 @@ const notifications = "notifications"
 const domains = "domains"
 const externalEvents = "externalEvents"
 const cloudEvents = "cloudEvents"
+const dbReadReplicas = "readReplicas"
+const dbPartitioning = "partitioning"
 const metricPort = 10254
 
 const KB = 1024
@@ -33,6 +37,32 @@ var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.Applic
 	MaxParallelism:              25,
 	K8SServiceAccount:           "",
 	UseOffloadedWorkflowClosure: false,
+	AsyncEventsWriter: interfaces.AsyncEventsWriterConfig{
+		BatchSize:     100,
+		FlushInterval: config.Duration{Duration: time.Second},
+		MaxRetries:    3,
+		RetryDelay:    config.Duration{Duration: time.Second},
+		MaxSpillBytes: 1024 * MB,
+		DrainTimeout:  config.Duration{Duration: 30 * time.Second},
+	},
+	EventsOutbox: interfaces.EventsOutboxConfig{
+		PollInterval:    config.Duration{Duration: time.Second},
+		BatchSize:       100,
+		MaxAttempts:     10,
+		RetentionPeriod: config.Duration{Duration: 24 * time.Hour},
+	},
+	Retention: interfaces.RetentionConfig{
+		Interval:  config.Duration{Duration: time.Hour},
+		BatchSize: 100,
+		Archive: interfaces.ArchiveConfig{
+			StoragePrefix: []string{"archive", "executions"},
+		},
+	},
+	Backfill: interfaces.BackfillConfig{
+		Interval:              config.Duration{Duration: 30 * time.Second},
+		MaxScheduledTimes:     5000,
+		DefaultMaxConcurrency: 5,
+	},
 })
 
 var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.SchedulerConfig{
@@ -50,6 +80,10 @@ var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.Schedule
 			},
 		},
 	},
+	LeaderElection: interfaces.SchedulerLeaderElectionConfig{
+		LeaseDuration: config.Duration{Duration: 15 * time.Second},
+		RetryPeriod:   config.Duration{Duration: 2 * time.Second},
+	},
 })
 var remoteDataConfig = config.MustRegisterSection(remoteData, &interfaces.RemoteDataConfig{
 	Scheme:                common.None,
@@ -61,6 +95,12 @@ var remoteDataConfig = config.MustRegisterSection(remoteData, &interfaces.Remote
 })
 var notificationsConfig = config.MustRegisterSection(notifications, &interfaces.NotificationsConfig{
 	Type: common.Local,
+	NotificationsProcessorConfig: interfaces.NotificationsProcessorConfig{
+		DeadLetter: interfaces.NotificationsDeadLetterConfig{
+			MaxAttempts: 3,
+			RetryDelay:  config.Duration{Duration: time.Second},
+		},
+	},
 })
 var domainsConfig = config.MustRegisterSection(domains, &interfaces.DomainsConfig{
 	{
@@ -84,6 +124,15 @@ var cloudEventsConfig = config.MustRegisterSection(cloudEvents, &interfaces.Clou
 	Type: common.Local,
 })
 
+var dbReadReplicasConfig = config.GetSection("database").MustRegisterSection(
+	dbReadReplicas, &interfaces.DbReadReplicasConfig{})
+
+var dbPartitioningConfig = config.GetSection("database").MustRegisterSection(
+	dbPartitioning, &interfaces.DbPartitioningConfig{
+		FutureMonths: 3,
+		Interval:     config.Duration{Duration: time.Hour},
+	})
+
 // Implementation of an interfaces.ApplicationConfiguration
 type ApplicationConfigurationProvider struct{}
 
@@ -91,6 +140,14 @@ func (p *ApplicationConfigurationProvider) GetDbConfig() *database.DbConfig {
 	return database.GetConfig()
 }
 
+func (p *ApplicationConfigurationProvider) GetDbReadReplicasConfig() *interfaces.DbReadReplicasConfig {
+	return dbReadReplicasConfig.GetConfig().(*interfaces.DbReadReplicasConfig)
+}
+
+func (p *ApplicationConfigurationProvider) GetDbPartitioningConfig() *interfaces.DbPartitioningConfig {
+	return dbPartitioningConfig.GetConfig().(*interfaces.DbPartitioningConfig)
+}
+
 func (p *ApplicationConfigurationProvider) GetTopLevelConfig() *interfaces.ApplicationConfig {
 	return flyteAdminConfig.GetConfig().(*interfaces.ApplicationConfig)
 }
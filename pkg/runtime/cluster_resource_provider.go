@@ -40,6 +40,10 @@ func (p *ClusterResourceConfigurationProvider) IsStandaloneDeployment() bool {
 	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).StandaloneDeployment
 }
 
+func (p *ClusterResourceConfigurationProvider) ShouldDeleteArchivedNamespaces() bool {
+	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).DeleteArchivedNamespaces
+}
+
 func NewClusterResourceConfigurationProvider() interfaces.ClusterResourceConfiguration {
 	return &ClusterResourceConfigurationProvider{}
 }
@@ -30,6 +30,25 @@ type DbConfig struct {
 	SQLiteConfig                            *SQLiteConfig   `json:"sqlite,omitempty"`
 }
 
+// DbReadReplicasConfig configures the read replicas of the postgres database, nested under the database config as
+// readReplicas. Repository reads made outside of transactions are balanced across the replicas, unless their context
+// sets common.PrimaryReadsKey. The connection pool settings of the database config apply to every replica.
+type DbReadReplicasConfig struct {
+	Postgres []database.PostgresConfig `json:"postgres"`
+}
+
+// DbPartitioningConfig configures the partitioning of the node and task executions tables of the postgres database,
+// nested under the database config as partitioning.
+type DbPartitioningConfig struct {
+	// Runs the migrations range partitioning the tables by the month rows were created in when set, and periodically
+	// creates the partitions of the months to come and detaches those of past months which are no longer needed.
+	Enabled bool `json:"enabled"`
+	// How many months ahead of the current one partitions are created for.
+	FutureMonths int `json:"futureMonths"`
+	// How often partitions are maintained in the background.
+	Interval config.Duration `json:"interval"`
+}
+
 // SQLiteConfig can be used to configure
 type SQLiteConfig struct {
 	File string `json:"file" pflag:",The path to the file (existing or new) where the DB should be created / stored. If existing, then this will be re-used, else a new will be created"`
@@ -48,6 +67,96 @@ type PostgresConfig struct {
 	Debug        bool   `json:"debug" pflag:" Whether or not to start the database connection with debug mode enabled."`
 }
 
+// AsyncEventsWriterConfig configures the writers which asynchronously persist workflow and node execution events.
+type AsyncEventsWriterConfig struct {
+	// The maximum number of events inserted in a single statement.
+	BatchSize int `json:"batchSize"`
+	// How long queued events wait for a batch to fill before they're inserted anyway.
+	FlushInterval config.Duration `json:"flushInterval"`
+	// How many times an insert failing with a transient error is retried before its events are spilled to disk.
+	MaxRetries int `json:"maxRetries"`
+	// The delay before the first retry, doubled on every subsequent one.
+	RetryDelay config.Duration `json:"retryDelay"`
+	// Local directory events are spilled to when the database is unavailable or the buffer is full, and replayed from
+	// once it recovers. Spilling is disabled, and such events dropped, when unset.
+	SpillDirectory string `json:"spillDirectory"`
+	// The maximum size of the spilled events on disk, once reached further events are dropped. Zero means no limit.
+	MaxSpillBytes int64 `json:"maxSpillBytes"`
+	// How long shutdown waits for queued events to be written, after which the remainder is spilled to disk.
+	DrainTimeout config.Duration `json:"drainTimeout"`
+}
+
+// EventsOutboxConfig configures the transactional outbox of external events and cloud events. When enabled, events are
+// recorded in the same transaction as the execution state change they describe and published by a background relay,
+// rather than published once the state change was committed.
+type EventsOutboxConfig struct {
+	Enabled bool `json:"enabled"`
+	// How often the relay checks for events to publish.
+	PollInterval config.Duration `json:"pollInterval"`
+	// The maximum number of events published by the relay at a time.
+	BatchSize int `json:"batchSize"`
+	// How many times publishing an event is attempted before it's given up on.
+	MaxAttempts int `json:"maxAttempts"`
+	// How long published events are kept around before they're deleted.
+	RetentionPeriod config.Duration `json:"retentionPeriod"`
+}
+
+// RetentionPolicy selects the executions to purge. Policies for a project and domain take precedence over those for the
+// project, which take precedence over those for every project.
+type RetentionPolicy struct {
+	// Optional, the policy applies to every project when unset.
+	Project string `json:"project"`
+	// Optional, the policy applies to every domain of the project when unset.
+	Domain string `json:"domain"`
+	// Executions are purged once they were last updated this long ago. Executions of the project and domain are kept
+	// forever when unset.
+	MaxAge config.Duration `json:"maxAge"`
+	// The terminal phases of the executions to purge, all of them when unset.
+	Phases []string `json:"phases"`
+}
+
+// ArchiveConfig configures the archival of executions to the data store before they're purged.
+type ArchiveConfig struct {
+	// Archives executions before purging them when set.
+	Enabled bool `json:"enabled"`
+	// The nested path on the data store under which archive files are written, partitioned by project, domain and the
+	// date executions were created on.
+	StoragePrefix []string `json:"storagePrefix"`
+}
+
+// RetentionConfig configures the purging of terminal executions, along with their node executions, task executions
+// and events, past their retention period.
+type RetentionConfig struct {
+	// Purges executions in the background when set, otherwise they're only purged by the purge command.
+	Enabled bool `json:"enabled"`
+	// How often executions are purged in the background.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of executions deleted at a time.
+	BatchSize int `json:"batchSize"`
+	// Also deletes the inputs and dynamic workflow closures offloaded by admin for the purged executions.
+	DeleteOffloadedData bool              `json:"deleteOffloadedData"`
+	Policies            []RetentionPolicy `json:"policies"`
+	Archive             ArchiveConfig     `json:"archive"`
+}
+
+// EntityDeletionConfig configures the hard deletion of task, workflow and launch plan versions.
+type EntityDeletionConfig struct {
+	// Entity versions can only be deleted when set.
+	Enabled bool `json:"enabled"`
+	// The user or app ids allowed to delete entity versions. No caller may delete them when empty.
+	Admins []string `json:"admins"`
+}
+
+// BackfillConfig configures how the executions of backfills are launched.
+type BackfillConfig struct {
+	// How often the next executions of running backfills are launched.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of times a backfill may be scheduled at over its time range.
+	MaxScheduledTimes int `json:"maxScheduledTimes"`
+	// The number of executions of a backfill run at a time, unless the backfill sets its own maximum.
+	DefaultMaxConcurrency uint32 `json:"defaultMaxConcurrency"`
+}
+
 // ApplicationConfig is the base configuration to start admin
 type ApplicationConfig struct {
 	// The RoleName key inserted as an annotation (https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/)
@@ -67,6 +176,16 @@ type ApplicationConfig struct {
 	EventVersion int `json:"eventVersion"`
 	// Specifies the shared buffer size which is used to queue asynchronous event writes.
 	AsyncEventsBufferSize int `json:"asyncEventsBufferSize"`
+	// Configures how queued asynchronous event writes are batched, retried and spilled to disk.
+	AsyncEventsWriter AsyncEventsWriterConfig `json:"asyncEventsWriter"`
+	// Configures the transactional outbox external events and cloud events are published through.
+	EventsOutbox EventsOutboxConfig `json:"eventsOutbox"`
+	// Configures how long executions are retained before they're purged.
+	Retention RetentionConfig `json:"retention"`
+	// Configures who may hard delete task, workflow and launch plan versions.
+	EntityDeletion EntityDeletionConfig `json:"entityDeletion"`
+	// Configures how backfills of scheduled launch plans are launched.
+	Backfill BackfillConfig `json:"backfill"`
 	// Controls the maximum number of task nodes that can be run in parallel for the entire workflow.
 	// This is useful to achieve fairness. Note: MapTasks are regarded as one unit,
 	// and parallelism/concurrency of MapTasks is independent from this.
@@ -123,6 +242,26 @@ func (a *ApplicationConfig) GetAsyncEventsBufferSize() int {
 	return a.AsyncEventsBufferSize
 }
 
+func (a *ApplicationConfig) GetAsyncEventsWriterConfig() AsyncEventsWriterConfig {
+	return a.AsyncEventsWriter
+}
+
+func (a *ApplicationConfig) GetEventsOutboxConfig() EventsOutboxConfig {
+	return a.EventsOutbox
+}
+
+func (a *ApplicationConfig) GetRetentionConfig() RetentionConfig {
+	return a.Retention
+}
+
+func (a *ApplicationConfig) GetEntityDeletionConfig() EntityDeletionConfig {
+	return a.EntityDeletion
+}
+
+func (a *ApplicationConfig) GetBackfillConfig() BackfillConfig {
+	return a.Backfill
+}
+
 func (a *ApplicationConfig) GetMaxParallelism() int32 {
 	return a.MaxParallelism
 }
@@ -226,6 +365,15 @@ type KafkaConfig struct {
 	Brokers []string `json:"brokers"`
 }
 
+// This section holds common config for NATS JetStream
+type NATSConfig struct {
+	// Address of the NATS server, e.g. nats://localhost:4222. Credentials may be given as user info, a user without
+	// a password is used as an authentication token. tls:// addresses connect over TLS.
+	URL string `json:"url"`
+	// How long the server waits for a message to be acknowledged before redelivering it.
+	AckWait config.Duration `json:"ackWait"`
+}
+
 // This section holds configuration for the event scheduler used to schedule workflow executions.
 type EventSchedulerConfig struct {
 	// Defines the cloud provider that backs the scheduler. In the absence of a specification the no-op, 'local'
@@ -371,7 +519,8 @@ type FlyteWorkflowExecutorConfig struct {
 	// eg : 100 TPS will send at the max 100 schedule requests to admin per sec.
 	// Burst specifies burst traffic count
 	AdminRateLimit *AdminRateLimit `json:"adminRateLimit"`
-	// Defaults to using user local timezone where the scheduler is deployed.
+	// Defaults to using user local timezone where the scheduler is deployed. Cron schedules prefixed by a time zone,
+	// e.g. "CRON_TZ=Europe/Berlin 0 9 * * *", are evaluated in it instead.
 	UseUTCTz bool `json:"useUTCTz"`
 }
 
@@ -396,6 +545,37 @@ func (f *AdminRateLimit) GetBurst() int {
 	return f.Burst
 }
 
+// SchedulerLeaderElectionConfig configures the election, through a lease in the database, of the native scheduler
+// replica which runs the schedules.
+type SchedulerLeaderElectionConfig struct {
+	// Only the replica holding the lease runs the schedules when set, otherwise every replica runs them.
+	Enabled bool `json:"enabled"`
+	// How long the lease is held for unless renewed, after which another replica may acquire it.
+	LeaseDuration config.Duration `json:"leaseDuration"`
+	// How often the leader renews the lease, and the other replicas attempt to acquire it.
+	RetryPeriod config.Duration `json:"retryPeriod"`
+}
+
+// ScheduleCatchUpPolicy determines which of the runs of the native scheduler's schedules missed while it was down, or
+// while they were deactivated, are launched once they're running again. Policies for a launch plan take precedence over
+// those for its project and domain, which take precedence over those for the project, then those for every project.
+// Policies for a launch plan are stored with its schedule when it's activated, the others are defaults applied to the
+// schedules without one.
+type ScheduleCatchUpPolicy struct {
+	// Optional, the policy applies to every project when unset.
+	Project string `json:"project"`
+	// Optional, the policy applies to every domain of the project when unset.
+	Domain string `json:"domain"`
+	// Optional, the name of the launch plan the policy applies to, every one of the project and domain when unset.
+	Name string `json:"name"`
+	// One of all, latest, none, maxRuns or maxAge.
+	Policy string `json:"policy"`
+	// The most recent missed runs launched by the maxRuns policy.
+	MaxRuns uint32 `json:"maxRuns"`
+	// How long ago runs may have been missed to be launched by the maxAge policy.
+	MaxAge config.Duration `json:"maxAge"`
+}
+
 // This configuration is the base configuration for all scheduler-related set-up.
 type SchedulerConfig struct {
 	// Determines which port the profiling server used for scheduler monitoring and application debugging uses.
@@ -406,6 +586,11 @@ type SchedulerConfig struct {
 	ReconnectAttempts int `json:"reconnectAttempts"`
 	// Specifies the time interval to wait before attempting to reconnect the workflow executor client.
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
+	// Configures the election of the native scheduler replica which runs the schedules.
+	LeaderElection SchedulerLeaderElectionConfig `json:"leaderElection"`
+	// Which runs missed by the native scheduler's schedules are launched. Schedules without a policy catch up on all the
+	// runs missed while the scheduler was down, and none of those missed while they were deactivated.
+	CatchUpPolicies []ScheduleCatchUpPolicy `json:"catchUpPolicies"`
 }
 
 func (s *SchedulerConfig) GetEventSchedulerConfig() EventSchedulerConfig {
@@ -424,6 +609,14 @@ func (s *SchedulerConfig) GetReconnectDelaySeconds() int {
 	return s.ReconnectDelaySeconds
 }
 
+func (s *SchedulerConfig) GetLeaderElectionConfig() SchedulerLeaderElectionConfig {
+	return s.LeaderElection
+}
+
+func (s *SchedulerConfig) GetCatchUpPolicies() []ScheduleCatchUpPolicy {
+	return s.CatchUpPolicies
+}
+
 // Configuration specific to setting up signed urls.
 type SignedURL struct {
 	// Whether signed urls should even be returned with GetExecutionData, GetNodeExecutionData and GetTaskExecutionData
@@ -463,17 +656,46 @@ type RemoteDataConfig struct {
 
 // This section handles configuration for the workflow notifications pipeline.
 type NotificationsPublisherConfig struct {
-	// The topic which notifications use, e.g. AWS SNS topics.
+	// The topic which notifications use, e.g. AWS SNS topics, Kafka topics or NATS subjects.
 	TopicName string `json:"topicName"`
 }
 
 // This section handles configuration for processing workflow events.
 type NotificationsProcessorConfig struct {
-	// The name of the queue onto which workflow notifications will enqueue.
+	// The name of the queue onto which workflow notifications will enqueue. For Kafka this is the topic consumed and for
+	// NATS the JetStream stream capturing the publisher subject.
 	QueueName string `json:"queueName"`
 	// The account id (according to whichever cloud provider scheme is used) that has permission to read from the above
 	// queue.
 	AccountID string `json:"accountId"`
+	// The Kafka consumer group, or NATS JetStream durable consumer, shared by all processors so that each notification
+	// is only processed by one of them.
+	ConsumerGroup string `json:"consumerGroup"`
+	// Configures where notifications which can't be processed end up.
+	DeadLetter NotificationsDeadLetterConfig `json:"deadLetter"`
+}
+
+// The dead-letter destinations of notifications which can't be processed.
+const (
+	// Dead-lettered notifications are published to a topic using the notifications publisher type, e.g. an SNS topic
+	// which fans out to an SQS dead-letter queue.
+	DeadLetterTypeTopic = "topic"
+	// Dead-lettered notifications are recorded in the database, where they can be listed and replayed with the
+	// `flyteadmin notifications dead-letters` commands.
+	DeadLetterTypeDB = "db"
+)
+
+// NotificationsDeadLetterConfig configures how notifications which fail to decode, or fail to send after exhausting
+// their retries, are dead-lettered. When no type is set they are logged and dropped.
+type NotificationsDeadLetterConfig struct {
+	// Either topic or db.
+	Type string `json:"type"`
+	// For the topic type, the topic (for NATS, the subject) dead-lettered notifications are published to.
+	TopicName string `json:"topicName"`
+	// How many times sending a notification is attempted before it's dead-lettered.
+	MaxAttempts int `json:"maxAttempts"`
+	// How long to wait between attempts to send a notification.
+	RetryDelay config.Duration `json:"retryDelay"`
 }
 
 type EmailServerConfig struct {
@@ -495,6 +717,113 @@ type NotificationsEmailerConfig struct {
 	Body string `json:"body"`
 }
 
+// This section handles the native delivery of Slack notifications. When disabled, Slack notifications are sent as
+// emails to the recipients listed in the notification.
+type NotificationsSlackConfig struct {
+	Enabled bool `json:"enabled"`
+	// Name of the secret holding the incoming webhook URL messages are posted to.
+	WebhookURLSecretName string `json:"webhookUrlSecretName"`
+	// Name of the secret holding a bot token. When set, messages are posted with chat.postMessage to every channel
+	// listed as a recipient instead of to the incoming webhook.
+	TokenSecretName string `json:"tokenSecretName"`
+	// The channel used with chat.postMessage when a notification doesn't list any channel recipients.
+	DefaultChannel string `json:"defaultChannel"`
+	// Overrides the Slack Web API base url, defaults to https://slack.com/api.
+	APIURL string `json:"apiUrl"`
+	// The optionally templatized subject, defaults to the emailer subject.
+	Subject string `json:"subject"`
+	// The optionally templatized body, defaults to the emailer body.
+	Body string `json:"body"`
+	// Number of times to retry delivering a message on transient errors.
+	RetryAttempts int `json:"retryAttempts"`
+	// Specifies the time interval to wait before the first retry, doubled for every subsequent one.
+	RetryDelaySeconds int `json:"retryDelaySeconds"`
+}
+
+// This section handles the native delivery of PagerDuty notifications through the Events v2 API. When disabled,
+// PagerDuty notifications are sent as emails to the recipients listed in the notification.
+type NotificationsPagerDutyConfig struct {
+	Enabled bool `json:"enabled"`
+	// Name of the secret holding the integration (routing) key used for recipients that are email addresses.
+	RoutingKeySecretName string `json:"routingKeySecretName"`
+	// Overrides the Events API url, defaults to https://events.pagerduty.com/v2/enqueue.
+	EventsURL string `json:"eventsUrl"`
+	// Severity of the triggered alerts, one of critical, error, warning or info. Defaults to error.
+	Severity string `json:"severity"`
+	// The optionally templatized alert summary, defaults to the emailer subject.
+	Subject string `json:"subject"`
+	// The optionally templatized alert details, defaults to the emailer body.
+	Body string `json:"body"`
+	// Number of times to retry delivering an alert on transient errors.
+	RetryAttempts int `json:"retryAttempts"`
+	// Specifies the time interval to wait before the first retry, doubled for every subsequent one.
+	RetryDelaySeconds int `json:"retryDelaySeconds"`
+}
+
+// A webhook workflow execution phase changes are posted to as JSON.
+type WebhookConfig struct {
+	// Uniquely identifies the webhook.
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Name of the secret holding the key payloads are signed with (HMAC-SHA256). Payloads are sent unsigned when unset.
+	SecretName string `json:"secretName"`
+	// The workflow execution phases (e.g. SUCCEEDED) posted to the webhook. Defaults to all terminal phases.
+	Phases []string `json:"phases"`
+}
+
+// This section handles the delivery of workflow execution phase changes to generic HTTP webhooks.
+type NotificationsWebhookConfig struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+	// Number of times to retry delivering a payload on transient errors.
+	RetryAttempts int `json:"retryAttempts"`
+	// Specifies the time interval to wait before the first retry, doubled for every subsequent one.
+	RetryDelaySeconds int `json:"retryDelaySeconds"`
+}
+
+// A NotificationTriggerConfig subscribes recipients to the phase changes of matching node or task executions.
+type NotificationTriggerConfig struct {
+	// The project and domain of the executions the trigger applies to, all when unset.
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// The node (e.g. n0) the trigger applies to, all nodes when unset.
+	NodeID string `json:"nodeId"`
+	// The name of the task the trigger applies to, all tasks when unset. Only valid for task triggers.
+	TaskName string `json:"taskName"`
+	// Only notify once a task has been retried at least this many times. Only valid for task triggers.
+	MinRetryAttempts uint32 `json:"minRetryAttempts"`
+	// The node or task execution phases (e.g. FAILED) which trigger a notification. Defaults to all terminal phases.
+	Phases []string `json:"phases"`
+	// How the notification is delivered: email (the default), slack, pagerDuty or webhook. Recipients are email
+	// addresses, Slack channels, PagerDuty routing keys or webhook names accordingly.
+	Type       string   `json:"type"`
+	Recipients []string `json:"recipients"`
+	// The optionally templatized subject and body, a generic description of the phase change is sent when unset.
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// NotificationTemplatesConfig holds the optionally templatized subject and body of a notification type.
+type NotificationTemplatesConfig struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// A NotificationTemplateOverrideConfig overrides the templates of the notifications sent for the executions of a
+// project. The most specific override matching an execution applies, those for a launch plan taking precedence over
+// those for a workflow, which take precedence over those for a domain and then the project.
+type NotificationTemplateOverrideConfig struct {
+	Project string `json:"project"`
+	// Optional, the override applies to every domain of the project when unset.
+	Domain string `json:"domain"`
+	// Optional, the names of the workflow and launch plan of the executions the override applies to.
+	Workflow   string `json:"workflow"`
+	LaunchPlan string `json:"launchPlan"`
+	// Templates left unset fall back to the configured ones.
+	Email     NotificationTemplatesConfig `json:"email"`
+	Slack     NotificationTemplatesConfig `json:"slack"`
+	PagerDuty NotificationTemplatesConfig `json:"pagerDuty"`
+}
+
 // This section handles configuration for the workflow notifications pipeline.
 type EventsPublisherConfig struct {
 	// The topic which events should be published, e.g. node, task, workflow
@@ -522,10 +851,11 @@ type CloudEventsConfig struct {
 	Enable bool `json:"enable"`
 	// Defines the cloud provider that backs the scheduler. In the absence of a specification the no-op, 'local'
 	// scheme is used.
-	Type        string      `json:"type"`
-	AWSConfig   AWSConfig   `json:"aws"`
-	GCPConfig   GCPConfig   `json:"gcp"`
-	KafkaConfig KafkaConfig `json:"kafka"`
+	Type        string                `json:"type"`
+	AWSConfig   AWSConfig             `json:"aws"`
+	GCPConfig   GCPConfig             `json:"gcp"`
+	KafkaConfig KafkaConfig           `json:"kafka"`
+	HTTPConfig  CloudEventsHTTPConfig `json:"http"`
 	// Publish events to a pubsub tops
 	EventsPublisherConfig EventsPublisherConfig `json:"eventsPublisher"`
 	// Number of times to attempt recreating a notifications processor client should there be any disruptions.
@@ -534,6 +864,45 @@ type CloudEventsConfig struct {
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
 }
 
+// The CloudEvents HTTP protocol binding modes.
+const (
+	// The event attributes are sent as ce- headers and the event data as the request body.
+	CloudEventsHTTPModeBinary = "binary"
+	// The whole event is sent as the application/cloudevents+json request body.
+	CloudEventsHTTPModeStructured = "structured"
+)
+
+// This section configures posting cloud events to an HTTP receiver, e.g. a Knative broker or an Argo Events webhook,
+// using the CloudEvents HTTP protocol binding.
+type CloudEventsHTTPConfig struct {
+	// The url events are posted to.
+	URL string `json:"url"`
+	// Either binary (the default) or structured.
+	Mode string `json:"mode"`
+	// Headers added to every request.
+	Headers map[string]string `json:"headers"`
+	// Name of the secret holding the value of the Authorization header, e.g. "Bearer <token>".
+	AuthHeaderSecretName string `json:"authHeaderSecretName"`
+	// How long to wait for the receiver to respond to a request, defaults to 10s.
+	Timeout config.Duration `json:"timeout"`
+	TLS     TLSClientConfig `json:"tls"`
+	// Number of times to retry delivering an event on transient errors, e.g. 429 and 503 responses.
+	RetryAttempts int `json:"retryAttempts"`
+	// Specifies the time interval to wait before the first retry, doubled for every subsequent one. Defaults to 1s.
+	RetryDelaySeconds int `json:"retryDelaySeconds"`
+}
+
+// TLSClientConfig configures how clients verify the servers they connect to and authenticate to them.
+type TLSClientConfig struct {
+	// PEM encoded certificates of the CAs trusted to verify the server, the system CAs when unset.
+	CACertFile string `json:"caCertFile"`
+	// PEM encoded client certificate and key presented to servers requiring mutual TLS.
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	// Skips verifying the server certificate. Only meant for testing.
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+}
+
 // Configuration specific to notifications handling
 type NotificationsConfig struct {
 	// Defines the cloud provider that backs the scheduler. In the absence of a specification the no-op, 'local'
@@ -543,9 +912,26 @@ type NotificationsConfig struct {
 	Region                       string                       `json:"region"`
 	AWSConfig                    AWSConfig                    `json:"aws"`
 	GCPConfig                    GCPConfig                    `json:"gcp"`
+	KafkaConfig                  KafkaConfig                  `json:"kafka"`
+	NATSConfig                   NATSConfig                   `json:"nats"`
 	NotificationsPublisherConfig NotificationsPublisherConfig `json:"publisher"`
 	NotificationsProcessorConfig NotificationsProcessorConfig `json:"processor"`
 	NotificationsEmailerConfig   NotificationsEmailerConfig   `json:"emailer"`
+	NotificationsSlackConfig     NotificationsSlackConfig     `json:"slack"`
+	NotificationsPagerDutyConfig NotificationsPagerDutyConfig `json:"pagerDuty"`
+	NotificationsWebhookConfig   NotificationsWebhookConfig   `json:"webhook"`
+	// Engine notification subjects and bodies are rendered with, either "legacy" (the default) which only substitutes
+	// {{ project }}-style parameters or "gotemplate" which executes them as go templates.
+	TemplateEngine string `json:"templateEngine"`
+	// Base url of the Flyte console (e.g. https://flyte.example.com/console) used to link executions in notifications.
+	ConsoleURL string `json:"consoleUrl"`
+	// Per project overrides of the templates of the notifications sent for executions. Templates overridden in the
+	// "notifications.<type>.<subject|body>" cluster resource attributes matching an execution take precedence.
+	TemplateOverrides []NotificationTemplateOverrideConfig `json:"templateOverrides"`
+	// Notifications sent on node execution phase changes, regardless of the execution's own notifications.
+	NodeTriggers []NotificationTriggerConfig `json:"nodeTriggers"`
+	// Notifications sent on task execution phase changes, regardless of the execution's own notifications.
+	TaskTriggers []NotificationTriggerConfig `json:"taskTriggers"`
 	// Number of times to attempt recreating a notifications processor client should there be any disruptions.
 	ReconnectAttempts int `json:"reconnectAttempts"`
 	// Specifies the time interval to wait before attempting to reconnect the notifications processor client.
@@ -565,6 +951,8 @@ type DomainsConfig = []Domain
 // Defines the interface to return top-level config structs necessary to start up a flyteadmin application.
 type ApplicationConfiguration interface {
 	GetDbConfig() *database.DbConfig
+	GetDbReadReplicasConfig() *DbReadReplicasConfig
+	GetDbPartitioningConfig() *DbPartitioningConfig
 	GetTopLevelConfig() *ApplicationConfig
 	GetSchedulerConfig() *SchedulerConfig
 	GetRemoteDataConfig() *RemoteDataConfig
@@ -44,6 +44,9 @@ type ClusterResourceConfig struct {
 	*/
 	CustomData           map[DomainName]TemplateData `json:"customData"`
 	StandaloneDeployment bool                        `json:"standaloneDeployment" pflag:", Whether the cluster resource sync is running in a standalone deployment and should call flyteadmin service endpoints"`
+	// Whether the namespaces of archived projects are deleted, along with all the resources in them. Unarchiving a
+	// project creates its namespaces anew from the templates.
+	DeleteArchivedNamespaces bool `json:"deleteArchivedNamespaces" pflag:", Whether the cluster resource sync deletes the namespaces of archived projects"`
 }
 
 type ClusterResourceConfiguration interface {
@@ -52,4 +55,5 @@ type ClusterResourceConfiguration interface {
 	GetRefreshInterval() time.Duration
 	GetCustomTemplateData() map[DomainName]TemplateData
 	IsStandaloneDeployment() bool
+	ShouldDeleteArchivedNamespaces() bool
 }
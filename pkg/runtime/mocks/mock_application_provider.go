@@ -7,6 +7,8 @@ import (
 
 type MockApplicationProvider struct {
 	dbConfig             database.DbConfig
+	dbReadReplicasConfig interfaces.DbReadReplicasConfig
+	dbPartitioningConfig interfaces.DbPartitioningConfig
 	topLevelConfig       interfaces.ApplicationConfig
 	schedulerConfig      interfaces.SchedulerConfig
 	remoteDataConfig     interfaces.RemoteDataConfig
@@ -24,6 +26,22 @@ func (p *MockApplicationProvider) SetDbConfig(dbConfig database.DbConfig) {
 	p.dbConfig = dbConfig
 }
 
+func (p *MockApplicationProvider) GetDbReadReplicasConfig() *interfaces.DbReadReplicasConfig {
+	return &p.dbReadReplicasConfig
+}
+
+func (p *MockApplicationProvider) SetDbReadReplicasConfig(dbReadReplicasConfig interfaces.DbReadReplicasConfig) {
+	p.dbReadReplicasConfig = dbReadReplicasConfig
+}
+
+func (p *MockApplicationProvider) GetDbPartitioningConfig() *interfaces.DbPartitioningConfig {
+	return &p.dbPartitioningConfig
+}
+
+func (p *MockApplicationProvider) SetDbPartitioningConfig(dbPartitioningConfig interfaces.DbPartitioningConfig) {
+	p.dbPartitioningConfig = dbPartitioningConfig
+}
+
 func (p *MockApplicationProvider) GetTopLevelConfig() *interfaces.ApplicationConfig {
 	return &p.topLevelConfig
 }
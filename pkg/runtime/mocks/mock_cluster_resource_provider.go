@@ -7,11 +7,12 @@ import (
 )
 
 type MockClusterResourceConfiguration struct {
-	TemplatePath         string
-	TemplateData         interfaces.TemplateData
-	RefreshInterval      time.Duration
-	CustomTemplateData   map[interfaces.DomainName]interfaces.TemplateData
-	StandaloneDeployment bool
+	TemplatePath             string
+	TemplateData             interfaces.TemplateData
+	RefreshInterval          time.Duration
+	CustomTemplateData       map[interfaces.DomainName]interfaces.TemplateData
+	StandaloneDeployment     bool
+	DeleteArchivedNamespaces bool
 }
 
 func (c MockClusterResourceConfiguration) GetTemplatePath() string {
@@ -33,6 +34,10 @@ func (c MockClusterResourceConfiguration) IsStandaloneDeployment() bool {
 	return c.StandaloneDeployment
 }
 
+func (c MockClusterResourceConfiguration) ShouldDeleteArchivedNamespaces() bool {
+	return c.DeleteArchivedNamespaces
+}
+
 func NewMockClusterResourceConfiguration() interfaces.ClusterResourceConfiguration {
 	return &MockClusterResourceConfiguration{}
 }
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+// The backfills of scheduled launch plans are served by these endpoints:
+//
+//	POST /api/v1/backfills
+//	GET /api/v1/backfills/{project}/{domain}/{name}
+//	DELETE /api/v1/backfills/{project}/{domain}/{name}
+//
+// Backfills are created from a JSON interfaces.BackfillCreateRequest, with RFC 3339 start and end times. Deleting a
+// backfill cancels it and terminates its running executions, it can still be described afterwards.
+const (
+	backfillsPath = "/api/v1/backfills"
+	backfillPath  = backfillsPath + "/"
+)
+
+func parseBackfillIdentifier(r *http.Request) (interfaces.BackfillIdentifier, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, backfillPath), "/"), "/")
+	if len(segments) != 3 {
+		return interfaces.BackfillIdentifier{}, fmt.Errorf("expected a project, domain and name in path %s",
+			r.URL.Path)
+	}
+	return interfaces.BackfillIdentifier{
+		Project: segments[0],
+		Domain:  segments[1],
+		Name:    segments[2],
+	}, nil
+}
+
+func getBackfillCreateHandler(backfillManager interfaces.BackfillInterface, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequestMethod(w, r, http.MethodPost, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		var request interfaces.BackfillCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, fmt.Sprintf("invalid backfill: %v", err))
+			return
+		}
+		backfill, err := backfillManager.CreateBackfill(requestCtx, request)
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, backfill)
+	}
+}
+
+func getBackfillHandler(backfillManager interfaces.BackfillInterface, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		method := http.MethodGet
+		if r.Method == http.MethodDelete {
+			method = http.MethodDelete
+		}
+		requestCtx, ok := authorizeJSONRequestMethod(w, r, method, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		id, err := parseBackfillIdentifier(r)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var backfill *interfaces.Backfill
+		if method == http.MethodDelete {
+			backfill, err = backfillManager.CancelBackfill(requestCtx, id)
+		} else {
+			backfill, err = backfillManager.GetBackfill(requestCtx, id)
+		}
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, backfill)
+	}
+}
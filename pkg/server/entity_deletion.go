@@ -0,0 +1,73 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Task, workflow and launch plan versions are hard deleted by this endpoint:
+//
+//	DELETE /api/v1/entity_versions/{resource_type}/{project}/{domain}/{name}/{version}[?reason={reason}]
+//
+// Resource types are named as in core.ResourceType, e.g. TASK or launch_plan.
+const entityVersionsPath = "/api/v1/entity_versions/"
+
+func parseEntityDeleteRequest(r *http.Request) (interfaces.EntityDeleteRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, entityVersionsPath), "/"), "/")
+	if len(segments) != 5 {
+		return interfaces.EntityDeleteRequest{}, fmt.Errorf(
+			"expected a resource type, project, domain, name and version in path %s", r.URL.Path)
+	}
+	resourceType, ok := core.ResourceType_value[strings.ToUpper(segments[0])]
+	if !ok {
+		return interfaces.EntityDeleteRequest{}, fmt.Errorf("invalid resource type %s", segments[0])
+	}
+	return interfaces.EntityDeleteRequest{
+		ID: core.Identifier{
+			ResourceType: core.ResourceType(resourceType),
+			Project:      segments[1],
+			Domain:       segments[2],
+			Name:         segments[3],
+			Version:      segments[4],
+		},
+		Reason: r.URL.Query().Get("reason"),
+	}, nil
+}
+
+func getEntityDeletionHandler(adminServer *adminservice.AdminService, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequestMethod(w, r, http.MethodDelete, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		request, err := parseEntityDeleteRequest(r)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		switch request.ID.ResourceType {
+		case core.ResourceType_TASK:
+			err = adminServer.TaskManager.DeleteTask(requestCtx, request)
+		case core.ResourceType_WORKFLOW:
+			err = adminServer.WorkflowManager.DeleteWorkflow(requestCtx, request)
+		case core.ResourceType_LAUNCH_PLAN:
+			err = adminServer.LaunchPlanManager.DeleteLaunchPlan(requestCtx, request)
+		default:
+			writeJSONError(requestCtx, w, http.StatusBadRequest,
+				fmt.Sprintf("%s versions can't be deleted", request.ID.ResourceType))
+			return
+		}
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, struct{}{})
+	}
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+// Statistics of executions are aggregated by this endpoint:
+//
+//	GET /api/v1/execution_aggregates/{project}/{domain}[?group_by={dimension}...][&time_bucket={bucket}]
+//
+// It also accepts the limit and filters query parameters of list endpoints, where the limit bounds the number of
+// groups returned.
+const executionAggregatesPath = "/api/v1/execution_aggregates/"
+
+const defaultExecutionAggregatesLimit = 1000
+
+func parseExecutionAggregationRequest(r *http.Request) (interfaces.ExecutionAggregationRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, executionAggregatesPath), "/"), "/")
+	if len(segments) != 2 {
+		return interfaces.ExecutionAggregationRequest{}, fmt.Errorf("expected a project and domain in path %s",
+			r.URL.Path)
+	}
+	query := r.URL.Query()
+	request := interfaces.ExecutionAggregationRequest{
+		Project:    segments[0],
+		Domain:     segments[1],
+		Filters:    query.Get("filters"),
+		GroupBy:    query["group_by"],
+		TimeBucket: query.Get("time_bucket"),
+		Limit:      defaultExecutionAggregatesLimit,
+	}
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return interfaces.ExecutionAggregationRequest{}, fmt.Errorf("invalid limit %s", limit)
+		}
+		request.Limit = uint32(parsed)
+	}
+	return request, nil
+}
+
+func getExecutionAggregatesHandler(executionManager interfaces.ExecutionInterface, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequest(w, r, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		request, err := parseExecutionAggregationRequest(r)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		aggregates, err := executionManager.AggregateExecutions(requestCtx, request)
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, aggregates)
+	}
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Execution events are listed by these endpoints:
+//
+//	GET /api/v1/execution_events/{project}/{domain}/{name}
+//	GET /api/v1/node_execution_events/{project}/{domain}/{name}[/{node_id}]
+//
+// Both accept the limit, token, filters, sort_by.key and sort_by.direction query parameters of other list endpoints.
+const (
+	executionEventsPath     = "/api/v1/execution_events/"
+	nodeExecutionEventsPath = "/api/v1/node_execution_events/"
+)
+
+const defaultExecutionEventsLimit = 100
+
+type listExecutionEventsFunc func(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error)
+
+// Parses the execution events request from the path following the endpoint prefix and the query parameters.
+func parseExecutionEventListRequest(r *http.Request, prefix string, allowNodeID bool) (
+	interfaces.ExecutionEventListRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/"), "/")
+	if len(segments) != 3 && !(allowNodeID && len(segments) == 4) {
+		return interfaces.ExecutionEventListRequest{}, fmt.Errorf("expected an execution identifier in path %s",
+			r.URL.Path)
+	}
+	request := interfaces.ExecutionEventListRequest{
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: segments[0],
+			Domain:  segments[1],
+			Name:    segments[2],
+		},
+		Limit:   defaultExecutionEventsLimit,
+		Token:   r.URL.Query().Get("token"),
+		Filters: r.URL.Query().Get("filters"),
+	}
+	if len(segments) == 4 {
+		request.NodeID = segments[3]
+	}
+	if limit := r.URL.Query().Get("limit"); len(limit) > 0 {
+		parsed, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return interfaces.ExecutionEventListRequest{}, fmt.Errorf("invalid limit %s", limit)
+		}
+		request.Limit = uint32(parsed)
+	}
+	if key := r.URL.Query().Get("sort_by.key"); len(key) > 0 {
+		request.SortBy = &admin.Sort{Key: key, Direction: admin.Sort_ASCENDING}
+		if direction := r.URL.Query().Get("sort_by.direction"); len(direction) > 0 {
+			value, ok := admin.Sort_Direction_value[strings.ToUpper(direction)]
+			if !ok {
+				return interfaces.ExecutionEventListRequest{}, fmt.Errorf("invalid sort direction %s", direction)
+			}
+			request.SortBy.Direction = admin.Sort_Direction(value)
+		}
+	}
+	return request, nil
+}
+
+func getExecutionEventsHandler(prefix string, allowNodeID bool, list listExecutionEventsFunc,
+	useAuth bool, authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequest(w, r, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		request, err := parseExecutionEventListRequest(r, prefix, allowNodeID)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		events, err := list(requestCtx, request)
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, events)
+	}
+}
@@ -40,10 +40,22 @@ func withDB(ctx context.Context, do func(db *gorm.DB) error) error {
 	return do(db)
 }
 
+// Returns the migrations to run, including the opt-in partitioning of the execution tables when it's enabled.
+func getMigrations() []*gormigrate.Migration {
+	configuration := runtime.NewConfigurationProvider()
+	partitioningConfig := configuration.ApplicationConfiguration().GetDbPartitioningConfig()
+	if !partitioningConfig.Enabled {
+		return config.Migrations
+	}
+	migrations := make([]*gormigrate.Migration, 0, len(config.Migrations)+len(config.PartitionedTables))
+	migrations = append(migrations, config.Migrations...)
+	return append(migrations, config.GetPartitionMigrations(partitioningConfig.FutureMonths)...)
+}
+
 // Migrate runs all configured migrations
 func Migrate(ctx context.Context) error {
 	return withDB(ctx, func(db *gorm.DB) error {
-		m := gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations)
+		m := gormigrate.New(db, gormigrate.DefaultOptions, getMigrations())
 		if err := m.Migrate(); err != nil {
 			return fmt.Errorf("database migration failed: %v", err)
 		}
@@ -55,7 +67,7 @@ func Migrate(ctx context.Context) error {
 // Rollback rolls back the last migration
 func Rollback(ctx context.Context) error {
 	return withDB(ctx, func(db *gorm.DB) error {
-		m := gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations)
+		m := gormigrate.New(db, gormigrate.DefaultOptions, getMigrations())
 		err := m.RollbackLast()
 		if err != nil {
 			return fmt.Errorf("could not rollback latest migration: %v", err)
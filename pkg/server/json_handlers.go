@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// flyteidl has no messages for some of the requests admin serves, e.g. searching entities or backfilling schedules. So
+// they're served as JSON by HTTP endpoints alongside the gRPC gateway rather than through it. Each endpoint is
+// documented where its handler is defined.
+
+type newJSONHandlerFunc func(adminServer *adminservice.AdminService, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc
+
+// The endpoints serving JSON, by path.
+var jsonHandlers = map[string]newJSONHandlerFunc{
+	executionEventsPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getExecutionEventsHandler(executionEventsPath, false, adminServer.ExecutionManager.ListExecutionEvents,
+			useAuth, authCtx)
+	},
+	nodeExecutionEventsPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getExecutionEventsHandler(nodeExecutionEventsPath, true,
+			adminServer.NodeExecutionManager.ListNodeExecutionEvents, useAuth, authCtx)
+	},
+	searchPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getSearchHandler(adminServer.SearchManager, useAuth, authCtx)
+	},
+	executionAggregatesPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getExecutionAggregatesHandler(adminServer.ExecutionManager, useAuth, authCtx)
+	},
+	entityVersionsPath: getEntityDeletionHandler,
+	launchPlanSchedulesPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getLaunchPlanSchedulesHandler(adminServer.LaunchPlanManager, useAuth, authCtx)
+	},
+	backfillsPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getBackfillCreateHandler(adminServer.BackfillManager, useAuth, authCtx)
+	},
+	backfillPath: func(adminServer *adminservice.AdminService, useAuth bool,
+		authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+		return getBackfillHandler(adminServer.BackfillManager, useAuth, authCtx)
+	},
+}
+
+// registerJSONHandlers returns the handlers of the endpoints serving JSON, by path.
+func registerJSONHandlers(adminServer *adminservice.AdminService, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) map[string]http.HandlerFunc {
+	handlers := make(map[string]http.HandlerFunc, len(jsonHandlers))
+	for path, newHandler := range jsonHandlers {
+		handlers[path] = newHandler(adminServer, useAuth, authCtx)
+	}
+	return handlers
+}
+
+func writeJSONError(ctx context.Context, w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+		logger.Errorf(ctx, "failed to write error response, error: %v", err)
+	}
+}
+
+// Writes the error returned by a manager with the HTTP status of its code.
+func writeJSONManagerError(ctx context.Context, w http.ResponseWriter, err error) {
+	statusCode := http.StatusInternalServerError
+	if flyteAdminError, ok := err.(flyteAdminErrors.FlyteAdminError); ok {
+		statusCode = runtime.HTTPStatusFromCode(flyteAdminError.Code())
+	}
+	writeJSONError(ctx, w, statusCode, err.Error())
+}
+
+func writeJSONResponse(ctx context.Context, w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Errorf(ctx, "failed to write response, error: %v", err)
+	}
+}
+
+// Returns the context of a GET request along with whether it may be served, having written the error response
+// otherwise.
+func authorizeJSONRequest(w http.ResponseWriter, r *http.Request, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) (context.Context, bool) {
+	return authorizeJSONRequestMethod(w, r, http.MethodGet, useAuth, authCtx)
+}
+
+// Returns the context of a request using the HTTP method along with whether it may be served, having written the
+// error response otherwise.
+func authorizeJSONRequestMethod(w http.ResponseWriter, r *http.Request, method string, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) (context.Context, bool) {
+	requestCtx := GetOrGenerateRequestIDForRequest(r)
+	if r.Method != method {
+		writeJSONError(requestCtx, w, http.StatusMethodNotAllowed, fmt.Sprintf("only %s is supported", method))
+		return requestCtx, false
+	}
+	if !useAuth {
+		return requestCtx, true
+	}
+	identityContext, err := auth.IdentityContextFromRequest(requestCtx, r, authCtx)
+	if err != nil {
+		writeJSONError(requestCtx, w, http.StatusUnauthorized, err.Error())
+		return requestCtx, false
+	}
+	if !identityContext.Scopes().Has(auth.ScopeAll) {
+		writeJSONError(requestCtx, w, http.StatusForbidden, "authenticated user doesn't have required scope")
+		return requestCtx, false
+	}
+	return identityContext.WithContext(requestCtx), true
+}
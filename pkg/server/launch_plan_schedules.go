@@ -0,0 +1,84 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The native scheduler schedules of launch plans are described by this endpoint:
+//
+//	GET /api/v1/launch_plan_schedules/{project}/{domain}/{name}[/{version}][?next={count}][&history={duration}]
+//
+// The schedule of the active launch plan version is described when the version is omitted. It returns the next count
+// scheduled times and the executions scheduled within the history duration, e.g. 24h.
+const launchPlanSchedulesPath = "/api/v1/launch_plan_schedules/"
+
+const (
+	defaultLaunchPlanScheduleNextCount     = 10
+	defaultLaunchPlanScheduleHistoryWindow = 24 * time.Hour
+)
+
+func parseLaunchPlanScheduleRequest(r *http.Request) (interfaces.LaunchPlanScheduleRequest, error) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, launchPlanSchedulesPath), "/"), "/")
+	if len(segments) != 3 && len(segments) != 4 {
+		return interfaces.LaunchPlanScheduleRequest{}, fmt.Errorf(
+			"expected a project, domain, name and optionally version in path %s", r.URL.Path)
+	}
+	request := interfaces.LaunchPlanScheduleRequest{
+		ID: core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      segments[0],
+			Domain:       segments[1],
+			Name:         segments[2],
+		},
+		NextCount:     defaultLaunchPlanScheduleNextCount,
+		HistoryWindow: defaultLaunchPlanScheduleHistoryWindow,
+	}
+	if len(segments) == 4 {
+		request.ID.Version = segments[3]
+	}
+	query := r.URL.Query()
+	if next := query.Get("next"); len(next) > 0 {
+		parsed, err := strconv.Atoi(next)
+		if err != nil {
+			return interfaces.LaunchPlanScheduleRequest{}, fmt.Errorf("invalid next %s", next)
+		}
+		request.NextCount = parsed
+	}
+	if history := query.Get("history"); len(history) > 0 {
+		parsed, err := time.ParseDuration(history)
+		if err != nil {
+			return interfaces.LaunchPlanScheduleRequest{}, fmt.Errorf("invalid history %s", history)
+		}
+		request.HistoryWindow = parsed
+	}
+	return request, nil
+}
+
+func getLaunchPlanSchedulesHandler(launchPlanManager interfaces.LaunchPlanInterface, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequest(w, r, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		request, err := parseLaunchPlanScheduleRequest(r)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		schedule, err := launchPlanManager.GetLaunchPlanSchedule(requestCtx, request)
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, schedule)
+	}
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"gorm.io/gorm"
+)
+
+// ListNotificationDeadLetters returns the dead-lettered notifications which weren't replayed yet, oldest first.
+func ListNotificationDeadLetters(ctx context.Context, limit int) ([]models.NotificationDeadLetter, error) {
+	var deadLetters []models.NotificationDeadLetter
+	err := withDB(ctx, func(db *gorm.DB) error {
+		configuration := runtime.NewConfigurationProvider()
+		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().GetMetricsScope()).
+			NewSubScope("notifications_dead_letters")
+		repo := repositories.NewGormRepo(db, errors.NewPostgresErrorTransformer(scope.NewSubScope("errors")), scope)
+		var err error
+		deadLetters, err = repo.NotificationDeadLetterRepo().ListPending(ctx, limit)
+		return err
+	})
+	return deadLetters, err
+}
+
+// ReplayNotificationDeadLetters republishes the given dead-lettered notifications with the configured notifications
+// publisher. When no ids are given, up to limit pending notifications are replayed.
+func ReplayNotificationDeadLetters(ctx context.Context, ids []uint, limit int) error {
+	return withDB(ctx, func(db *gorm.DB) error {
+		configuration := runtime.NewConfigurationProvider()
+		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().GetMetricsScope()).
+			NewSubScope("notifications_dead_letters")
+		repo := repositories.NewGormRepo(db, errors.NewPostgresErrorTransformer(scope.NewSubScope("errors")), scope)
+		if len(ids) == 0 {
+			deadLetters, err := repo.NotificationDeadLetterRepo().ListPending(ctx, limit)
+			if err != nil {
+				return err
+			}
+			for _, deadLetter := range deadLetters {
+				// Notifications which couldn't be decoded can't be replayed.
+				if len(deadLetter.Message) > 0 {
+					ids = append(ids, deadLetter.ID)
+				}
+			}
+		}
+
+		publisher := notifications.NewNotificationsPublisher(
+			*configuration.ApplicationConfiguration().GetNotificationsConfig(), scope)
+		for _, id := range ids {
+			if err := implementations.ReplayDeadLetter(ctx, repo.NotificationDeadLetterRepo(), publisher, id); err != nil {
+				return fmt.Errorf("failed to replay dead-lettered notification [%d]: %w", id, err)
+			}
+		}
+		logger.Infof(ctx, "Successfully replayed %d dead-lettered notifications", len(ids))
+		return nil
+	})
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/retention/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/async/retention/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"gorm.io/gorm"
+)
+
+// Purge deletes the executions past the retention period of the configured retention policies, and then maintains the
+// partitions of the execution tables when they're partitioned. When dryRun is set nothing is changed, and the report
+// and partitions describe what would be.
+func Purge(ctx context.Context, dryRun bool) (
+	interfaces.PurgeReport, repositoryInterfaces.PartitionMaintenanceOutput, error) {
+	var report interfaces.PurgeReport
+	var partitions repositoryInterfaces.PartitionMaintenanceOutput
+	err := withDB(ctx, func(db *gorm.DB) error {
+		configuration := runtime.NewConfigurationProvider()
+		applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
+		scope := promutils.NewScope(applicationConfiguration.GetMetricsScope()).NewSubScope("purger")
+		repo := repositories.NewGormRepo(db, errors.NewPostgresErrorTransformer(scope.NewSubScope("errors")), scope)
+		dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+		if err != nil {
+			return err
+		}
+		purger := implementations.NewPurger(repo, dataStorageClient, applicationConfiguration.GetMetadataStoragePrefix(),
+			applicationConfiguration.GetRetentionConfig(), scope)
+		// Executions are purged under the lock of the purger loop, so that the purge doesn't race a running admin's.
+		acquired, err := repo.LockRepo().RunExclusively(ctx, implementations.PurgerLockName,
+			func(ctx context.Context) error {
+				var err error
+				report, err = purger.Purge(ctx, dryRun)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			return fmt.Errorf("another instance is purging executions, retry once it's done")
+		}
+		partitioningConfig := configuration.ApplicationConfiguration().GetDbPartitioningConfig()
+		if !partitioningConfig.Enabled {
+			return nil
+		}
+		partitionMaintainer := implementations.NewPartitionMaintainer(repo, *partitioningConfig,
+			scope.NewSubScope("partitions"))
+		acquired, err = repo.LockRepo().RunExclusively(ctx, implementations.PartitionMaintainerLockName,
+			func(ctx context.Context) error {
+				var err error
+				partitions, err = partitionMaintainer.Maintain(ctx, dryRun)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			return fmt.Errorf("another instance is maintaining partitions, retry once it's done")
+		}
+		return nil
+	})
+	return report, partitions, err
+}
+
+// RestoreArchive restores the executions of an archive file written when purging executions, all of them unless names
+// are given. Returns the number of executions restored.
+func RestoreArchive(ctx context.Context, reference storage.DataReference, names []string) (int, error) {
+	var restored int
+	err := withDB(ctx, func(db *gorm.DB) error {
+		configuration := runtime.NewConfigurationProvider()
+		applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
+		scope := promutils.NewScope(applicationConfiguration.GetMetricsScope()).NewSubScope("archiver")
+		repo := repositories.NewGormRepo(db, errors.NewPostgresErrorTransformer(scope.NewSubScope("errors")), scope)
+		dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+		if err != nil {
+			return err
+		}
+		archiver := implementations.NewArchiver(repo, dataStorageClient,
+			applicationConfiguration.GetRetentionConfig().Archive.StoragePrefix, scope)
+		restored, err = archiver.Restore(ctx, reference, names)
+		return err
+	})
+	return restored, err
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	authInterfaces "github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Registered entities are searched by this endpoint:
+//
+//	GET /api/v1/search?query={query}[&resource_type={type}...][&project={project}[&domain={domain}]]
+//
+// It also accepts the limit and token query parameters of list endpoints. Resource types are named as in
+// core.ResourceType, e.g. TASK or launch_plan.
+const searchPath = "/api/v1/search"
+
+const defaultSearchLimit = 20
+
+func parseSearchRequest(r *http.Request) (interfaces.SearchRequest, error) {
+	query := r.URL.Query()
+	request := interfaces.SearchRequest{
+		Query:   query.Get("query"),
+		Project: query.Get("project"),
+		Domain:  query.Get("domain"),
+		Limit:   defaultSearchLimit,
+		Token:   query.Get("token"),
+	}
+	for _, resourceType := range query["resource_type"] {
+		value, ok := core.ResourceType_value[strings.ToUpper(resourceType)]
+		if !ok {
+			return interfaces.SearchRequest{}, fmt.Errorf("invalid resource type %s", resourceType)
+		}
+		request.ResourceTypes = append(request.ResourceTypes, core.ResourceType(value))
+	}
+	if limit := query.Get("limit"); len(limit) > 0 {
+		parsed, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return interfaces.SearchRequest{}, fmt.Errorf("invalid limit %s", limit)
+		}
+		request.Limit = uint32(parsed)
+	}
+	return request, nil
+}
+
+func getSearchHandler(searchManager interfaces.SearchInterface, useAuth bool,
+	authCtx authInterfaces.AuthenticationContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCtx, ok := authorizeJSONRequest(w, r, useAuth, authCtx)
+		if !ok {
+			return
+		}
+		request, err := parseSearchRequest(r)
+		if err != nil {
+			writeJSONError(requestCtx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+		results, err := searchManager.Search(requestCtx, request)
+		if err != nil {
+			writeJSONManagerError(requestCtx, w, err)
+			return
+		}
+		writeJSONResponse(requestCtx, w, results)
+	}
+}
@@ -46,7 +46,10 @@ import (
 
 var defaultCorsHeaders = []string{"Content-Type"}
 
-// Serve starts a server and blocks the calling goroutine
+// How long in-flight requests are given to complete on shutdown.
+const shutdownTimeout = 30 * time.Second
+
+// Serve starts a server and blocks the calling goroutine until ctx is done and the server has shut down
 func Serve(ctx context.Context, pluginRegistry *plugins.Registry, additionalHandlers map[string]func(http.ResponseWriter, *http.Request)) error {
 	serverConfig := config.GetConfig()
 	configuration := runtime2.NewConfigurationProvider()
@@ -74,7 +77,7 @@ func SetMetricKeys(appConfig *runtimeIfaces.ApplicationConfig) {
 // Creates a new gRPC Server with all the configuration
 func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *config.ServerConfig,
 	storageCfg *storage.Config, authCtx interfaces.AuthenticationContext,
-	scope promutils.Scope, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	scope promutils.Scope, opts ...grpc.ServerOption) (*grpc.Server, *adminservice.AdminService, error) {
 
 	logger.Infof(ctx, "Registering default middleware with blanket auth validation")
 	pluginRegistry.RegisterDefault(plugins.PluginIDUnaryServiceMiddleware, grpcmiddleware.ChainUnaryServer(
@@ -122,7 +125,7 @@ func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 
 	dataProxySvc, err := dataproxy.NewService(cfg.DataProxy, adminServer.NodeExecutionManager, dataStorageClient, adminServer.TaskExecutionManager)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize dataProxy service. Error: %w", err)
+		return nil, nil, fmt.Errorf("failed to initialize dataProxy service. Error: %w", err)
 	}
 
 	pluginRegistry.RegisterDefault(plugins.PluginIDDataProxy, dataProxySvc)
@@ -137,7 +140,26 @@ func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 		reflection.Register(grpcServer)
 	}
 
-	return grpcServer, nil
+	return grpcServer, adminServer, nil
+}
+
+// Once ctx is done, stops serving and waits for the events queued by the admin service to be persisted, so that none
+// are lost when the process exits.
+func shutdownOnDone(ctx context.Context, httpServer *http.Server, grpcServer *grpc.Server,
+	adminServer *adminservice.AdminService) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		logger.Infof(context.Background(), "Shutting down Flyte Admin")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Warnf(context.Background(), "Failed to gracefully shut down the HTTP server. Error: %v", err)
+		}
+		grpcServer.GracefulStop()
+		done <- adminServer.Close(context.Background())
+	}()
+	return done
 }
 
 func GetHandleOpenapiSpec(ctx context.Context) http.HandlerFunc {
@@ -161,7 +183,7 @@ func healthCheckFunc(w http.ResponseWriter, _ *http.Request) {
 }
 
 func newHTTPServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *config.ServerConfig, _ *authConfig.Config, authCtx interfaces.AuthenticationContext,
-	additionalHandlers map[string]func(http.ResponseWriter, *http.Request),
+	additionalHandlers map[string]func(http.ResponseWriter, *http.Request), jsonHandlers map[string]http.HandlerFunc,
 	grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
 
 	// Register the server that will serve HTTP/REST Traffic
@@ -171,6 +193,9 @@ func newHTTPServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 	for p, f := range additionalHandlers {
 		mux.HandleFunc(p, f)
 	}
+	for p, f := range jsonHandlers {
+		mux.HandleFunc(p, f)
+	}
 
 	// Register healthcheck
 	mux.HandleFunc("/healthcheck", healthCheckFunc)
@@ -316,7 +341,7 @@ func serveGatewayInsecure(ctx context.Context, pluginRegistry *plugins.Registry,
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, pluginRegistry, cfg, storageConfig, authCtx, scope)
+	grpcServer, adminServer, err := newGRPCServer(ctx, pluginRegistry, cfg, storageConfig, authCtx, scope)
 	if err != nil {
 		return fmt.Errorf("failed to create a newGRPCServer. Error: %w", err)
 	}
@@ -328,8 +353,10 @@ func serveGatewayInsecure(ctx context.Context, pluginRegistry *plugins.Registry,
 	}
 
 	go func() {
-		err := grpcServer.Serve(lis)
-		logger.Fatalf(ctx, "Failed to create GRPC Server, Err: ", err)
+		// Serve only returns without an error once the server is stopped on shutdown.
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Fatalf(ctx, "Failed to create GRPC Server, Err: ", err)
+		}
 	}()
 
 	logger.Infof(ctx, "Starting HTTP/1 Gateway server on %s", cfg.GetHostAddress())
@@ -341,7 +368,8 @@ func serveGatewayInsecure(ctx context.Context, pluginRegistry *plugins.Registry,
 		grpcOptions = append(grpcOptions,
 			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.GrpcConfig.MaxMessageSizeBytes)))
 	}
-	httpServer, err := newHTTPServer(ctx, pluginRegistry, cfg, authCfg, authCtx, additionalHandlers, cfg.GetGrpcHostAddress(), grpcOptions...)
+	jsonHandlers := registerJSONHandlers(adminServer, cfg.Security.UseAuth, authCtx)
+	httpServer, err := newHTTPServer(ctx, pluginRegistry, cfg, authCfg, authCtx, additionalHandlers, jsonHandlers, cfg.GetGrpcHostAddress(), grpcOptions...)
 	if err != nil {
 		return err
 	}
@@ -364,12 +392,13 @@ func serveGatewayInsecure(ctx context.Context, pluginRegistry *plugins.Registry,
 		ReadHeaderTimeout: time.Duration(cfg.ReadHeaderTimeoutSeconds) * time.Second,
 	}
 
+	shutdownErr := shutdownOnDone(ctx, server, grpcServer, adminServer)
 	err = server.ListenAndServe()
-	if err != nil {
+	if err != http.ErrServerClosed {
 		return errors.Wrapf(err, "failed to Start HTTP Server")
 	}
 
-	return nil
+	return <-shutdownErr
 }
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
@@ -426,7 +455,7 @@ func serveGatewaySecure(ctx context.Context, pluginRegistry *plugins.Registry, c
 		}
 	}
 
-	grpcServer, err := newGRPCServer(ctx, pluginRegistry, cfg, storageCfg, authCtx, scope, grpc.Creds(credentials.NewServerTLSFromCert(cert)))
+	grpcServer, adminServer, err := newGRPCServer(ctx, pluginRegistry, cfg, storageCfg, authCtx, scope, grpc.Creds(credentials.NewServerTLSFromCert(cert)))
 	if err != nil {
 		return fmt.Errorf("failed to create a newGRPCServer. Error: %w", err)
 	}
@@ -444,7 +473,8 @@ func serveGatewaySecure(ctx context.Context, pluginRegistry *plugins.Registry, c
 		serverOpts = append(serverOpts,
 			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.GrpcConfig.MaxMessageSizeBytes)))
 	}
-	httpServer, err := newHTTPServer(ctx, pluginRegistry, cfg, authCfg, authCtx, additionalHandlers, cfg.GetHostAddress(), serverOpts...)
+	jsonHandlers := registerJSONHandlers(adminServer, cfg.Security.UseAuth, authCtx)
+	httpServer, err := newHTTPServer(ctx, pluginRegistry, cfg, authCfg, authCtx, additionalHandlers, jsonHandlers, cfg.GetHostAddress(), serverOpts...)
 	if err != nil {
 		return err
 	}
@@ -465,10 +495,11 @@ func serveGatewaySecure(ctx context.Context, pluginRegistry *plugins.Registry, c
 		ReadHeaderTimeout: time.Duration(cfg.ReadHeaderTimeoutSeconds) * time.Second,
 	}
 
+	shutdownErr := shutdownOnDone(ctx, srv, grpcServer, adminServer)
 	err = srv.Serve(tls.NewListener(conn, srv.TLSConfig))
 
-	if err != nil {
+	if err != http.ErrServerClosed {
 		return errors.Wrapf(err, "failed to Start HTTP/2 Server")
 	}
-	return nil
+	return <-shutdownErr
 }
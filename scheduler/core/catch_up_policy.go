@@ -0,0 +1,88 @@
+package core
+
+import (
+	"fmt"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+// Policies for a launch plan are the most specific, followed by those for a project and then a domain.
+func getCatchUpPolicySpecificity(policy runtimeInterfaces.ScheduleCatchUpPolicy) int {
+	specificity := 0
+	if len(policy.Name) > 0 {
+		specificity += 4
+	}
+	if len(policy.Project) > 0 {
+		specificity += 2
+	}
+	if len(policy.Domain) > 0 {
+		specificity++
+	}
+	return specificity
+}
+
+// GetCatchUpPolicy returns the most specific of the catch-up policies applying to the launch plan, if any.
+func GetCatchUpPolicy(policies []runtimeInterfaces.ScheduleCatchUpPolicy, project, domain,
+	name string) *runtimeInterfaces.ScheduleCatchUpPolicy {
+	var match *runtimeInterfaces.ScheduleCatchUpPolicy
+	for idx, policy := range policies {
+		if (len(policy.Project) > 0 && policy.Project != project) ||
+			(len(policy.Domain) > 0 && policy.Domain != domain) ||
+			(len(policy.Name) > 0 && policy.Name != name) {
+			continue
+		}
+		if match == nil || getCatchUpPolicySpecificity(policy) > getCatchUpPolicySpecificity(*match) {
+			match = &policies[idx]
+		}
+	}
+	return match
+}
+
+// ValidateCatchUpPolicy checks the policy is known and has the settings it requires.
+func ValidateCatchUpPolicy(policy runtimeInterfaces.ScheduleCatchUpPolicy) error {
+	switch policy.Policy {
+	case models.CatchUpAll, models.CatchUpLatest, models.CatchUpNone:
+		return nil
+	case models.CatchUpMaxRuns:
+		if policy.MaxRuns == 0 {
+			return fmt.Errorf("catch-up policy %s requires maxRuns to be set", policy.Policy)
+		}
+		return nil
+	case models.CatchUpMaxAge:
+		if policy.MaxAge.Duration <= 0 {
+			return fmt.Errorf("catch-up policy %s requires maxAge to be set", policy.Policy)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown catch-up policy %q", policy.Policy)
+	}
+}
+
+// ValidateCatchUpPolicies checks each of the configured policies, so that invalid ones fail startup rather than the
+// activation of the launch plans they apply to.
+func ValidateCatchUpPolicies(policies []runtimeInterfaces.ScheduleCatchUpPolicy) error {
+	for _, policy := range policies {
+		if err := ValidateCatchUpPolicy(policy); err != nil {
+			return fmt.Errorf("invalid catch-up policy for project [%s], domain [%s] and name [%s]: %w",
+				policy.Project, policy.Domain, policy.Name, err)
+		}
+	}
+	return nil
+}
+
+// withCatchUpPolicy returns the schedule s with the catch-up policy it was activated with or, when it has none, the
+// default currently configured for its project and domain. Changes to the defaults thus apply to schedules which are
+// already active.
+func withCatchUpPolicy(s models.SchedulableEntity,
+	policies []runtimeInterfaces.ScheduleCatchUpPolicy) models.SchedulableEntity {
+	if len(s.CatchUpPolicy) > 0 {
+		return s
+	}
+	if policy := GetCatchUpPolicy(policies, s.Project, s.Domain, s.Name); policy != nil {
+		s.CatchUpPolicy = policy.Policy
+		s.CatchUpMaxRuns = policy.MaxRuns
+		s.CatchUpMaxAge = policy.MaxAge.Duration
+	}
+	return s
+}
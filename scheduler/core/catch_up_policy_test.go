@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCatchUpPolicy(t *testing.T) {
+	policies := []runtimeInterfaces.ScheduleCatchUpPolicy{
+		{Policy: models.CatchUpNone},
+		{Project: "project", Domain: "domain", Policy: models.CatchUpLatest},
+		{Project: "project", Domain: "domain", Name: "name", Policy: models.CatchUpMaxRuns, MaxRuns: 3},
+		{Project: "other", Policy: models.CatchUpAll},
+	}
+	assert.Equal(t, &policies[2], GetCatchUpPolicy(policies, "project", "domain", "name"))
+	assert.Equal(t, &policies[1], GetCatchUpPolicy(policies, "project", "domain", "other"))
+	assert.Equal(t, &policies[0], GetCatchUpPolicy(policies, "project", "other", "name"))
+	assert.Nil(t, GetCatchUpPolicy(policies[1:], "project", "other", "name"))
+}
+
+func TestValidateCatchUpPolicies(t *testing.T) {
+	assert.Nil(t, ValidateCatchUpPolicies([]runtimeInterfaces.ScheduleCatchUpPolicy{
+		{Policy: models.CatchUpAll},
+		{Policy: models.CatchUpMaxRuns, MaxRuns: 1},
+		{Policy: models.CatchUpMaxAge, MaxAge: config.Duration{Duration: time.Hour}},
+	}))
+	for _, policy := range []runtimeInterfaces.ScheduleCatchUpPolicy{
+		{Policy: "unknown"},
+		{Policy: models.CatchUpMaxRuns},
+		{Policy: models.CatchUpMaxAge},
+	} {
+		err := ValidateCatchUpPolicies([]runtimeInterfaces.ScheduleCatchUpPolicy{{Policy: models.CatchUpNone}, policy})
+		assert.NotNil(t, err)
+	}
+}
+
+func TestWithCatchUpPolicy(t *testing.T) {
+	policies := []runtimeInterfaces.ScheduleCatchUpPolicy{
+		{Project: "project", Policy: models.CatchUpMaxAge, MaxAge: config.Duration{Duration: time.Hour}},
+	}
+	key := models.SchedulableEntityKey{Project: "project", Domain: "domain", Name: "name"}
+	t.Run("stored policy", func(t *testing.T) {
+		s := models.SchedulableEntity{
+			SchedulableEntityKey: key,
+			CatchUpPolicy:        models.CatchUpMaxRuns,
+			CatchUpMaxRuns:       3,
+		}
+		assert.Equal(t, s, withCatchUpPolicy(s, policies))
+	})
+	t.Run("default policy", func(t *testing.T) {
+		resolved := withCatchUpPolicy(models.SchedulableEntity{SchedulableEntityKey: key}, policies)
+		assert.Equal(t, models.CatchUpMaxAge, resolved.CatchUpPolicy)
+		assert.Equal(t, uint32(0), resolved.CatchUpMaxRuns)
+		assert.Equal(t, time.Hour, resolved.CatchUpMaxAge)
+	})
+	t.Run("no policy", func(t *testing.T) {
+		resolved := withCatchUpPolicy(models.SchedulableEntity{SchedulableEntityKey: key}, nil)
+		assert.Empty(t, resolved.CatchUpPolicy)
+	})
+}
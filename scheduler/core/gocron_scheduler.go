@@ -3,9 +3,11 @@ package core
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/executor"
 	"github.com/flyteorg/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
@@ -19,8 +21,8 @@ import (
 	"golang.org/x/time/rate"
 )
 
-// goCronMetrics mertrics recorded for go cron.
-type goCronMetrics struct {
+// GoCronMetrics mertrics recorded for go cron.
+type GoCronMetrics struct {
 	Scope                     promutils.Scope
 	JobFuncPanicCounter       prometheus.Counter
 	JobScheduledFailedCounter prometheus.Counter
@@ -31,10 +33,15 @@ type goCronMetrics struct {
 type GoCronScheduler struct {
 	cron        *cron.Cron
 	jobStore    sync.Map
-	metrics     goCronMetrics
+	metrics     GoCronMetrics
 	rateLimiter *rate.Limiter
 	executor    executor.Executor
 	snapshot    snapshoter.Snapshot
+	// The last execution times of the schedules which were descheduled, or inactive during bootstrap, by name. Schedules
+	// with a catch-up policy catch up from them once reactivated.
+	descheduledExecTimes sync.Map
+	// The configured catch-up policies, which the schedules are resolved against each time they're read.
+	catchUpPolicies []runtimeInterfaces.ScheduleCatchUpPolicy
 }
 
 // GetTimedFuncWithSchedule returns the job function with scheduled time parameter
@@ -54,7 +61,13 @@ func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
 func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, schedules []models.SchedulableEntity,
 	snapshot snapshoter.Snapshot) {
 	for _, s := range schedules {
-		if *s.Active {
+		s = withCatchUpPolicy(s, g.catchUpPolicies)
+		if !*s.Active {
+			nameOfSchedule := identifier.GetScheduleName(ctx, s)
+			if fromSnapshot := snapshot.GetLastExecutionTime(nameOfSchedule); fromSnapshot != nil {
+				g.descheduledExecTimes.Store(nameOfSchedule, fromSnapshot)
+			}
+		} else {
 			funcRef := g.GetTimedFuncWithSchedule()
 			nameOfSchedule := identifier.GetScheduleName(ctx, s)
 			// Initialize the lastExectime as the updatedAt time
@@ -79,16 +92,32 @@ func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, sc
 // UpdateSchedules updates all the schedules in the schedulers job store
 func (g *GoCronScheduler) UpdateSchedules(ctx context.Context, schedules []models.SchedulableEntity) {
 	for _, s := range schedules {
+		s = withCatchUpPolicy(s, g.catchUpPolicies)
 		// Schedule or Deschedule job from the scheduler based on the activation status
 		if !*s.Active {
 			g.DeScheduleJob(ctx, s)
 		} else {
 			// Get the TimedFuncWithSchedule
 			funcRef := g.GetTimedFuncWithSchedule()
-			err := g.ScheduleJob(ctx, s, funcRef, nil)
+			nameOfSchedule := identifier.GetScheduleName(ctx, s)
+			// Schedules reactivated with a catch-up policy catch up on the runs missed while they were inactive
+			var lastExecTime *time.Time
+			if _, ok := g.jobStore.Load(nameOfSchedule); !ok {
+				if val, ok := g.descheduledExecTimes.LoadAndDelete(nameOfSchedule); ok && len(s.CatchUpPolicy) > 0 {
+					lastExecTime = val.(*time.Time)
+				}
+			}
+			err := g.ScheduleJob(ctx, s, funcRef, lastExecTime)
 			if err != nil {
 				g.metrics.JobScheduledFailedCounter.Inc()
 				logger.Errorf(ctx, "unable to register the schedule %+v due to %v", s, err)
+				continue
+			}
+			if lastExecTime != nil {
+				logger.Infof(ctx, "catching up reactivated schedule %+v from %v", s, lastExecTime)
+				if err := g.CatchUpSingleSchedule(ctx, s, *lastExecTime, time.Now()); err != nil {
+					logger.Errorf(ctx, "unable to catch up on reactivated schedule %+v due to %v", s, err)
+				}
 			}
 		}
 	} // Done iterating over all the read schedules
@@ -108,6 +137,46 @@ func (g *GoCronScheduler) CalculateSnapshot(ctx context.Context) snapshoter.Snap
 	return snapshot
 }
 
+// GetScheduleStatuses returns the state of the schedules in the job store, ordered by their identifiers.
+func (g *GoCronScheduler) GetScheduleStatuses(ctx context.Context) []ScheduleStatus {
+	nextTimes := make(map[cron.EntryID]time.Time)
+	for _, entry := range g.cron.Entries() {
+		nextTimes[entry.ID] = entry.Next
+	}
+	var statuses []ScheduleStatus
+	g.jobStore.Range(func(key, value interface{}) bool {
+		job := value.(*GoCronJob)
+		status := ScheduleStatus{
+			Project:           job.schedule.Project,
+			Domain:            job.schedule.Domain,
+			Name:              job.schedule.Name,
+			Version:           job.schedule.Version,
+			CronExpression:    job.schedule.CronExpression,
+			LastExecutionTime: job.lastExecTime,
+		}
+		if len(job.schedule.CronExpression) == 0 {
+			status.FixedRateValue = job.schedule.FixedRateValue
+			status.FixedRateUnit = job.schedule.Unit.String()
+		}
+		if next, ok := nextTimes[job.entryID]; ok && !next.IsZero() {
+			status.NextExecutionTime = &next
+		}
+		statuses = append(statuses, status)
+		return true
+	})
+	sort.Slice(statuses, func(i, j int) bool {
+		left := []string{statuses[i].Project, statuses[i].Domain, statuses[i].Name, statuses[i].Version}
+		right := []string{statuses[j].Project, statuses[j].Domain, statuses[j].Name, statuses[j].Version}
+		for idx := range left {
+			if left[idx] != right[idx] {
+				return left[idx] < right[idx]
+			}
+		}
+		return false
+	})
+	return statuses
+}
+
 // ScheduleJob allows to schedule a job using the implemented scheduler
 func (g *GoCronScheduler) ScheduleJob(ctx context.Context, schedule models.SchedulableEntity,
 	funcWithSchedule TimedFuncWithSchedule, lastExecTime *time.Time) error {
@@ -121,7 +190,8 @@ func (g *GoCronScheduler) ScheduleJob(ctx context.Context, schedule models.Sched
 	}
 
 	// Update the catchupFrom time as the lastExecTime.
-	// Here lastExecTime is passed to this function only from BootStrapSchedulesFromSnapShot which is during bootup
+	// Here lastExecTime is passed to this function only from BootStrapSchedulesFromSnapShot which is during bootup,
+	// or from UpdateSchedules when a schedule with a catch-up policy is reactivated
 	// Once initialized we wont be changing the catchupTime until the next boot
 	job := &GoCronJob{nameOfSchedule: nameOfSchedule, schedule: schedule, funcWithSchedule: funcWithSchedule,
 		catchupFromTime: lastExecTime, lastExecTime: lastExecTime, ctx: ctx}
@@ -157,6 +227,9 @@ func (g *GoCronScheduler) DeScheduleJob(ctx context.Context, schedule models.Sch
 	}
 	val, _ := g.jobStore.Load(nameOfSchedule)
 	jobWrapper := val.(*GoCronJob)
+	if jobWrapper.lastExecTime != nil {
+		g.descheduledExecTimes.Store(nameOfSchedule, jobWrapper.lastExecTime)
+	}
 
 	s := jobWrapper.schedule
 	if len(s.CronExpression) > 0 {
@@ -204,6 +277,13 @@ func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.Sc
 	if err != nil {
 		return err
 	}
+	if missed := len(catchUpTimes); missed > 0 {
+		catchUpTimes = FilterCatchUpTimes(s, catchUpTimes, toTime)
+		if skipped := missed - len(catchUpTimes); skipped > 0 {
+			logger.Infof(ctx, "skipping %v of the %v missed runs of the schedule %+v per its catch-up policy %v",
+				skipped, missed, s, s.CatchUpPolicy)
+		}
+	}
 	var catchupTime time.Time
 	for _, catchupTime = range catchUpTimes {
 		_ = g.rateLimiter.Wait(ctx)
@@ -235,6 +315,111 @@ func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
 	return scheduledTimes, nil
 }
 
+// FilterCatchUpTimes returns the catch up times, in ascending order, of schedule s to be launched according to its
+// catch-up policy. All of them are launched for schedules without a policy.
+func FilterCatchUpTimes(s models.SchedulableEntity, catchUpTimes []time.Time, to time.Time) []time.Time {
+	switch s.CatchUpPolicy {
+	case models.CatchUpNone:
+		return nil
+	case models.CatchUpLatest:
+		return lastCatchUpTimes(catchUpTimes, 1)
+	case models.CatchUpMaxRuns:
+		return lastCatchUpTimes(catchUpTimes, int(s.CatchUpMaxRuns))
+	case models.CatchUpMaxAge:
+		oldest := to.Add(-s.CatchUpMaxAge)
+		for idx, catchUpTime := range catchUpTimes {
+			if !catchUpTime.Before(oldest) {
+				return catchUpTimes[idx:]
+			}
+		}
+		return nil
+	default:
+		return catchUpTimes
+	}
+}
+
+func lastCatchUpTimes(catchUpTimes []time.Time, count int) []time.Time {
+	if len(catchUpTimes) > count {
+		return catchUpTimes[len(catchUpTimes)-count:]
+	}
+	return catchUpTimes
+}
+
+// GetNextScheduledTimes finds the next count times, after now, schedule s is scheduled at. Fixed rate schedules are
+// scheduled at intervals from their lastExecTime when known.
+func GetNextScheduledTimes(s models.SchedulableEntity, lastExecTime *time.Time, now time.Time, count int) (
+	[]time.Time, error) {
+	if count <= 0 {
+		return nil, nil
+	}
+	var next time.Time
+	var err error
+	if len(s.CronExpression) == 0 && lastExecTime != nil {
+		d, err := getFixedRateDurationFromSchedule(s.Unit, s.FixedRateValue)
+		if err != nil {
+			return nil, err
+		}
+		next = getFixedIntervalStartTime(*lastExecTime, d, now)
+	} else if next, err = GetScheduledTime(s, now); err != nil {
+		return nil, err
+	}
+	scheduledTimes := []time.Time{next}
+	for len(scheduledTimes) < count {
+		if next, err = GetScheduledTime(s, next); err != nil {
+			return nil, err
+		}
+		scheduledTimes = append(scheduledTimes, next)
+	}
+	return scheduledTimes, nil
+}
+
+// GetExecutedTimes finds the times, after from, schedule s was executed at through its lastExecTime, in ascending
+// order.
+func GetExecutedTimes(s models.SchedulableEntity, from time.Time, lastExecTime time.Time) ([]time.Time, error) {
+	if len(s.CronExpression) > 0 {
+		return GetCatchUpTimes(s, from, lastExecTime)
+	}
+	// Fixed rate schedules are executed at intervals from their last execution.
+	d, err := getFixedRateDurationFromSchedule(s.Unit, s.FixedRateValue)
+	if err != nil {
+		return nil, err
+	}
+	var executedTimes []time.Time
+	for executedTime := lastExecTime; executedTime.After(from); executedTime = executedTime.Add(-d) {
+		executedTimes = append(executedTimes, executedTime)
+	}
+	for i, j := 0, len(executedTimes)-1; i < j; i, j = i+1, j-1 {
+		executedTimes[i], executedTimes[j] = executedTimes[j], executedTimes[i]
+	}
+	return executedTimes, nil
+}
+
+// GetScheduledTimesInRange finds the times, from through to, schedule s is scheduled at in ascending order. Fixed rate
+// schedules are scheduled at intervals from the start of the range. Fails when there are more than maxCount of them.
+func GetScheduledTimesInRange(s models.SchedulableEntity, from, to time.Time, maxCount int) ([]time.Time, error) {
+	var next time.Time
+	var err error
+	if len(s.CronExpression) > 0 {
+		// Evaluated from just before the range so that its start may be scheduled at.
+		next, err = getCronScheduledTime(s.CronExpression, from.Add(-time.Nanosecond))
+	} else {
+		// Like cron, fixed intervals are scheduled at whole seconds.
+		next = from.Add(time.Second - time.Nanosecond).Truncate(time.Second)
+	}
+	var scheduledTimes []time.Time
+	for ; err == nil && !next.After(to); next, err = GetScheduledTime(s, next) {
+		if len(scheduledTimes) == maxCount {
+			return nil, fmt.Errorf("the schedule is scheduled at more than %d times between %v and %v",
+				maxCount, from, to)
+		}
+		scheduledTimes = append(scheduledTimes, next)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scheduledTimes, nil
+}
+
 // GetScheduledTime find next schedule time for both cron and fixed rate scheduled entity given the fromTime
 func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time, error) {
 	if len(s.CronExpression) > 0 {
@@ -271,11 +456,13 @@ func (g *GoCronScheduler) AddFixedIntervalJob(ctx context.Context, job *GoCronJo
 	var jobFunc cron.TimedFuncJob
 	jobFunc = job.Run
 
-	var lastTime time.Time
+	// Start from the first run after now aligned with the last one, since cron would otherwise launch every run missed
+	// since. Those are caught up on according to the catch-up policy of the schedule instead.
+	var startTime time.Time
 	if job.lastExecTime != nil {
-		lastTime = *job.lastExecTime
+		startTime = getFixedIntervalStartTime(*job.lastExecTime, d, time.Now())
 	}
-	entryID := g.cron.ScheduleTimedJob(cron.ConstantDelaySchedule{Delay: d}, jobFunc, lastTime)
+	entryID := g.cron.ScheduleTimedJob(cron.ConstantDelaySchedule{Delay: d}, jobFunc, startTime)
 	// Update the enttry id in the job which is handle to be used for removal
 	job.entryID = entryID
 	logger.Infof(ctx, "successfully added the fixed rate schedule %s to the scheduler for schedule %+v",
@@ -315,6 +502,15 @@ func (g *GoCronScheduler) RemoveCronJob(ctx context.Context, job *GoCronJob) {
 
 }
 
+// Returns the first time, after now, of a fixed rate schedule with the interval d which was last executed at
+// lastExecTime.
+func getFixedIntervalStartTime(lastExecTime time.Time, d time.Duration, now time.Time) time.Time {
+	if lastExecTime.After(now) {
+		return lastExecTime
+	}
+	return lastExecTime.Add((now.Sub(lastExecTime)/d + 1) * d)
+}
+
 func getFixedRateDurationFromSchedule(unit admin.FixedRateUnit, fixedRateValue uint32) (time.Duration, error) {
 	d := time.Duration(fixedRateValue)
 	switch unit {
@@ -331,7 +527,17 @@ func getFixedRateDurationFromSchedule(unit admin.FixedRateUnit, fixedRateValue u
 }
 
 func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntity, scope promutils.Scope,
-	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool) Scheduler {
+	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool,
+	catchUpPolicies []runtimeInterfaces.ScheduleCatchUpPolicy) Scheduler {
+	return NewGoCronSchedulerWithMetrics(ctx, schedules, NewGoCronMetrics(scope), snapshot, rateLimiter, executor,
+		useUtcTz, catchUpPolicies)
+}
+
+// NewGoCronSchedulerWithMetrics creates a scheduler recording the given metrics, which lets the schedulers created over
+// time by the same process, such as on each leadership term, share them. The scheduler stops once ctx is done.
+func NewGoCronSchedulerWithMetrics(ctx context.Context, schedules []models.SchedulableEntity, metrics GoCronMetrics,
+	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool,
+	catchUpPolicies []runtimeInterfaces.ScheduleCatchUpPolicy) Scheduler {
 	// Create the new cron scheduler and start it off
 	var opts []cron.Option
 	if useUtcTz {
@@ -339,20 +545,28 @@ func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntit
 	}
 	c := cron.New(opts...)
 	c.Start()
+	go func() {
+		<-ctx.Done()
+		c.Stop()
+	}()
 	scheduler := &GoCronScheduler{
 		cron:        c,
 		jobStore:    sync.Map{},
-		metrics:     getCronMetrics(scope),
+		metrics:     metrics,
 		rateLimiter: rateLimiter,
 		executor:    executor,
 		snapshot:    snapshot,
+		// The schedules are resolved against the policies currently configured, rather than those they were
+		// activated with, which may since have changed.
+		catchUpPolicies: catchUpPolicies,
 	}
 	scheduler.BootStrapSchedulesFromSnapShot(ctx, schedules, snapshot)
 	return scheduler
 }
 
-func getCronMetrics(scope promutils.Scope) goCronMetrics {
-	return goCronMetrics{
+// NewGoCronMetrics registers the metrics recorded by go cron schedulers in the scope.
+func NewGoCronMetrics(scope promutils.Scope) GoCronMetrics {
+	return GoCronMetrics{
 		Scope: scope,
 		JobFuncPanicCounter: scope.MustNewCounter("job_func_panic_counter",
 			"count of crashes for the job functions executed by the scheduler"),
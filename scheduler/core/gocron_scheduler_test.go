@@ -15,7 +15,9 @@ import (
 
 	adminModels "github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/executor/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteadmin/scheduler/snapshoter"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -115,7 +117,7 @@ func setupWithSchedules(t *testing.T, subscope string, schedules []models.Schedu
 	executor := new(mocks.Executor)
 	snapshot := &snapshoter.SnapshotV1{}
 	executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	g := NewGoCronScheduler(context.Background(), schedules, schedulerScope, snapshot, rateLimiter, executor, useUtcTz)
+	g := NewGoCronScheduler(context.Background(), schedules, schedulerScope, snapshot, rateLimiter, executor, useUtcTz, nil)
 	goCronScheduler, ok := g.(*GoCronScheduler)
 	goCronScheduler.UpdateSchedules(context.Background(), schedules)
 	assert.True(t, ok)
@@ -204,6 +206,49 @@ func TestGetCronScheduledTime(t *testing.T) {
 	assert.Equal(t, expectedNextTime, nextTime)
 }
 
+func TestGetCronScheduledTimeWithTimeZone(t *testing.T) {
+	t.Run("follows daylight saving time", func(t *testing.T) {
+		fromTime := time.Date(2022, time.March, 26, 0, 0, 0, 0, time.UTC)
+		nextTime, err := getCronScheduledTime("CRON_TZ=Europe/Berlin 0 9 * * *", fromTime)
+		assert.Nil(t, err)
+		assert.True(t, time.Date(2022, time.March, 26, 8, 0, 0, 0, time.UTC).Equal(nextTime))
+		nextTime, err = getCronScheduledTime("CRON_TZ=Europe/Berlin 0 9 * * *", nextTime)
+		assert.Nil(t, err)
+		assert.True(t, time.Date(2022, time.March, 27, 7, 0, 0, 0, time.UTC).Equal(nextTime))
+	})
+	t.Run("invalid time zone", func(t *testing.T) {
+		_, err := getCronScheduledTime("CRON_TZ=Europe/Nowhere 0 9 * * *", time.Now())
+		assert.NotNil(t, err)
+	})
+	t.Run("catch up times", func(t *testing.T) {
+		s := models.SchedulableEntity{
+			CronExpression: "TZ=America/New_York 30 6 * * *",
+		}
+		from := time.Date(2022, time.November, 5, 12, 0, 0, 0, time.UTC)
+		to := time.Date(2022, time.November, 7, 12, 0, 0, 0, time.UTC)
+		catchupTimes, err := GetCatchUpTimes(s, from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(catchupTimes))
+		assert.True(t, time.Date(2022, time.November, 6, 11, 30, 0, 0, time.UTC).Equal(catchupTimes[0]))
+		assert.True(t, time.Date(2022, time.November, 7, 11, 30, 0, 0, time.UTC).Equal(catchupTimes[1]))
+	})
+	t.Run("add cron job", func(t *testing.T) {
+		ctx := context.Background()
+		g := setupWithSchedules(t, "add_cron_job_with_time_zone", nil, true)
+		s := scheduleCron
+		s.CronExpression = "CRON_TZ=Asia/Kolkata 0 9 * * *"
+		job := &GoCronJob{nameOfSchedule: "cron_tz", schedule: s, ctx: ctx,
+			funcWithSchedule: g.GetTimedFuncWithSchedule()}
+		assert.Nil(t, g.AddCronJob(ctx, job))
+		next := g.cron.Entry(job.entryID).Next.UTC()
+		assert.Equal(t, 3, next.Hour())
+		assert.Equal(t, 30, next.Minute())
+
+		s.CronExpression = "CRON_TZ=Asia/Nowhere 0 9 * * *"
+		assert.NotNil(t, g.AddCronJob(ctx, &GoCronJob{nameOfSchedule: "cron_bad_tz", schedule: s, ctx: ctx}))
+	})
+}
+
 func TestGetCatchUpTimes(t *testing.T) {
 	t.Run("to time before scheduled time", func(t *testing.T) {
 		s := models.SchedulableEntity{
@@ -296,3 +341,259 @@ func TestCatchUpAllSchedule(t *testing.T) {
 	catchupSuccess := g.CatchupAll(ctx, toTime)
 	assert.True(t, catchupSuccess)
 }
+
+func TestFilterCatchUpTimes(t *testing.T) {
+	to := time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC)
+	catchUpTimes := []time.Time{to.Add(-3 * time.Hour), to.Add(-2 * time.Hour), to.Add(-time.Hour), to}
+	tests := []struct {
+		name     string
+		schedule models.SchedulableEntity
+		want     []time.Time
+	}{
+		{name: "no policy", schedule: models.SchedulableEntity{}, want: catchUpTimes},
+		{name: "all", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpAll}, want: catchUpTimes},
+		{name: "latest", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpLatest},
+			want: catchUpTimes[3:]},
+		{name: "none", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpNone}},
+		{name: "max runs", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpMaxRuns, CatchUpMaxRuns: 2},
+			want: catchUpTimes[2:]},
+		{name: "max runs above missed", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpMaxRuns,
+			CatchUpMaxRuns: 10}, want: catchUpTimes},
+		{name: "max age", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpMaxAge,
+			CatchUpMaxAge: 2 * time.Hour}, want: catchUpTimes[1:]},
+		{name: "max age before missed", schedule: models.SchedulableEntity{CatchUpPolicy: models.CatchUpMaxAge,
+			CatchUpMaxAge: time.Minute}, want: catchUpTimes[3:]},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FilterCatchUpTimes(tc.schedule, catchUpTimes, to))
+		})
+	}
+	t.Run("max age after missed", func(t *testing.T) {
+		s := models.SchedulableEntity{CatchUpPolicy: models.CatchUpMaxAge, CatchUpMaxAge: time.Minute}
+		assert.Nil(t, FilterCatchUpTimes(s, catchUpTimes[:3], to))
+	})
+}
+
+func TestCatchUpSingleSchedulePolicy(t *testing.T) {
+	ctx := context.Background()
+	g := setupWithSchedules(t, "catch_up_single_schedule_policy", nil, false)
+	executor := new(mocks.Executor)
+	var executed []time.Time
+	executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		executed = append(executed, args.Get(1).(time.Time))
+	})
+	g.executor = executor
+	s := models.SchedulableEntity{
+		FixedRateValue: 1,
+		Unit:           admin.FixedRateUnit_HOUR,
+		CatchUpPolicy:  models.CatchUpMaxRuns,
+		CatchUpMaxRuns: 2,
+	}
+	from := time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC)
+	assert.Nil(t, g.CatchUpSingleSchedule(ctx, s, from, to))
+	assert.Equal(t, []time.Time{to.Add(-time.Hour), to}, executed)
+}
+
+func TestUpdateSchedulesReactivation(t *testing.T) {
+	True := true
+	False := false
+	newSchedule := func(policy models.CatchUpPolicy, active *bool) models.SchedulableEntity {
+		return models.SchedulableEntity{
+			SchedulableEntityKey: models.SchedulableEntityKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "fixed_reactivated",
+				Version: "version1",
+			},
+			FixedRateValue: 1,
+			Unit:           admin.FixedRateUnit_HOUR,
+			Active:         active,
+			CatchUpPolicy:  policy,
+		}
+	}
+	// The policy stored with the schedule takes precedence over the configured default.
+	for _, tc := range []struct {
+		policy   models.CatchUpPolicy
+		defaults models.CatchUpPolicy
+		executed int
+	}{
+		{executed: 0},
+		{policy: models.CatchUpAll, executed: 3},
+		{policy: models.CatchUpLatest, executed: 1},
+		{policy: models.CatchUpNone, executed: 0},
+		{defaults: models.CatchUpLatest, executed: 1},
+		{policy: models.CatchUpAll, defaults: models.CatchUpNone, executed: 3},
+		{policy: models.CatchUpNone, defaults: models.CatchUpAll, executed: 0},
+	} {
+		t.Run(fmt.Sprintf("policy %q default %q", tc.policy, tc.defaults), func(t *testing.T) {
+			ctx := context.Background()
+			g := setupWithSchedules(t, fmt.Sprintf("update_schedules_reactivation_%s_%s", tc.policy, tc.defaults),
+				nil, false)
+			executor := new(mocks.Executor)
+			executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			g.executor = executor
+			if len(tc.defaults) > 0 {
+				g.catchUpPolicies = []runtimeInterfaces.ScheduleCatchUpPolicy{{Project: "project", Policy: tc.defaults}}
+			}
+
+			g.UpdateSchedules(ctx, []models.SchedulableEntity{newSchedule(tc.policy, &True)})
+			// The schedule last ran a little over three hours ago when it was deactivated
+			lastExecTime := time.Now().Add(-3*time.Hour - time.Minute)
+			g.jobStore.Range(func(key, value interface{}) bool {
+				value.(*GoCronJob).lastExecTime = &lastExecTime
+				return true
+			})
+			g.UpdateSchedules(ctx, []models.SchedulableEntity{newSchedule(tc.policy, &False)})
+			g.UpdateSchedules(ctx, []models.SchedulableEntity{newSchedule(tc.policy, &True)})
+
+			executor.AssertNumberOfCalls(t, "Execute", tc.executed)
+			_, ok := g.descheduledExecTimes.Load(identifier.GetScheduleName(ctx, newSchedule(tc.policy, &True)))
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestBootStrapSchedulesCatchUpPolicy(t *testing.T) {
+	ctx := context.Background()
+	True := true
+	schedule := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "fixed_bootstrapped",
+			Version: "version1",
+		},
+		FixedRateValue: 1,
+		Unit:           admin.FixedRateUnit_HOUR,
+		Active:         &True,
+		CatchUpPolicy:  models.CatchUpAll,
+	}
+	defaulted := schedule
+	defaulted.Name = "fixed_defaulted"
+	defaulted.CatchUpPolicy = ""
+	g := setupWithSchedules(t, "bootstrap_schedules_catch_up_policy", nil, false)
+	g.catchUpPolicies = []runtimeInterfaces.ScheduleCatchUpPolicy{{Project: "project", Policy: models.CatchUpNone}}
+	g.BootStrapSchedulesFromSnapShot(ctx, []models.SchedulableEntity{schedule, defaulted}, &snapshoter.SnapshotV1{})
+
+	// The stored policy is honored, and the configured default applies to schedules without one.
+	val, ok := g.jobStore.Load(identifier.GetScheduleName(ctx, schedule))
+	assert.True(t, ok)
+	assert.Equal(t, models.CatchUpAll, val.(*GoCronJob).schedule.CatchUpPolicy)
+	val, ok = g.jobStore.Load(identifier.GetScheduleName(ctx, defaulted))
+	assert.True(t, ok)
+	assert.Equal(t, models.CatchUpNone, val.(*GoCronJob).schedule.CatchUpPolicy)
+}
+
+func TestAddFixedIntervalJobStartTime(t *testing.T) {
+	ctx := context.Background()
+	g := setupWithSchedules(t, "add_fixed_interval_job_start_time", nil, false)
+	lastExecTime := time.Now().Add(-150 * time.Minute)
+	job := &GoCronJob{nameOfSchedule: "fixed", schedule: scheduleFixed, lastExecTime: &lastExecTime, ctx: ctx,
+		funcWithSchedule: g.GetTimedFuncWithSchedule()}
+	assert.Nil(t, g.AddFixedIntervalJob(ctx, job))
+	// The runs missed since the last one are left to the catch up
+	next := g.cron.Entry(job.entryID).Next
+	assert.Equal(t, lastExecTime.Add(3*time.Hour), next)
+}
+
+func TestGetNextScheduledTimes(t *testing.T) {
+	now := time.Date(2022, time.January, 28, 10, 30, 0, 0, time.UTC)
+	t.Run("cron", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 19 * * *"}
+		nextTimes, err := GetNextScheduledTimes(s, nil, now, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{
+			time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC),
+			time.Date(2022, time.January, 29, 19, 0, 0, 0, time.UTC),
+		}, nextTimes)
+	})
+	t.Run("fixed rate from last execution", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_HOUR}
+		lastExecTime := time.Date(2022, time.January, 28, 7, 15, 0, 0, time.UTC)
+		nextTimes, err := GetNextScheduledTimes(s, &lastExecTime, now, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{
+			time.Date(2022, time.January, 28, 11, 15, 0, 0, time.UTC),
+			time.Date(2022, time.January, 28, 12, 15, 0, 0, time.UTC),
+			time.Date(2022, time.January, 28, 13, 15, 0, 0, time.UTC),
+		}, nextTimes)
+	})
+	t.Run("none", func(t *testing.T) {
+		nextTimes, err := GetNextScheduledTimes(models.SchedulableEntity{CronExpression: "0 19 * * *"}, nil, now, 0)
+		assert.Nil(t, err)
+		assert.Empty(t, nextTimes)
+	})
+	t.Run("invalid cron", func(t *testing.T) {
+		_, err := GetNextScheduledTimes(models.SchedulableEntity{CronExpression: "0 19 * *"}, nil, now, 1)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetExecutedTimes(t *testing.T) {
+	from := time.Date(2022, time.January, 27, 12, 0, 0, 0, time.UTC)
+	t.Run("cron", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 19 * * *"}
+		lastExecTime := time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC)
+		executedTimes, err := GetExecutedTimes(s, from, lastExecTime)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC), lastExecTime},
+			executedTimes)
+	})
+	t.Run("fixed rate", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_DAY}
+		lastExecTime := time.Date(2022, time.January, 29, 6, 0, 0, 0, time.UTC)
+		executedTimes, err := GetExecutedTimes(s, from, lastExecTime)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{time.Date(2022, time.January, 28, 6, 0, 0, 0, time.UTC), lastExecTime},
+			executedTimes)
+	})
+}
+
+func TestGetScheduledTimesInRange(t *testing.T) {
+	from := time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.January, 29, 19, 0, 0, 0, time.UTC)
+	t.Run("cron", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 19 * * *"}
+		scheduledTimes, err := GetScheduledTimesInRange(s, from, to, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{from, time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC), to},
+			scheduledTimes)
+	})
+	t.Run("fixed rate", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_DAY}
+		scheduledTimes, err := GetScheduledTimesInRange(s, from.Add(time.Minute), to, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{
+			time.Date(2022, time.January, 27, 19, 1, 0, 0, time.UTC),
+			time.Date(2022, time.January, 28, 19, 1, 0, 0, time.UTC),
+		}, scheduledTimes)
+	})
+	t.Run("too many", func(t *testing.T) {
+		_, err := GetScheduledTimesInRange(models.SchedulableEntity{CronExpression: "0 19 * * *"}, from, to, 2)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetScheduleStatuses(t *testing.T) {
+	ctx := context.Background()
+	g := setup(t, "get_schedule_statuses", false)
+	lastExecTime := time.Now().Add(-time.Minute)
+	g.jobStore.Range(func(key, value interface{}) bool {
+		value.(*GoCronJob).lastExecTime = &lastExecTime
+		return true
+	})
+	statuses := g.GetScheduleStatuses(ctx)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "cron1", statuses[0].Name)
+	assert.Equal(t, "0 19 * * *", statuses[0].CronExpression)
+	assert.Equal(t, "fixed1", statuses[1].Name)
+	assert.Equal(t, uint32(1), statuses[1].FixedRateValue)
+	assert.Equal(t, admin.FixedRateUnit_HOUR.String(), statuses[1].FixedRateUnit)
+	for _, status := range statuses {
+		assert.Equal(t, &lastExecTime, status.LastExecutionTime)
+		assert.NotNil(t, status.NextExecutionTime)
+		assert.True(t, status.NextExecutionTime.After(time.Now()))
+	}
+}
@@ -10,6 +10,20 @@ import (
 
 type TimedFuncWithSchedule func(ctx context.Context, s models.SchedulableEntity, t time.Time) error
 
+// ScheduleStatus is the state of a schedule run by the scheduler.
+type ScheduleStatus struct {
+	Project        string `json:"project"`
+	Domain         string `json:"domain"`
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	CronExpression string `json:"cronExpression,omitempty"`
+	FixedRateValue uint32 `json:"fixedRateValue,omitempty"`
+	FixedRateUnit  string `json:"fixedRateUnit,omitempty"`
+	// Unset until the schedule is first executed, unless the scheduler was bootstrapped with its last execution time.
+	LastExecutionTime *time.Time `json:"lastExecutionTime,omitempty"`
+	NextExecutionTime *time.Time `json:"nextExecutionTime,omitempty"`
+}
+
 // Scheduler is the main scheduler interfaces for scheduling/descheduling jobs, updating the schedules,
 // calculating snapshot of the schedules , bootstrapping the scheduler from the snapshot as well as the catcup functionality
 type Scheduler interface {
@@ -25,4 +39,6 @@ type Scheduler interface {
 	CalculateSnapshot(ctx context.Context) snapshoter.Snapshot
 	// CatchupAll catches up all the schedules in the schedulers job store to the until time
 	CatchupAll(ctx context.Context, until time.Time) bool
+	// GetScheduleStatuses returns the state of the schedules run by the scheduler, ordered by their identifiers.
+	GetScheduleStatuses(ctx context.Context) []ScheduleStatus
 }
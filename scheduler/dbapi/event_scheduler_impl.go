@@ -9,6 +9,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	scheduleInterfaces "github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyteadmin/scheduler/core"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -27,6 +28,18 @@ func (s *eventScheduler) CreateScheduleInput(ctx context.Context, appConfig *run
 		Identifier:         identifier,
 		ScheduleExpression: *schedule,
 	}
+	if appConfig != nil {
+		// Policies for the launch plan are stored along with its schedule, the others are defaults which the scheduler
+		// resolves from its configuration.
+		policy := schedulerCore.GetCatchUpPolicy(appConfig.GetCatchUpPolicies(),
+			identifier.Project, identifier.Domain, identifier.Name)
+		if policy != nil && len(policy.Name) > 0 {
+			if err := schedulerCore.ValidateCatchUpPolicy(*policy); err != nil {
+				return interfaces.AddScheduleInput{}, err
+			}
+			addScheduleInput.CatchUpPolicy = policy
+		}
+	}
 	return addScheduleInput, nil
 }
 
@@ -58,6 +71,11 @@ func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddSc
 			Version: input.Identifier.Version,
 		},
 	}
+	if input.CatchUpPolicy != nil {
+		modelInput.CatchUpPolicy = input.CatchUpPolicy.Policy
+		modelInput.CatchUpMaxRuns = input.CatchUpPolicy.MaxRuns
+		modelInput.CatchUpMaxAge = input.CatchUpPolicy.MaxAge.Duration
+	}
 	err := s.db.SchedulableEntityRepo().Activate(ctx, modelInput)
 	if err != nil {
 		return err
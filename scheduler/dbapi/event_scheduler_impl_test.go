@@ -3,13 +3,17 @@ package dbapi
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule/interfaces"
 	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -44,6 +48,72 @@ func TestCreateScheduleInput(t *testing.T) {
 	assert.NotNil(t, addScheduleInput)
 }
 
+func TestCreateScheduleInputCatchUpPolicy(t *testing.T) {
+	eventScheduler := setupEventScheduler()
+	schedule := &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{
+				Schedule: "*/1 * * * *",
+			},
+		},
+	}
+	identifier := core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "scheduled_wroflow",
+		Version: "v1",
+	}
+	t.Run("most specific", func(t *testing.T) {
+		appConfig := &runtimeInterfaces.SchedulerConfig{
+			CatchUpPolicies: []runtimeInterfaces.ScheduleCatchUpPolicy{
+				{Policy: models.CatchUpNone},
+				{Project: "project", Domain: "domain", Policy: models.CatchUpLatest},
+				{Project: "project", Domain: "domain", Name: "scheduled_wroflow", Policy: models.CatchUpMaxRuns,
+					MaxRuns: 3},
+				{Project: "project", Domain: "domain", Name: "other", Policy: models.CatchUpAll},
+				{Project: "other", Policy: models.CatchUpAll},
+			},
+		}
+		addScheduleInput, err := eventScheduler.CreateScheduleInput(context.Background(), appConfig, identifier, schedule)
+		assert.Nil(t, err)
+		assert.Equal(t, &appConfig.CatchUpPolicies[2], addScheduleInput.CatchUpPolicy)
+	})
+	t.Run("default policy", func(t *testing.T) {
+		// Project and domain policies are defaults resolved by the scheduler, rather than stored with the schedule.
+		appConfig := &runtimeInterfaces.SchedulerConfig{
+			CatchUpPolicies: []runtimeInterfaces.ScheduleCatchUpPolicy{
+				{Project: "project", Domain: "domain", Policy: models.CatchUpLatest},
+			},
+		}
+		addScheduleInput, err := eventScheduler.CreateScheduleInput(context.Background(), appConfig, identifier, schedule)
+		assert.Nil(t, err)
+		assert.Nil(t, addScheduleInput.CatchUpPolicy)
+	})
+	t.Run("no matching policy", func(t *testing.T) {
+		appConfig := &runtimeInterfaces.SchedulerConfig{
+			CatchUpPolicies: []runtimeInterfaces.ScheduleCatchUpPolicy{
+				{Project: "other", Policy: models.CatchUpNone},
+			},
+		}
+		addScheduleInput, err := eventScheduler.CreateScheduleInput(context.Background(), appConfig, identifier, schedule)
+		assert.Nil(t, err)
+		assert.Nil(t, addScheduleInput.CatchUpPolicy)
+	})
+	t.Run("invalid policy", func(t *testing.T) {
+		for _, policy := range []runtimeInterfaces.ScheduleCatchUpPolicy{
+			{Name: "scheduled_wroflow", Policy: "unknown"},
+			{Name: "scheduled_wroflow", Policy: models.CatchUpMaxRuns},
+			{Name: "scheduled_wroflow", Policy: models.CatchUpMaxAge},
+		} {
+			appConfig := &runtimeInterfaces.SchedulerConfig{
+				CatchUpPolicies: []runtimeInterfaces.ScheduleCatchUpPolicy{policy},
+			}
+			_, err := eventScheduler.CreateScheduleInput(context.Background(), appConfig, identifier, schedule)
+			assert.NotNil(t, err)
+		}
+	})
+}
+
 func TestRemoveSchedule(t *testing.T) {
 	eventScheduler := setupEventScheduler()
 
@@ -115,6 +185,38 @@ func TestAddSchedule(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("catch_up_policy", func(t *testing.T) {
+		eventScheduler := setupEventScheduler()
+		schedule := admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "*/1 * * * *",
+				},
+			},
+		}
+
+		scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+		scheduleEntitiesRepo.OnActivateMatch(mock.Anything, mock.MatchedBy(func(s models.SchedulableEntity) bool {
+			return s.CatchUpPolicy == models.CatchUpMaxAge && s.CatchUpMaxAge == time.Hour
+		})).Return(nil)
+
+		err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+			Identifier: core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "scheduled_wroflow",
+				Version: "v1",
+			},
+			ScheduleExpression: schedule,
+			CatchUpPolicy: &runtimeInterfaces.ScheduleCatchUpPolicy{
+				Policy: models.CatchUpMaxAge,
+				MaxAge: config.Duration{Duration: time.Hour},
+			},
+		})
+		assert.Nil(t, err)
+		scheduleEntitiesRepo.AssertNumberOfCalls(t, "Activate", 1)
+	})
+
 	t.Run("cron_expression_unsupported", func(t *testing.T) {
 		eventScheduler := setupEventScheduler()
 		schedule := admin.Schedule{
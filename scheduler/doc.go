@@ -17,6 +17,8 @@
 // 	  duplicate the work since each execution for a scheduleTime will have unique identifier derived from schedule name
 //	  and time of the schedule. The idempotency aspect of the admin for same identifier prevents duplication on the admin
 //	  side.
+//	  With leader election enabled multiple replicas can be run, of which only the one holding a lease in the DB runs the
+//	  schedules. The others take over once the lease is released or expires, resuming from the last snapshot.
 //    The scheduler runs continuously in a loop reading the updated schedule entries in the data store and adding or removing
 //    the schedules. Removing a schedule will not alter in-flight go-routines launched by the scheduler.
 //    Thus the behavior of these executions is undefined (most probably will get executed).
@@ -39,6 +41,12 @@
 //			The scheduler is not run until all the schedules have been caught up.
 //			The current design is also not to snapshot until all the schedules are caught up.
 //			This might be drawback in case catch up runs for a long time and hasn't been snapshotted.(reassess)
+//			Which of the missed runs are launched is determined by the catch-up policy of each schedule, configured per
+//			project, domain and launch plan: all of them, the latest, none, the N most recent or those within a max age.
+//			Schedules reactivated with a policy also catch up according to it on the runs missed while inactive.
+//			The policies are validated when admin and the scheduler start. Those for a launch plan are stored with its
+//			schedule when it's activated, while the project and domain defaults are resolved from the scheduler's
+//			configuration whenever it reads the schedules, so that changes to them apply to schedules already active.
 //		c) GOCronWrapper :
 //			This component is responsible for locking in the time for the scheduled job to be invoked and adding those
 //			to the cron scheduler. Right now this uses https://github.com/robfig/cron/v3 framework for fixed rate and cron
@@ -53,6 +61,8 @@
 //          It accepts
 //   			- Standard crontab specs, e.g. "* * * * ?"
 //   			- Descriptors, e.g. "@midnight", "@every 1h30m"
+//   			- Either of them prefixed by the IANA time zone they're evaluated in, following daylight saving time,
+//   			  e.g. "CRON_TZ=Europe/Berlin 0 9 * * *". Others are evaluated in UTC or local time per useUTCTz.
 //		d) Job function :
 //			The job function accepts the scheduleTime and the schedule which is used for creating an execution request
 //			to the admin. Each job function is tied to schedule which gets executed in separate go routine by the gogf
@@ -95,6 +105,8 @@
 //		g) Case there are multiple pod running with the scheduler , then we rely on the idempotency aspect of the executions
 //		   which have a identifier derived from the hash of schedule time + launch plan identifier which would remain the same
 //		   any other instance of the scheduler picks up and admin will return the AlreadyExists error.
+//		   With leader election enabled only the leader runs the schedules, but the same applies to the executions fired
+//		   by a leader stepping down after losing its lease.
 //
 
 package scheduler
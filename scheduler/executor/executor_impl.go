@@ -2,7 +2,6 @@ package executor
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/flyteorg/flyteadmin/scheduler/identifier"
@@ -33,8 +32,10 @@ type executorMetrics struct {
 	SuccessfulExecutionCounter prometheus.Counter
 }
 
-func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error {
-
+// NewExecutionCreateRequest returns the request creating the execution named executionName of the launch plan of
+// schedule s at the scheduledTime, whose kickoff time input is set to the scheduled time for cron schedules.
+func NewExecutionCreateRequest(s models.SchedulableEntity, executionName string,
+	scheduledTime time.Time) *admin.ExecutionCreateRequest {
 	literalsInputMap := map[string]*core.Literal{}
 	// Only add kickoff time input arg for cron based schedules
 	if len(s.CronExpression) > 0 && len(s.KickoffTimeInputArg) > 0 {
@@ -53,23 +54,10 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 		}
 	}
 
-	// Making the identifier deterministic using the hash of the identifier and scheduled time
-	executionIdentifier, err := identifier.GetExecutionIdentifier(ctx, core.Identifier{
+	return &admin.ExecutionCreateRequest{
 		Project: s.Project,
 		Domain:  s.Domain,
-		Name:    s.Name,
-		Version: s.Version,
-	}, scheduledTime)
-
-	if err != nil {
-		logger.Error(ctx, "failed to generate execution identifier for schedule %+v due to %v", s, err)
-		return err
-	}
-
-	executionRequest := &admin.ExecutionCreateRequest{
-		Project: s.Project,
-		Domain:  s.Domain,
-		Name:    "f" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19],
+		Name:    executionName,
 		Spec: &admin.ExecutionSpec{
 			LaunchPlan: &core.Identifier{
 				ResourceType: core.ResourceType_LAUNCH_PLAN,
@@ -89,6 +77,24 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 			Literals: literalsInputMap,
 		},
 	}
+}
+
+func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error {
+
+	// Making the identifier deterministic using the hash of the identifier and scheduled time
+	executionName, err := identifier.GetExecutionName(ctx, core.Identifier{
+		Project: s.Project,
+		Domain:  s.Domain,
+		Name:    s.Name,
+		Version: s.Version,
+	}, scheduledTime)
+
+	if err != nil {
+		logger.Error(ctx, "failed to generate execution identifier for schedule %+v due to %v", s, err)
+		return err
+	}
+
+	executionRequest := NewExecutionCreateRequest(s, executionName, scheduledTime)
 	if !*s.Active {
 		// no longer active
 		logger.Debugf(ctx, "schedule %+v is no longer active", s)
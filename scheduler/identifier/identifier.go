@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
@@ -20,6 +21,8 @@ import (
 const (
 	scheduleNameInputsFormat = "%s:%s:%s:%s"
 	executionIDInputsFormat  = scheduleNameInputsFormat + ":%d"
+	// Backfill execution names are also hashed from the name of the backfill.
+	backfillExecutionIDInputsFormat = executionIDInputsFormat + ":%s"
 )
 
 // GetScheduleName generate the schedule name to be used as unique identification string within the scheduler
@@ -40,6 +43,38 @@ func GetExecutionIdentifier(ctx context.Context, identifier core.Identifier, sch
 	return uuid.FromBytes(b)
 }
 
+// GetExecutionName returns the name of the execution launched by the scheduler for the launch plan identifier at the
+// scheduledTime, which is deterministic so that the same scheduled time isn't launched twice.
+func GetExecutionName(ctx context.Context, identifier core.Identifier, scheduledTime time.Time) (string, error) {
+	executionIdentifier, err := GetExecutionIdentifier(ctx, identifier, scheduledTime)
+	if err != nil {
+		return "", err
+	}
+	return "f" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19], nil
+}
+
+// GetBackfillExecutionName returns the name of the execution launched by the backfill named backfillName for the launch
+// plan identifier at the scheduledTime. It's deterministic so that a backfill doesn't launch a scheduled time twice, and
+// differs from the name of the execution launched by the scheduler so that scheduled times may be rerun.
+func GetBackfillExecutionName(ctx context.Context, identifier core.Identifier, backfillName string,
+	scheduledTime time.Time) (string, error) {
+	h := fnv.New64()
+	_, err := h.Write([]byte(fmt.Sprintf(backfillExecutionIDInputsFormat, identifier.Project, identifier.Domain,
+		identifier.Name, identifier.Version, scheduledTime.Unix(), backfillName)))
+	if err != nil {
+		logger.Errorf(ctx, "failed to hash launch plan identifier: %+v with scheduled time %v and backfill %s with err: %v",
+			identifier, scheduledTime, backfillName, err)
+		return "", err
+	}
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint64(b, h.Sum64())
+	executionIdentifier, err := uuid.FromBytes(b)
+	if err != nil {
+		return "", err
+	}
+	return "b" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19], nil
+}
+
 // hashIdentifier returns the hash of the identifier
 func hashIdentifier(ctx context.Context, identifier core.Identifier) uint64 {
 	h := fnv.New64()
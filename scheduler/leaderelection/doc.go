@@ -0,0 +1,7 @@
+// Package leaderelection
+// This package provides the election of the scheduler replica which runs the schedules, through a lease in the DB.
+// The leader renews the lease periodically while the other replicas attempt to acquire it, which they do once it has
+// expired or been released by the leader on shutdown. A leader which fails to renew the lease in time stops running
+// the schedules, and the next leader resumes them from the last snapshot. Since a leader which loses the lease may
+// still fire a schedule while stepping down, executions rely on the idempotency of admin as before.
+package leaderelection
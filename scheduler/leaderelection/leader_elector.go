@@ -0,0 +1,154 @@
+package leaderelection
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The name of the lease the scheduler replicas sharing a DB compete for.
+const leaseName = "flytescheduler"
+
+type Metrics struct {
+	Scope              promutils.Scope
+	IsLeader           prometheus.Gauge
+	LeadershipAcquired prometheus.Counter
+	LeadershipLost     prometheus.Counter
+	LeaseErrCounter    prometheus.Counter
+}
+
+// LeaderElector runs a function for as long as the replica holds the scheduler lease.
+type LeaderElector struct {
+	repo    repositoryInterfaces.SchedulerLeaseRepoInterface
+	holder  string
+	config  runtimeInterfaces.SchedulerLeaderElectionConfig
+	metrics Metrics
+}
+
+// Acquires or renews the lease, returning whether it's held.
+func (e *LeaderElector) acquire(ctx context.Context) (bool, error) {
+	held, err := e.repo.Acquire(ctx, models.SchedulerLease{
+		Name:      leaseName,
+		Holder:    e.holder,
+		ExpiresAt: time.Now().Add(e.config.LeaseDuration.Duration),
+	})
+	if err != nil {
+		e.metrics.LeaseErrCounter.Inc()
+		logger.Warnf(ctx, "unable to acquire the scheduler lease as [%s] due to %v", e.holder, err)
+	}
+	return held, err
+}
+
+// Runs lead until it returns, ctx is done or the lease isn't renewed in time, and then releases the lease.
+func (e *LeaderElector) lead(ctx context.Context, lead func(ctx context.Context) error) error {
+	logger.Infof(ctx, "acquired the scheduler lease as [%s]", e.holder)
+	e.metrics.IsLeader.Set(1)
+	e.metrics.LeadershipAcquired.Inc()
+	defer e.metrics.IsLeader.Set(0)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- lead(leaderCtx)
+	}()
+
+	// Leadership is given up ahead of the lease expiring, since another replica may acquire it right after.
+	renewDeadline := e.config.LeaseDuration.Duration - e.config.RetryPeriod.Duration
+	renewedAt := time.Now()
+	ticker := time.NewTicker(e.config.RetryPeriod.Duration)
+	defer ticker.Stop()
+	var err error
+	stopped := false
+	for !stopped {
+		select {
+		case err = <-done:
+			stopped = true
+		case <-ctx.Done():
+			cancel()
+			err = <-done
+			stopped = true
+		case <-ticker.C:
+			held, acquireErr := e.acquire(ctx)
+			if held {
+				renewedAt = time.Now()
+				continue
+			}
+			if acquireErr == nil || time.Since(renewedAt) >= renewDeadline {
+				logger.Warnf(ctx, "lost the scheduler lease as [%s]", e.holder)
+				e.metrics.LeadershipLost.Inc()
+				cancel()
+				err = <-done
+				stopped = true
+			}
+		}
+	}
+
+	// Another replica may take over right away, rather than once the lease expires.
+	if releaseErr := e.repo.Release(context.Background(), leaseName, e.holder); releaseErr != nil {
+		e.metrics.LeaseErrCounter.Inc()
+		logger.Warnf(ctx, "unable to release the scheduler lease as [%s] due to %v", e.holder, releaseErr)
+	}
+	return err
+}
+
+// Run blocks until ctx is done, running lead each time the lease is acquired with a context which is done once the
+// lease is lost. Returns the error of lead, if any.
+func (e *LeaderElector) Run(ctx context.Context, lead func(ctx context.Context) error) error {
+	if e.config.RetryPeriod.Duration <= 0 || e.config.LeaseDuration.Duration <= e.config.RetryPeriod.Duration {
+		return fmt.Errorf("the lease duration [%v] must exceed the retry period [%v]",
+			e.config.LeaseDuration.Duration, e.config.RetryPeriod.Duration)
+	}
+	logger.Infof(ctx, "waiting to acquire the scheduler lease as [%s]", e.holder)
+	ticker := time.NewTicker(e.config.RetryPeriod.Duration)
+	defer ticker.Stop()
+	for {
+		if held, _ := e.acquire(ctx); held {
+			if err := e.lead(ctx, lead); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func getHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	// Replicas on the same host are told apart by a random suffix.
+	return fmt.Sprintf("%s_%s", hostname, uuid.New().String())
+}
+
+func NewLeaderElector(repo repositoryInterfaces.SchedulerLeaseRepoInterface,
+	config runtimeInterfaces.SchedulerLeaderElectionConfig, scope promutils.Scope) *LeaderElector {
+	return &LeaderElector{
+		repo:   repo,
+		holder: getHolder(),
+		config: config,
+		metrics: Metrics{
+			Scope:    scope,
+			IsLeader: scope.MustNewGauge("is_leader", "whether the replica holds the scheduler lease"),
+			LeadershipAcquired: scope.MustNewCounter("leadership_acquired_counter",
+				"count of times the replica acquired the scheduler lease"),
+			LeadershipLost: scope.MustNewCounter("leadership_lost_counter",
+				"count of times the replica lost the scheduler lease before stepping down"),
+			LeaseErrCounter: scope.MustNewCounter("lease_error_counter",
+				"count of failures acquiring, renewing or releasing the scheduler lease"),
+		},
+	}
+}
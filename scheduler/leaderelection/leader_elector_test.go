@@ -0,0 +1,150 @@
+package leaderelection
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/gormimpl"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var leaderElectionConfig = runtimeInterfaces.SchedulerLeaderElectionConfig{
+	Enabled:       true,
+	LeaseDuration: config.Duration{Duration: 500 * time.Millisecond},
+	RetryPeriod:   config.Duration{Duration: 50 * time.Millisecond},
+}
+
+func getLeaseRepo(t *testing.T) (repositoryInterfaces.SchedulerLeaseRepoInterface, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "scheduler.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.SchedulerLease{}))
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// Replicas share the connection, as sqlite doesn't allow concurrent writes.
+	sqlDB.SetMaxOpenConns(1)
+	return gormimpl.NewSchedulerLeaseRepo(db, errors.NewTestErrorTransformer(), promutils.NewTestScope()), db
+}
+
+// Starts a replica leading until its context is done, returning whether it leads and a function stopping it.
+func startReplica(t *testing.T, elector *LeaderElector) (*int32, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	leading := new(int32)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		err := elector.Run(ctx, func(ctx context.Context) error {
+			atomic.StoreInt32(leading, 1)
+			<-ctx.Done()
+			atomic.StoreInt32(leading, 0)
+			return nil
+		})
+		assert.NoError(t, err)
+	}()
+	return leading, func() {
+		cancel()
+		<-stopped
+	}
+}
+
+func isLeading(leading *int32) func() bool {
+	return func() bool {
+		return atomic.LoadInt32(leading) == 1
+	}
+}
+
+func TestSchedulerLeaseRepo(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := getLeaseRepo(t)
+	expiresAt := time.Now().Add(time.Minute)
+
+	held, err := repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "a", ExpiresAt: expiresAt})
+	assert.NoError(t, err)
+	assert.True(t, held)
+	// Renewed by its holder.
+	held, err = repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "a", ExpiresAt: expiresAt})
+	assert.NoError(t, err)
+	assert.True(t, held)
+	held, err = repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "b", ExpiresAt: expiresAt})
+	assert.NoError(t, err)
+	assert.False(t, held)
+
+	// Only released by its holder.
+	assert.NoError(t, repo.Release(ctx, leaseName, "b"))
+	held, err = repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "b", ExpiresAt: expiresAt})
+	assert.NoError(t, err)
+	assert.False(t, held)
+	assert.NoError(t, repo.Release(ctx, leaseName, "a"))
+	held, err = repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "b", ExpiresAt: time.Now()})
+	assert.NoError(t, err)
+	assert.True(t, held)
+
+	// Taken over once expired.
+	held, err = repo.Acquire(ctx, models.SchedulerLease{Name: leaseName, Holder: "a", ExpiresAt: expiresAt})
+	assert.NoError(t, err)
+	assert.True(t, held)
+}
+
+func TestLeaderElector_Failover(t *testing.T) {
+	repo, _ := getLeaseRepo(t)
+	first := NewLeaderElector(repo, leaderElectionConfig, promutils.NewTestScope())
+	second := NewLeaderElector(repo, leaderElectionConfig, promutils.NewTestScope())
+
+	firstLeading, stopFirst := startReplica(t, first)
+	assert.Eventually(t, isLeading(firstLeading), time.Second, 10*time.Millisecond)
+	assert.Equal(t, float64(1), testutil.ToFloat64(first.metrics.IsLeader))
+
+	secondLeading, stopSecond := startReplica(t, second)
+	defer stopSecond()
+	// The lease is renewed by the leader.
+	time.Sleep(2 * leaderElectionConfig.LeaseDuration.Duration)
+	assert.False(t, isLeading(secondLeading)())
+
+	// The lease is released on shutdown, so the other replica takes over ahead of it expiring.
+	stopFirst()
+	assert.Equal(t, float64(0), testutil.ToFloat64(first.metrics.IsLeader))
+	assert.Eventually(t, isLeading(secondLeading), leaderElectionConfig.LeaseDuration.Duration/2,
+		10*time.Millisecond)
+	assert.Equal(t, float64(1), testutil.ToFloat64(second.metrics.LeadershipAcquired))
+}
+
+func TestLeaderElector_LostLease(t *testing.T) {
+	repo, db := getLeaseRepo(t)
+	elector := NewLeaderElector(repo, leaderElectionConfig, promutils.NewTestScope())
+	leading, stop := startReplica(t, elector)
+	defer stop()
+	assert.Eventually(t, isLeading(leading), time.Second, 10*time.Millisecond)
+
+	// Another replica took over the lease, e.g. after it expired.
+	require.NoError(t, db.Model(&models.SchedulerLease{}).Where("name = ?", leaseName).Updates(map[string]interface{}{
+		"holder":     "other",
+		"expires_at": time.Now().Add(time.Minute),
+	}).Error)
+	assert.Eventually(t, func() bool {
+		return !isLeading(leading)() && testutil.ToFloat64(elector.metrics.IsLeader) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, float64(1), testutil.ToFloat64(elector.metrics.LeadershipLost))
+}
+
+func TestLeaderElector_InvalidConfig(t *testing.T) {
+	repo, _ := getLeaseRepo(t)
+	elector := NewLeaderElector(repo, runtimeInterfaces.SchedulerLeaderElectionConfig{
+		LeaseDuration: config.Duration{Duration: time.Second},
+		RetryPeriod:   config.Duration{Duration: time.Second},
+	}, promutils.NewTestScope())
+	assert.EqualError(t, elector.Run(context.Background(), func(ctx context.Context) error {
+		return nil
+	}), "the lease duration [1s] must exceed the retry period [1s]")
+}
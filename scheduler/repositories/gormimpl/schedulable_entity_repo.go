@@ -55,13 +55,18 @@ func (r *SchedulableEntityRepo) Activate(ctx context.Context, input models.Sched
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
 	}
 
-	// Activate the already existing schedule
-	return activateOrDeactivate(r, input.SchedulableEntityKey, true)
+	// Activate the already existing schedule, along with the catch-up policy it's now activated with
+	return updateSchedulableEntity(r, input.SchedulableEntityKey, map[string]interface{}{
+		"active":            true,
+		"catch_up_policy":   input.CatchUpPolicy,
+		"catch_up_max_runs": input.CatchUpMaxRuns,
+		"catch_up_max_age":  input.CatchUpMaxAge,
+	})
 }
 
 func (r *SchedulableEntityRepo) Deactivate(ctx context.Context, ID models.SchedulableEntityKey) error {
-	// Activate the schedule
-	return activateOrDeactivate(r, ID, false)
+	// Deactivate the schedule
+	return updateSchedulableEntity(r, ID, map[string]interface{}{"active": false})
 }
 
 func (r *SchedulableEntityRepo) GetAll(ctx context.Context) ([]models.SchedulableEntity, error) {
@@ -112,8 +117,8 @@ func (r *SchedulableEntityRepo) Get(ctx context.Context, ID models.SchedulableEn
 	return schedulableEntity, nil
 }
 
-// Helper function to activate and deactivate a schedule
-func activateOrDeactivate(r *SchedulableEntityRepo, ID models.SchedulableEntityKey, activate bool) error {
+// Helper function to update the columns of a schedule, such as to activate and deactivate it
+func updateSchedulableEntity(r *SchedulableEntityRepo, ID models.SchedulableEntityKey, columns map[string]interface{}) error {
 	timer := r.metrics.GetDuration.Start()
 	tx := r.db.Model(&models.SchedulableEntity{}).Where(&models.SchedulableEntity{
 		SchedulableEntityKey: models.SchedulableEntityKey{
@@ -122,7 +127,7 @@ func activateOrDeactivate(r *SchedulableEntityRepo, ID models.SchedulableEntityK
 			Name:    ID.Name,
 			Version: ID.Version,
 		},
-	}).Update("active", activate)
+	}).Updates(columns)
 	timer.Stop()
 	if tx.Error != nil {
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
@@ -0,0 +1,65 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SchedulerLeaseRepo Implementation of SchedulerLeaseRepoInterface.
+type SchedulerLeaseRepo struct {
+	db               *gorm.DB
+	errorTransformer adminErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SchedulerLeaseRepo) Acquire(ctx context.Context, input models.SchedulerLease) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// The lease is created by whichever holder first acquires it.
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&input)
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected > 0 {
+		return true, nil
+	}
+	// Then it's only taken over once expired, which relies on the clocks of the holders being reasonably in sync.
+	tx = r.db.WithContext(ctx).Model(&models.SchedulerLease{}).
+		Where("name = ? AND (holder = ? OR expires_at < ?)", input.Name, input.Holder, time.Now()).
+		Updates(map[string]interface{}{
+			"holder":     input.Holder,
+			"expires_at": input.ExpiresAt,
+		})
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *SchedulerLeaseRepo) Release(ctx context.Context, name, holder string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.WithContext(ctx).Where("name = ? AND holder = ?", name, holder).Delete(&models.SchedulerLease{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// NewSchedulerLeaseRepo Returns an instance of SchedulerLeaseRepoInterface
+func NewSchedulerLeaseRepo(
+	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer, scope promutils.Scope) interfaces.SchedulerLeaseRepoInterface {
+	metrics := newMetrics(scope)
+	return &SchedulerLeaseRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -3,4 +3,5 @@ package interfaces
 type SchedulerRepoInterface interface {
 	SchedulableEntityRepo() SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() ScheduleEntitiesSnapShotRepoInterface
+	SchedulerLeaseRepo() SchedulerLeaseRepoInterface
 }
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=SchedulerLeaseRepoInterface -output=../mocks -case=underscore
+
+// SchedulerLeaseRepoInterface : An Interface for acquiring and renewing the lease of the scheduler replicas in the database
+type SchedulerLeaseRepoInterface interface {
+
+	// Acquire the lease for its holder until it expires, or renew it if the holder already holds it. Leases held by
+	// another holder are only acquired once they've expired. Returns whether the holder holds the lease.
+	Acquire(ctx context.Context, input models.SchedulerLease) (bool, error)
+
+	// Release the lease if the holder holds it, so that another holder may acquire it without waiting for it to expire.
+	Release(ctx context.Context, name, holder string) error
+}
@@ -1,10 +1,30 @@
 package models
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
+// CatchUpPolicy determines which of the runs of a schedule missed while the scheduler was down, or while the schedule
+// was deactivated, are launched once it's running again.
+type CatchUpPolicy = string
+
+const (
+	// CatchUpAll launches every missed run. Schedules without a policy catch up on all the runs missed while the
+	// scheduler was down, and none of those missed while they were deactivated.
+	CatchUpAll CatchUpPolicy = "all"
+	// CatchUpLatest launches only the most recent missed run.
+	CatchUpLatest CatchUpPolicy = "latest"
+	// CatchUpNone launches none of the missed runs.
+	CatchUpNone CatchUpPolicy = "none"
+	// CatchUpMaxRuns launches at most the CatchUpMaxRuns most recent missed runs.
+	CatchUpMaxRuns CatchUpPolicy = "maxRuns"
+	// CatchUpMaxAge launches the runs missed within the last CatchUpMaxAge.
+	CatchUpMaxAge CatchUpPolicy = "maxAge"
+)
+
 // Database model to encapsulate metadata associated with a SchedulableEntity
 type SchedulableEntity struct {
 	models.BaseModel
@@ -14,6 +34,11 @@ type SchedulableEntity struct {
 	Unit                admin.FixedRateUnit
 	KickoffTimeInputArg string
 	Active              *bool
+	// The catch-up policy configured for the launch plan when the schedule was activated. Schedules without one follow
+	// the default the scheduler's configuration holds for their project and domain.
+	CatchUpPolicy  CatchUpPolicy
+	CatchUpMaxRuns uint32
+	CatchUpMaxAge  time.Duration
 }
 
 // Schedulable entity primary key
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Database model of the lease held by the leader among the native scheduler replicas.
+type SchedulerLease struct {
+	Name      string `gorm:"primary_key"`
+	Holder    string
+	ExpiresAt time.Time
+	UpdatedAt time.Time
+}
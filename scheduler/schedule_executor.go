@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	repositoryInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
@@ -32,8 +33,43 @@ type ScheduledExecutor struct {
 	scope                  promutils.Scope
 	adminServiceClient     service.AdminServiceClient
 	workflowExecutorConfig *runtimeInterfaces.FlyteWorkflowExecutorConfig
+	catchUpPolicies        []runtimeInterfaces.ScheduleCatchUpPolicy
+	// The executor and metrics are shared by the schedulers created each time Run is called.
+	executor    executor.Executor
+	cronMetrics core.GoCronMetrics
+	// Guards the scheduler, which is only set while Run runs the schedules.
+	mu sync.RWMutex
 }
 
+// Status is the state of the scheduler replica.
+type Status struct {
+	// Whether the replica runs the schedules, which only the leader does with leader election enabled.
+	Running   bool                  `json:"running"`
+	Schedules []core.ScheduleStatus `json:"schedules"`
+}
+
+// GetStatus returns the state of the replica and the schedules it runs.
+func (w *ScheduledExecutor) GetStatus(ctx context.Context) Status {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.scheduler == nil {
+		return Status{Schedules: []core.ScheduleStatus{}}
+	}
+	schedules := w.scheduler.GetScheduleStatuses(ctx)
+	if schedules == nil {
+		schedules = []core.ScheduleStatus{}
+	}
+	return Status{Running: true, Schedules: schedules}
+}
+
+func (w *ScheduledExecutor) setScheduler(scheduler core.Scheduler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.scheduler = scheduler
+}
+
+// Run runs the schedules until ctx is done, resuming them from the last snapshot. It may be called again once it has
+// returned, such as on each leadership term of the replica.
 func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	logger.Infof(ctx, "Flyte native scheduler started successfully")
 
@@ -61,16 +97,15 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	// Set the rate limit on the admin
 	rateLimiter := rate.NewLimiter(adminRateLimit.GetTps(), adminRateLimit.GetBurst())
 
-	// Set the executor to send executions to admin
-	executor := executor.New(w.scope, w.adminServiceClient)
-
 	// Create the scheduler using GoCronScheduler implementation
 	// Also Bootstrap the schedules from the snapshot
 	bootStrapCtx, bootStrapCancel := context.WithCancel(ctx)
 	defer bootStrapCancel()
 	useUtcTz := w.workflowExecutorConfig.UseUTCTz
-	gcronScheduler := core.NewGoCronScheduler(bootStrapCtx, schedules, w.scope, snapshot, rateLimiter, executor, useUtcTz)
-	w.scheduler = gcronScheduler
+	gcronScheduler := core.NewGoCronSchedulerWithMetrics(bootStrapCtx, schedules, w.cronMetrics, snapshot, rateLimiter,
+		w.executor, useUtcTz, w.catchUpPolicies)
+	w.setScheduler(gcronScheduler)
+	defer w.setScheduler(nil)
 
 	// Start the go routine to write the update schedules periodically
 	updaterCtx, updaterCancel := context.WithCancel(ctx)
@@ -94,7 +129,7 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 		return err
 	}
 
-	snapshotRunner := core.NewSnapshotRunner(w.snapshoter, w.scheduler)
+	snapshotRunner := core.NewSnapshotRunner(w.snapshoter, gcronScheduler)
 	// Start the go routine to write the snapshot periodically
 	snapshoterCtx, snapshoterCancel := context.WithCancel(ctx)
 	defer snapshoterCancel()
@@ -106,12 +141,17 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 
 func NewScheduledExecutor(db repositoryInterfaces.SchedulerRepoInterface,
 	workflowExecutorConfig runtimeInterfaces.WorkflowExecutorConfig,
-	scope promutils.Scope, adminServiceClient service.AdminServiceClient) ScheduledExecutor {
-	return ScheduledExecutor{
+	catchUpPolicies []runtimeInterfaces.ScheduleCatchUpPolicy,
+	scope promutils.Scope, adminServiceClient service.AdminServiceClient) *ScheduledExecutor {
+	return &ScheduledExecutor{
 		db:                     db,
 		scope:                  scope,
 		adminServiceClient:     adminServiceClient,
 		workflowExecutorConfig: workflowExecutorConfig.GetFlyteWorkflowExecutorConfig(),
+		catchUpPolicies:        catchUpPolicies,
 		snapshoter:             snapshoter.New(scope, db),
+		// Set the executor to send executions to admin
+		executor:    executor.New(scope, adminServiceClient),
+		cronMetrics: core.NewGoCronMetrics(scope),
 	}
 }
@@ -29,7 +29,7 @@ import (
 var schedules []models.SchedulableEntity
 var db repositoryInterfaces.Repository
 
-func setupScheduleExecutor(t *testing.T, s string) ScheduledExecutor {
+func setupScheduleExecutor(t *testing.T, s string) *ScheduledExecutor {
 	db = mocks.NewMockRepository()
 	var scope = promutils.NewScope(s)
 	scheduleExecutorConfig := runtimeInterfaces.WorkflowExecutorConfig{
@@ -62,7 +62,7 @@ func setupScheduleExecutor(t *testing.T, s string) ScheduledExecutor {
 	snapshotRepo.OnWriteMatch(mock.Anything, mock.Anything).Return(nil)
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).
 		Return(&admin.ExecutionCreateResponse{}, nil)
-	return NewScheduledExecutor(db, scheduleExecutorConfig,
+	return NewScheduledExecutor(db, scheduleExecutorConfig, nil,
 		scope, mockAdminClient)
 }
 
@@ -8,13 +8,16 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flyteadmin/scheduler/core"
+	"github.com/flyteorg/flyteadmin/scheduler/leaderelection"
 	"github.com/flyteorg/flyteidl/clients/go/admin"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
 )
 
-// StartScheduler creates and starts a new scheduler instance. This is a blocking call and will block the calling go-routine
-func StartScheduler(ctx context.Context) error {
+// StartScheduler creates and starts a new scheduler instance, whose status is served by the optional statusHandler.
+// This is a blocking call and will block the calling go-routine
+func StartScheduler(ctx context.Context, statusHandler *StatusHandler) error {
 	configuration := runtime.NewConfigurationProvider()
 	applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
 
@@ -48,12 +51,28 @@ func StartScheduler(ctx context.Context) error {
 	}
 	adminServiceClient := clientSet.AdminClient()
 
-	scheduleExecutor := NewScheduledExecutor(repo,
-		configuration.ApplicationConfiguration().GetSchedulerConfig().GetWorkflowExecutorConfig(), schedulerScope, adminServiceClient)
+	schedulerConfig := configuration.ApplicationConfiguration().GetSchedulerConfig()
+	if err := core.ValidateCatchUpPolicies(schedulerConfig.GetCatchUpPolicies()); err != nil {
+		logger.Fatalf(ctx, "Flyte native scheduler failed to start due to %v", err)
+		return err
+	}
+	scheduleExecutor := NewScheduledExecutor(repo, schedulerConfig.GetWorkflowExecutorConfig(),
+		schedulerConfig.GetCatchUpPolicies(), schedulerScope, adminServiceClient)
+
+	if statusHandler != nil {
+		statusHandler.setExecutor(scheduleExecutor)
+	}
 
 	logger.Info(ctx, "Successfully initialized a native flyte scheduler")
 
-	err = scheduleExecutor.Run(ctx)
+	if leaderElectionConfig := schedulerConfig.GetLeaderElectionConfig(); leaderElectionConfig.Enabled {
+		// Only the leader among the replicas runs the schedules.
+		leaderElector := leaderelection.NewLeaderElector(repo.SchedulerLeaseRepo(), leaderElectionConfig,
+			schedulerScope.NewSubScope("leader_election"))
+		err = leaderElector.Run(ctx, scheduleExecutor.Run)
+	} else {
+		err = scheduleExecutor.Run(ctx)
+	}
 	if err != nil {
 		logger.Fatalf(ctx, "Flyte native scheduler failed to start due to %v", err)
 		return err
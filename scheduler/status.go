@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/profutils"
+)
+
+// StatusPath is the path of the profiling server the status of the scheduler replica is served on as JSON.
+const StatusPath = "/status"
+
+// StatusHandler serves the status of the scheduler replica, once it's started, along with the schedules it runs and
+// their last and next execution times.
+type StatusHandler struct {
+	mu       sync.RWMutex
+	executor *ScheduledExecutor
+}
+
+func (h *StatusHandler) setExecutor(executor *ScheduledExecutor) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.executor = executor
+}
+
+func (h *StatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	h.mu.RLock()
+	executor := h.executor
+	h.mu.RUnlock()
+	if executor == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if err := profutils.WriteJSONResponse(w, http.StatusOK, executor.GetStatus(r.Context())); err != nil {
+		logger.Errorf(r.Context(), "failed to write the scheduler status due to %v", err)
+	}
+}
+
+func NewStatusHandler() *StatusHandler {
+	return &StatusHandler{}
+}
@@ -0,0 +1,82 @@
+//go:build !race
+// +build !race
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	adminModels "github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getStatus(t *testing.T, handler http.Handler) (int, Status) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, StatusPath, nil))
+	var status Status
+	if recorder.Code == http.StatusOK {
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	}
+	return recorder.Code, status
+}
+
+func TestStatusHandler(t *testing.T) {
+	statusHandler := NewStatusHandler()
+	code, _ := getStatus(t, statusHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	scheduleExecutor := setupScheduleExecutor(t, "status")
+	active := true
+	scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+	scheduleEntitiesRepo.OnGetAllMatch(mock.Anything).Return([]models.SchedulableEntity{
+		{
+			BaseModel: adminModels.BaseModel{
+				UpdatedAt: time.Now(),
+			},
+			SchedulableEntityKey: models.SchedulableEntityKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "status_schedule",
+				Version: "v1",
+			},
+			CronExpression: "0 19 * * *",
+			Active:         &active,
+		},
+	}, nil)
+	statusHandler.setExecutor(scheduleExecutor)
+	code, status := getStatus(t, statusHandler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, status.Running)
+	assert.Empty(t, status.Schedules)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- scheduleExecutor.Run(ctx)
+	}()
+	assert.Eventually(t, func() bool {
+		_, status = getStatus(t, statusHandler)
+		return status.Running
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Len(t, status.Schedules, 1)
+	assert.Equal(t, "status_schedule", status.Schedules[0].Name)
+	assert.NotNil(t, status.Schedules[0].NextExecutionTime)
+
+	cancel()
+	assert.Nil(t, <-done)
+	_, status = getStatus(t, statusHandler)
+	assert.False(t, status.Running)
+
+	recorder := httptest.NewRecorder()
+	statusHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, StatusPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -51,7 +51,7 @@ func TestScheduleJob(t *testing.T) {
 	executor := new(mocks.Executor)
 	snapshot := &snapshoter.SnapshotV1{}
 	executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	g := scheduler.NewGoCronScheduler(context.Background(), []models.SchedulableEntity{}, schedulerScope, snapshot, rateLimiter, executor, false)
+	g := scheduler.NewGoCronScheduler(context.Background(), []models.SchedulableEntity{}, schedulerScope, snapshot, rateLimiter, executor, false, nil)
 	c.Start()
 
 	tests := []struct {